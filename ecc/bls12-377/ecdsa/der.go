@@ -0,0 +1,449 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ecdsa
+
+import (
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"hash"
+	"math/big"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fp"
+)
+
+var (
+	errTrailingData      = errors.New("trailing data after ASN.1 structure")
+	errUnknownAlgorithm  = errors.New("unknown public key algorithm")
+	errUnknownCurve      = errors.New("unknown elliptic curve parameters")
+	errInvalidPoint      = errors.New("invalid elliptic curve point")
+	errInvalidPrivateKey = errors.New("invalid private key")
+	errWrongPublicKey    = errors.New("public key doesn't match the private key")
+	errNoPEMBlock        = errors.New("no PEM block of the expected type")
+)
+
+var (
+	// id-ecPublicKey, RFC 5480
+	oidPublicKeyECDSA = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	// prime-field, ANSI X9.62
+	oidPrimeField = asn1.ObjectIdentifier{1, 2, 840, 10045, 1, 1}
+)
+
+const (
+	ecPrivateKeyVersion = 1
+	pemTypeECPrivateKey = "EC PRIVATE KEY"
+	pemTypePrivateKey   = "PRIVATE KEY"
+	pemTypePublicKey    = "PUBLIC KEY"
+)
+
+// ecdsaSignature is the ECDSA-Sig-Value of RFC 3279, Section 2.2.3
+type ecdsaSignature struct {
+	R, S *big.Int
+}
+
+// ecPrivateKey is the ECPrivateKey of SEC 1, Version 2.0, Section C.4 (RFC 5915)
+type ecPrivateKey struct {
+	Version    int
+	PrivateKey []byte
+	Parameters asn1.RawValue  `asn1:"optional,explicit,tag:0"`
+	PublicKey  asn1.BitString `asn1:"optional,explicit,tag:1"`
+}
+
+// pkcs8 is the PrivateKeyInfo of RFC 5208, without the optional attributes
+type pkcs8 struct {
+	Version    int
+	Algo       pkix.AlgorithmIdentifier
+	PrivateKey []byte
+}
+
+// publicKeyInfo is the SubjectPublicKeyInfo of RFC 5280, Section 4.1
+type publicKeyInfo struct {
+	Algorithm pkix.AlgorithmIdentifier
+	PublicKey asn1.BitString
+}
+
+// specifiedECDomain is the SpecifiedECDomain of SEC 1, Version 2.0, Section C.2,
+// restricted to prime fields
+type specifiedECDomain struct {
+	Version  int
+	FieldID  primeFieldID
+	Curve    curveCoefficients
+	Base     []byte
+	Order    *big.Int
+	Cofactor *big.Int `asn1:"optional"`
+}
+
+type primeFieldID struct {
+	FieldType asn1.ObjectIdentifier
+	Prime     *big.Int
+}
+
+type curveCoefficients struct {
+	A, B []byte
+	Seed asn1.BitString `asn1:"optional"`
+}
+
+var (
+	domainOnce     sync.Once
+	domain         specifiedECDomain // explicit parameters of the curve
+	domainEncoding []byte            // DER encoding of the ECParameters of the curve
+	domainBase     bls12377.G1Affine
+)
+
+func initDomain() {
+	a, b := bls12377.CurveCoefficients()
+	aBin, bBin := a.Bytes(), b.Bytes()
+	_, _, domainBase, _ = bls12377.Generators()
+	domain = specifiedECDomain{
+		Version: 1,
+		FieldID: primeFieldID{FieldType: oidPrimeField, Prime: fp.Modulus()},
+		Curve:   curveCoefficients{A: aBin[:], B: bBin[:]},
+		Base:    marshalPoint(&domainBase),
+		Order:   order,
+	}
+
+	var err error
+	// the curve has no standard object identifier, the parameters are explicit
+	domainEncoding, err = asn1.Marshal(domain)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// checkParameters returns an error if der isn't the DER encoding of ECParameters
+// describing the curve with explicit parameters. The cofactor and the seed are
+// ignored.
+func checkParameters(der []byte) error {
+	domainOnce.Do(initDomain)
+
+	var params specifiedECDomain
+	rest, err := asn1.Unmarshal(der, &params)
+	if err != nil {
+		return err
+	}
+	if len(rest) != 0 {
+		return errTrailingData
+	}
+	var a, b, expectedA, expectedB big.Int
+	a.SetBytes(params.Curve.A)
+	b.SetBytes(params.Curve.B)
+	expectedA.SetBytes(domain.Curve.A)
+	expectedB.SetBytes(domain.Curve.B)
+	if params.Version != 1 || !params.FieldID.FieldType.Equal(oidPrimeField) ||
+		params.FieldID.Prime.Cmp(domain.FieldID.Prime) != 0 ||
+		a.Cmp(&expectedA) != 0 || b.Cmp(&expectedB) != 0 ||
+		params.Order.Cmp(domain.Order) != 0 {
+		return errUnknownCurve
+	}
+	var base bls12377.G1Affine
+	if err := unmarshalPoint(&base, params.Base); err != nil {
+		return err
+	}
+	if !base.Equal(&domainBase) {
+		return errUnknownCurve
+	}
+	return nil
+}
+
+// marshalPoint returns the uncompressed encoding 0x04||x||y of p,
+// SEC 1, Version 2.0, Section 2.3.3
+func marshalPoint(p *bls12377.G1Affine) []byte {
+	res := make([]byte, 1+2*sizeFp)
+	res[0] = 4
+	xBin, yBin := p.X.Bytes(), p.Y.Bytes()
+	copy(res[1:1+sizeFp], xBin[:])
+	copy(res[1+sizeFp:], yBin[:])
+	return res
+}
+
+// unmarshalPoint sets p from its uncompressed encoding 0x04||x||y, and checks that
+// it is a point of the prime order subgroup, different from the point at infinity.
+// SEC 1, Version 2.0, Section 2.3.4
+func unmarshalPoint(p *bls12377.G1Affine, buf []byte) error {
+	if len(buf) != 1+2*sizeFp || buf[0] != 4 {
+		return errInvalidPoint
+	}
+	if err := p.X.SetBytesCanonical(buf[1 : 1+sizeFp]); err != nil {
+		return errInvalidPoint
+	}
+	if err := p.Y.SetBytesCanonical(buf[1+sizeFp:]); err != nil {
+		return errInvalidPoint
+	}
+	if p.IsInfinity() || !p.IsOnCurve() || !p.IsInSubGroup() {
+		return errInvalidPoint
+	}
+	return nil
+}
+
+// MarshalASN1 returns the DER encoding of the signature, as the ECDSA-Sig-Value
+// SEQUENCE { r INTEGER, s INTEGER } of RFC 3279, Section 2.2.3
+func (sig *Signature) MarshalASN1() ([]byte, error) {
+	return asn1.Marshal(ecdsaSignature{
+		R: new(big.Int).SetBytes(sig.R[:]),
+		S: new(big.Int).SetBytes(sig.S[:]),
+	})
+}
+
+// UnmarshalASN1 sets sig from the DER encoding of an ECDSA-Sig-Value. As in
+// SetBytes, r and s must be in [1, order-1].
+func (sig *Signature) UnmarshalASN1(der []byte) error {
+	var rs ecdsaSignature
+	rest, err := asn1.Unmarshal(der, &rs)
+	if err != nil {
+		return err
+	}
+	if len(rest) != 0 {
+		return errTrailingData
+	}
+	if rs.R.Sign() <= 0 || rs.S.Sign() <= 0 {
+		return errZero
+	}
+	if rs.R.BitLen() > sizeFrBits || rs.S.BitLen() > sizeFrBits {
+		return errWrongSize
+	}
+
+	var buf [sizeSignature]byte
+	rs.R.FillBytes(buf[:sizeFr])
+	rs.S.FillBytes(buf[sizeFr:])
+	_, err = sig.SetBytes(buf[:])
+	return err
+}
+
+// SignASN1 performs the ECDSA signature, as Sign does, and returns it DER encoded
+// as an ECDSA-Sig-Value, as crypto/ecdsa.SignASN1 does.
+func (privKey *PrivateKey) SignASN1(message []byte, hFunc hash.Hash) ([]byte, error) {
+	sigBin, err := privKey.Sign(message, hFunc)
+	if err != nil {
+		return nil, err
+	}
+	var sig Signature
+	if _, err = sig.SetBytes(sigBin); err != nil {
+		return nil, err
+	}
+	return sig.MarshalASN1()
+}
+
+// VerifyASN1 validates the DER encoded ECDSA signature sigDER, as Verify does.
+func (publicKey *PublicKey) VerifyASN1(sigDER, message []byte, hFunc hash.Hash) (bool, error) {
+	var sig Signature
+	if err := sig.UnmarshalASN1(sigDER); err != nil {
+		return false, err
+	}
+	return publicKey.Verify(sig.Bytes(), message, hFunc)
+}
+
+// MarshalECPrivateKey returns the SEC 1 (RFC 5915) DER encoding of privKey,
+// including the curve parameters and the public key.
+func MarshalECPrivateKey(privKey *PrivateKey) ([]byte, error) {
+	domainOnce.Do(initDomain)
+	return marshalECPrivateKey(privKey, domainEncoding)
+}
+
+// marshalECPrivateKey returns the SEC 1 DER encoding of privKey, with the DER
+// encoded ECParameters params, omitted if nil.
+func marshalECPrivateKey(privKey *PrivateKey, params []byte) ([]byte, error) {
+	pkBin := marshalPoint(&privKey.PublicKey.A)
+	key := ecPrivateKey{
+		Version:    ecPrivateKeyVersion,
+		PrivateKey: privKey.scalar[:],
+		PublicKey:  asn1.BitString{Bytes: pkBin, BitLength: 8 * len(pkBin)},
+	}
+	if params != nil {
+		// the RawValue is written as is: we add the explicit tag
+		key.Parameters = asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: params}
+	}
+	return asn1.Marshal(key)
+}
+
+// ParseECPrivateKey parses a SEC 1 (RFC 5915) DER encoded private key. The curve
+// parameters, if present, must describe the curve, and the public key, if
+// present, must match the private key.
+func ParseECPrivateKey(der []byte) (*PrivateKey, error) {
+	var key ecPrivateKey
+	rest, err := asn1.Unmarshal(der, &key)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) != 0 {
+		return nil, errTrailingData
+	}
+	if key.Version != ecPrivateKeyVersion {
+		return nil, errInvalidPrivateKey
+	}
+	if len(key.Parameters.FullBytes) != 0 {
+		// the RawValue is the explicitly tagged element
+		if err := checkParameters(key.Parameters.Bytes); err != nil {
+			return nil, err
+		}
+	}
+
+	// the scalar may be encoded with leading zeroes, or without them
+	k := new(big.Int).SetBytes(key.PrivateKey)
+	if k.Sign() <= 0 || k.Cmp(order) >= 0 {
+		return nil, errInvalidPrivateKey
+	}
+	privKey := new(PrivateKey)
+	k.FillBytes(privKey.scalar[:])
+	privKey.PublicKey.A.ScalarMultiplicationBase(k)
+
+	if key.PublicKey.BitLength != 0 {
+		var pk bls12377.G1Affine
+		if err := unmarshalPoint(&pk, key.PublicKey.RightAlign()); err != nil {
+			return nil, err
+		}
+		if !pk.Equal(&privKey.PublicKey.A) {
+			return nil, errWrongPublicKey
+		}
+	}
+	return privKey, nil
+}
+
+// MarshalPKCS8PrivateKey returns the PKCS #8 (RFC 5208) DER encoding of privKey,
+// the algorithm being id-ecPublicKey with the curve parameters (RFC 5480).
+func MarshalPKCS8PrivateKey(privKey *PrivateKey) ([]byte, error) {
+	domainOnce.Do(initDomain)
+
+	// the curve parameters are in the algorithm identifier only, as in RFC 5915, Section 2
+	key, err := marshalECPrivateKey(privKey, nil)
+	if err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(pkcs8{
+		Algo: pkix.AlgorithmIdentifier{
+			Algorithm:  oidPublicKeyECDSA,
+			Parameters: asn1.RawValue{FullBytes: domainEncoding},
+		},
+		PrivateKey: key,
+	})
+}
+
+// ParsePKCS8PrivateKey parses a PKCS #8 (RFC 5208) DER encoded private key, with
+// the algorithm id-ecPublicKey on the curve.
+func ParsePKCS8PrivateKey(der []byte) (*PrivateKey, error) {
+	var key pkcs8
+	rest, err := asn1.Unmarshal(der, &key)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) != 0 {
+		return nil, errTrailingData
+	}
+	if !key.Algo.Algorithm.Equal(oidPublicKeyECDSA) {
+		return nil, errUnknownAlgorithm
+	}
+	if err := checkParameters(key.Algo.Parameters.FullBytes); err != nil {
+		return nil, err
+	}
+	return ParseECPrivateKey(key.PrivateKey)
+}
+
+// MarshalPKIXPublicKey returns the DER encoding of the SubjectPublicKeyInfo
+// (RFC 5280) of publicKey, the algorithm being id-ecPublicKey with the curve
+// parameters (RFC 5480).
+func MarshalPKIXPublicKey(publicKey *PublicKey) ([]byte, error) {
+	domainOnce.Do(initDomain)
+
+	pkBin := marshalPoint(&publicKey.A)
+	return asn1.Marshal(publicKeyInfo{
+		Algorithm: pkix.AlgorithmIdentifier{
+			Algorithm:  oidPublicKeyECDSA,
+			Parameters: asn1.RawValue{FullBytes: domainEncoding},
+		},
+		PublicKey: asn1.BitString{Bytes: pkBin, BitLength: 8 * len(pkBin)},
+	})
+}
+
+// ParsePKIXPublicKey parses a DER encoded SubjectPublicKeyInfo (RFC 5280), with
+// the algorithm id-ecPublicKey on the curve.
+func ParsePKIXPublicKey(der []byte) (*PublicKey, error) {
+	var info publicKeyInfo
+	rest, err := asn1.Unmarshal(der, &info)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) != 0 {
+		return nil, errTrailingData
+	}
+	if !info.Algorithm.Algorithm.Equal(oidPublicKeyECDSA) {
+		return nil, errUnknownAlgorithm
+	}
+	if err := checkParameters(info.Algorithm.Parameters.FullBytes); err != nil {
+		return nil, err
+	}
+	publicKey := new(PublicKey)
+	if err := unmarshalPoint(&publicKey.A, info.PublicKey.RightAlign()); err != nil {
+		return nil, err
+	}
+	return publicKey, nil
+}
+
+// MarshalPEMPrivateKey returns the PKCS #8 encoding of privKey in a "PRIVATE KEY"
+// PEM block (RFC 7468).
+func MarshalPEMPrivateKey(privKey *PrivateKey) ([]byte, error) {
+	der, err := MarshalPKCS8PrivateKey(privKey)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: pemTypePrivateKey, Bytes: der}), nil
+}
+
+// ParsePEMPrivateKey parses the first "PRIVATE KEY" (PKCS #8) or "EC PRIVATE KEY"
+// (SEC 1) PEM block of data, skipping the other blocks, such as the "EC PARAMETERS"
+// written by OpenSSL.
+func ParsePEMPrivateKey(data []byte) (*PrivateKey, error) {
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return nil, errNoPEMBlock
+		}
+		switch block.Type {
+		case pemTypePrivateKey:
+			return ParsePKCS8PrivateKey(block.Bytes)
+		case pemTypeECPrivateKey:
+			return ParseECPrivateKey(block.Bytes)
+		}
+	}
+}
+
+// MarshalPEMPublicKey returns the SubjectPublicKeyInfo of publicKey in a
+// "PUBLIC KEY" PEM block (RFC 7468).
+func MarshalPEMPublicKey(publicKey *PublicKey) ([]byte, error) {
+	der, err := MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: pemTypePublicKey, Bytes: der}), nil
+}
+
+// ParsePEMPublicKey parses the first "PUBLIC KEY" PEM block of data, skipping the
+// other blocks.
+func ParsePEMPublicKey(data []byte) (*PublicKey, error) {
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return nil, errNoPEMBlock
+		}
+		if block.Type == pemTypePublicKey {
+			return ParsePKIXPublicKey(block.Bytes)
+		}
+	}
+}
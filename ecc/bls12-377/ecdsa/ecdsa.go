@@ -257,10 +257,25 @@ func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error)
 // SEC 1, Version 2.0, Section 4.1.4
 func (publicKey *PublicKey) Verify(sigBin, message []byte, hFunc hash.Hash) (bool, error) {
 
+	r, u1, u2, err := verifyScalars(sigBin, message, hFunc)
+	if err != nil {
+		return false, err
+	}
+
+	var U bls12377.G1Jac
+	U.JointScalarMultiplicationBase(&publicKey.A, u1, u2)
+
+	return checkX(&U, r), nil
+}
+
+// verifyScalars deserializes the signature sigBin = r||s of message, and returns r
+// and the scalars u₁ = s⁻¹ ⋅ m and u₂ = s⁻¹ ⋅ r of the verification equation.
+func verifyScalars(sigBin, message []byte, hFunc hash.Hash) (r, u1, u2 *big.Int, err error) {
+
 	// Deserialize the signature
 	var sig Signature
 	if _, err := sig.SetBytes(sigBin); err != nil {
-		return false, err
+		return nil, nil, nil, err
 	}
 
 	r, s := new(big.Int), new(big.Int)
@@ -277,7 +292,7 @@ func (publicKey *PublicKey) Verify(sigBin, message []byte, hFunc hash.Hash) (boo
 		hFunc.Reset()
 		_, err := hFunc.Write(dataToHash[:])
 		if err != nil {
-			return false, err
+			return nil, nil, nil, err
 		}
 		hramBin := hFunc.Sum(nil)
 		m = HashToInt(hramBin)
@@ -285,13 +300,16 @@ func (publicKey *PublicKey) Verify(sigBin, message []byte, hFunc hash.Hash) (boo
 		m = HashToInt(message)
 	}
 
-	u1 := new(big.Int).Mul(m, sInv)
+	u1 = new(big.Int).Mul(m, sInv)
 	u1.Mod(u1, order)
-	u2 := new(big.Int).Mul(r, sInv)
+	u2 = new(big.Int).Mul(r, sInv)
 	u2.Mod(u2, order)
-	var U bls12377.G1Jac
-	U.JointScalarMultiplicationBase(&publicKey.A, u1, u2)
 
+	return r, u1, u2, nil
+}
+
+// checkX returns true if the x coordinate of U is r (mod order). U is modified.
+func checkX(U *bls12377.G1Jac, r *big.Int) bool {
 	var z big.Int
 	U.Z.Square(&U.Z).
 		Inverse(&U.Z).
@@ -300,6 +318,5 @@ func (publicKey *PublicKey) Verify(sigBin, message []byte, hFunc hash.Hash) (boo
 
 	z.Mod(&z, order)
 
-	return z.Cmp(r) == 0, nil
-
+	return z.Cmp(r) == 0
 }
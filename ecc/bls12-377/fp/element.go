@@ -18,6 +18,7 @@ package fp
 
 import (
 	"crypto/rand"
+	"encoding/base64"
 	"encoding/binary"
 	"errors"
 	"io"
@@ -1194,6 +1195,91 @@ func (z *Element) SetString(number string) (*Element, error) {
 	return z, nil
 }
 
+// MarshalText implements encoding.TextMarshaler. It returns z.Text(10), see MarshalTextBase
+// for the hexadecimal and base64 encodings.
+func (z *Element) MarshalText() ([]byte, error) {
+	return z.MarshalTextBase(10)
+}
+
+// MarshalTextBase returns the text encoding of z in the given base:
+//   - 10: z.Text(10)
+//   - 16: 0x followed by z.Text(16)
+//   - 64: the standard base64 encoding (RFC 4648, section 4) of z.Bytes()
+func (z *Element) MarshalTextBase(base int) ([]byte, error) {
+	switch base {
+	case 10:
+		return []byte(z.Text(10)), nil
+	case 16:
+		return []byte("0x" + z.Text(16)), nil
+	case 64:
+		b := z.Bytes()
+		res := make([]byte, base64.StdEncoding.EncodedLen(len(b)))
+		base64.StdEncoding.Encode(res, b[:])
+		return res, nil
+	default:
+		return nil, errors.New("invalid base: must be 10, 16 or 64")
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts the encodings written by
+// MarshalTextBase(10) and MarshalTextBase(16), see UnmarshalTextBase.
+func (z *Element) UnmarshalText(text []byte) error {
+	if len(text) > 1 && text[0] == '0' && text[1] == 'x' {
+		return z.UnmarshalTextBase(text, 16)
+	}
+	return z.UnmarshalTextBase(text, 10)
+}
+
+// UnmarshalTextBase sets z from its encoding by MarshalTextBase(base).
+//
+// Every element has a single encoding in each base: values ⩾ q, leading zeros, signs other than
+// the one written by Text(10), upper case digits, ... are rejected. See SetString for a lenient
+// decoder.
+func (z *Element) UnmarshalTextBase(text []byte, base int) error {
+	var v Element
+	switch base {
+	case 10, 16:
+		if len(text) > 2+Bits {
+			return errors.New("value too large (max = Element.Bits + 2 characters)")
+		}
+		s := string(text)
+		if base == 16 {
+			if !strings.HasPrefix(s, "0x") {
+				return errors.New("invalid fp.Element encoding: missing 0x prefix")
+			}
+			s = s[2:]
+		}
+
+		// get temporary big int from the pool
+		vv := pool.BigInt.Get()
+		_, ok := vv.SetString(s, base)
+		if ok {
+			v.SetBigInt(vv)
+		}
+		// release object into pool
+		pool.BigInt.Put(vv)
+		if !ok {
+			return errors.New("can't parse into a big.Int: " + s)
+		}
+	case 64:
+		b, err := base64.StdEncoding.DecodeString(string(text))
+		if err != nil {
+			return err
+		}
+		if err := v.SetBytesCanonical(b); err != nil {
+			return err
+		}
+	default:
+		return errors.New("invalid base: must be 10, 16 or 64")
+	}
+
+	if canonical, _ := v.MarshalTextBase(base); string(canonical) != string(text) {
+		return errors.New("non-canonical fp.Element encoding")
+	}
+	*z = v
+	return nil
+}
+
 // MarshalJSON returns json encoding of z (z.Text(10))
 // If z == nil, returns null
 func (z *Element) MarshalJSON() ([]byte, error) {
@@ -1212,34 +1298,91 @@ func (z *Element) MarshalJSON() ([]byte, error) {
 	return []byte(sbb.String()), nil
 }
 
-// UnmarshalJSON accepts numbers and strings as input
-// See Element.SetString for valid prefixes (0x, 0b, ...)
+// MarshalJSONBase returns the json encoding of z: MarshalJSON's if base is 10, or the string
+// MarshalTextBase(base) if base is 16 or 64.
+// If z == nil, returns null
+func (z *Element) MarshalJSONBase(base int) ([]byte, error) {
+	switch base {
+	case 10:
+		return z.MarshalJSON()
+	case 16, 64:
+		if z == nil {
+			return []byte("null"), nil
+		}
+		text, err := z.MarshalTextBase(base)
+		if err != nil {
+			return nil, err
+		}
+		return []byte("\"" + string(text) + "\""), nil
+	default:
+		return nil, errors.New("invalid base: must be 10, 16 or 64")
+	}
+}
+
+// UnmarshalJSON accepts the encodings written by MarshalJSONBase(10) and MarshalJSONBase(16),
+// see UnmarshalJSONBase.
 func (z *Element) UnmarshalJSON(data []byte) error {
-	s := string(data)
-	if len(s) > Bits*3 {
-		return errors.New("value too large (max = Element.Bits * 3)")
+	if len(data) > 2 && data[0] == '"' && data[1] == '0' && data[2] == 'x' {
+		return z.UnmarshalJSONBase(data, 16)
 	}
+	return z.UnmarshalJSONBase(data, 10)
+}
 
-	// we accept numbers and strings, remove leading and trailing quotes if any
-	if len(s) > 0 && s[0] == '"' {
-		s = s[1:]
+// UnmarshalJSONBase sets z from its encoding by MarshalJSONBase(base). Like UnmarshalTextBase,
+// it rejects any other encoding: in base 10, values are numbers if their decimal encoding is at
+// most 15 characters long, and strings otherwise.
+func (z *Element) UnmarshalJSONBase(data []byte, base int) error {
+	text := data
+	if len(text) > 1 && text[0] == '"' && text[len(text)-1] == '"' {
+		text = text[1 : len(text)-1]
+	}
+	var v Element
+	if err := v.UnmarshalTextBase(text, base); err != nil {
+		return err
 	}
-	if len(s) > 0 && s[len(s)-1] == '"' {
-		s = s[:len(s)-1]
+	if canonical, _ := v.MarshalJSONBase(base); string(canonical) != string(data) {
+		return errors.New("non-canonical fp.Element encoding")
 	}
+	*z = v
+	return nil
+}
 
-	// get temporary big int from the pool
-	vv := pool.BigInt.Get()
+// GobEncode implements gob.GobEncoder with the canonical big-endian encoding of z (see Bytes).
+//
+// Without it, encoding/gob would use MarshalText: either way, the gob encoding of an
+// Element is no longer the one of its [6]uint64 Montgomery form, and gob
+// streams written before Element implemented encoding.TextMarshaler can't be decoded.
+func (z *Element) GobEncode() ([]byte, error) {
+	return z.Marshal(), nil
+}
 
-	if _, ok := vv.SetString(s, 0); !ok {
-		return errors.New("can't parse into a big.Int: " + s)
-	}
+// GobDecode implements gob.GobDecoder, see GobEncode. It returns an error if data is not a
+// 48-byte slice or encodes a value higher than q.
+func (z *Element) GobDecode(data []byte) error {
+	return z.SetBytesCanonical(data)
+}
 
-	z.SetBigInt(vv)
+// cborByteString is the major type of CBOR byte strings (RFC 8949, section 3.1)
+const cborByteString = 2 << 5
 
-	// release object into pool
-	pool.BigInt.Put(vv)
-	return nil
+// MarshalCBOR returns the CBOR (RFC 8949) encoding of z: a byte string
+// holding the canonical big-endian encoding of z (see Marshal).
+func (z *Element) MarshalCBOR() ([]byte, error) {
+	b := z.Bytes()
+	res := make([]byte, 0, 3+Bytes)
+	res = append(res, cborByteString|24, Bytes)
+	return append(res, b[:]...), nil
+}
+
+// UnmarshalCBOR decodes a CBOR byte string encoding a Element (see MarshalCBOR).
+// It returns an error if the encoding is not the shortest one allowed by CBOR,
+// or if the encoded value is greater or equal than q.
+func (z *Element) UnmarshalCBOR(data []byte) error {
+	const headerSize = 2
+	if len(data) != headerSize+Bytes || data[0] != cborByteString|24 || data[1] != Bytes {
+		return errors.New("invalid CBOR encoding: expected a byte string of 48 bytes")
+	}
+	return z.SetBytesCanonical(data[headerSize:])
 }
 
 // A ByteOrder specifies how to convert byte slices into a Element
@@ -1790,6 +1933,358 @@ func updateFactorsDecompose(c int64) (int64, int64) {
 	return f, g
 }
 
+var (
+	// _bInverseCTExponentElement is q-2, used by InverseCT (Fermat's little theorem)
+	_bInverseCTExponentElement [Bytes]byte
+	// _bSqrtCTExponentElement is the exponent used by SqrtCT, see Sqrt
+	_bSqrtCTExponentElement [Bytes]byte
+)
+
+func init() {
+	e := Modulus()
+	e.Sub(e, big.NewInt(2))
+	e.FillBytes(_bInverseCTExponentElement[:])
+	e.SetString("35c748c2f8a21d58c760b80d94292763445b3e601ea271e3de6c45f741290002e16ba88600000010a11", 16)
+	e.FillBytes(_bSqrtCTExponentElement[:])
+}
+
+// mulCT z = x * y (mod q)
+//
+// mulCT is the textbook CIOS multiplication (see _mulGeneric) followed by a branchless
+// reduction; unlike Mul, its running time doesn't depend on x and y.
+func mulCT(z, x, y *Element) {
+	var t [7]uint64
+	var D uint64
+	var m, C uint64
+	C, t[0] = bits.Mul64(y[0], x[0])
+	C, t[1] = madd1(y[0], x[1], C)
+	C, t[2] = madd1(y[0], x[2], C)
+	C, t[3] = madd1(y[0], x[3], C)
+	C, t[4] = madd1(y[0], x[4], C)
+	C, t[5] = madd1(y[0], x[5], C)
+	t[6], D = bits.Add64(t[6], C, 0)
+	m = t[0] * qInvNeg
+	C = madd0(m, q0, t[0])
+	C, t[0] = madd2(m, q1, t[1], C)
+	C, t[1] = madd2(m, q2, t[2], C)
+	C, t[2] = madd2(m, q3, t[3], C)
+	C, t[3] = madd2(m, q4, t[4], C)
+	C, t[4] = madd2(m, q5, t[5], C)
+	t[5], C = bits.Add64(t[6], C, 0)
+	t[6], _ = bits.Add64(0, D, C)
+	C, t[0] = madd1(y[1], x[0], t[0])
+	C, t[1] = madd2(y[1], x[1], t[1], C)
+	C, t[2] = madd2(y[1], x[2], t[2], C)
+	C, t[3] = madd2(y[1], x[3], t[3], C)
+	C, t[4] = madd2(y[1], x[4], t[4], C)
+	C, t[5] = madd2(y[1], x[5], t[5], C)
+	t[6], D = bits.Add64(t[6], C, 0)
+	m = t[0] * qInvNeg
+	C = madd0(m, q0, t[0])
+	C, t[0] = madd2(m, q1, t[1], C)
+	C, t[1] = madd2(m, q2, t[2], C)
+	C, t[2] = madd2(m, q3, t[3], C)
+	C, t[3] = madd2(m, q4, t[4], C)
+	C, t[4] = madd2(m, q5, t[5], C)
+	t[5], C = bits.Add64(t[6], C, 0)
+	t[6], _ = bits.Add64(0, D, C)
+	C, t[0] = madd1(y[2], x[0], t[0])
+	C, t[1] = madd2(y[2], x[1], t[1], C)
+	C, t[2] = madd2(y[2], x[2], t[2], C)
+	C, t[3] = madd2(y[2], x[3], t[3], C)
+	C, t[4] = madd2(y[2], x[4], t[4], C)
+	C, t[5] = madd2(y[2], x[5], t[5], C)
+	t[6], D = bits.Add64(t[6], C, 0)
+	m = t[0] * qInvNeg
+	C = madd0(m, q0, t[0])
+	C, t[0] = madd2(m, q1, t[1], C)
+	C, t[1] = madd2(m, q2, t[2], C)
+	C, t[2] = madd2(m, q3, t[3], C)
+	C, t[3] = madd2(m, q4, t[4], C)
+	C, t[4] = madd2(m, q5, t[5], C)
+	t[5], C = bits.Add64(t[6], C, 0)
+	t[6], _ = bits.Add64(0, D, C)
+	C, t[0] = madd1(y[3], x[0], t[0])
+	C, t[1] = madd2(y[3], x[1], t[1], C)
+	C, t[2] = madd2(y[3], x[2], t[2], C)
+	C, t[3] = madd2(y[3], x[3], t[3], C)
+	C, t[4] = madd2(y[3], x[4], t[4], C)
+	C, t[5] = madd2(y[3], x[5], t[5], C)
+	t[6], D = bits.Add64(t[6], C, 0)
+	m = t[0] * qInvNeg
+	C = madd0(m, q0, t[0])
+	C, t[0] = madd2(m, q1, t[1], C)
+	C, t[1] = madd2(m, q2, t[2], C)
+	C, t[2] = madd2(m, q3, t[3], C)
+	C, t[3] = madd2(m, q4, t[4], C)
+	C, t[4] = madd2(m, q5, t[5], C)
+	t[5], C = bits.Add64(t[6], C, 0)
+	t[6], _ = bits.Add64(0, D, C)
+	C, t[0] = madd1(y[4], x[0], t[0])
+	C, t[1] = madd2(y[4], x[1], t[1], C)
+	C, t[2] = madd2(y[4], x[2], t[2], C)
+	C, t[3] = madd2(y[4], x[3], t[3], C)
+	C, t[4] = madd2(y[4], x[4], t[4], C)
+	C, t[5] = madd2(y[4], x[5], t[5], C)
+	t[6], D = bits.Add64(t[6], C, 0)
+	m = t[0] * qInvNeg
+	C = madd0(m, q0, t[0])
+	C, t[0] = madd2(m, q1, t[1], C)
+	C, t[1] = madd2(m, q2, t[2], C)
+	C, t[2] = madd2(m, q3, t[3], C)
+	C, t[3] = madd2(m, q4, t[4], C)
+	C, t[4] = madd2(m, q5, t[5], C)
+	t[5], C = bits.Add64(t[6], C, 0)
+	t[6], _ = bits.Add64(0, D, C)
+	C, t[0] = madd1(y[5], x[0], t[0])
+	C, t[1] = madd2(y[5], x[1], t[1], C)
+	C, t[2] = madd2(y[5], x[2], t[2], C)
+	C, t[3] = madd2(y[5], x[3], t[3], C)
+	C, t[4] = madd2(y[5], x[4], t[4], C)
+	C, t[5] = madd2(y[5], x[5], t[5], C)
+	t[6], D = bits.Add64(t[6], C, 0)
+	m = t[0] * qInvNeg
+	C = madd0(m, q0, t[0])
+	C, t[0] = madd2(m, q1, t[1], C)
+	C, t[1] = madd2(m, q2, t[2], C)
+	C, t[2] = madd2(m, q3, t[3], C)
+	C, t[3] = madd2(m, q4, t[4], C)
+	C, t[4] = madd2(m, q5, t[5], C)
+	t[5], C = bits.Add64(t[6], C, 0)
+	t[6], _ = bits.Add64(0, D, C)
+
+	// t < 2q; s = t - q, and b = 1 iff t < q
+	var s [6]uint64
+	var b uint64
+	s[0], b = bits.Sub64(t[0], q0, 0)
+	s[1], b = bits.Sub64(t[1], q1, b)
+	s[2], b = bits.Sub64(t[2], q2, b)
+	s[3], b = bits.Sub64(t[3], q3, b)
+	s[4], b = bits.Sub64(t[4], q4, b)
+	s[5], b = bits.Sub64(t[5], q5, b)
+	_, b = bits.Sub64(t[6], 0, b)
+
+	// z = t if b == 1, s otherwise
+	mask := -b
+	z[0] = s[0] ^ (mask & (s[0] ^ t[0]))
+	z[1] = s[1] ^ (mask & (s[1] ^ t[1]))
+	z[2] = s[2] ^ (mask & (s[2] ^ t[2]))
+	z[3] = s[3] ^ (mask & (s[3] ^ t[3]))
+	z[4] = s[4] ^ (mask & (s[4] ^ t[4]))
+	z[5] = s[5] ^ (mask & (s[5] ^ t[5]))
+}
+
+// addCT z = x + y (mod q)
+//
+// unlike Add, its running time doesn't depend on x and y
+func addCT(z, x, y *Element) {
+	var t [6]uint64
+	var c uint64
+	t[0], c = bits.Add64(x[0], y[0], 0)
+	t[1], c = bits.Add64(x[1], y[1], c)
+	t[2], c = bits.Add64(x[2], y[2], c)
+	t[3], c = bits.Add64(x[3], y[3], c)
+	t[4], c = bits.Add64(x[4], y[4], c)
+	t[5], c = bits.Add64(x[5], y[5], c)
+
+	// t + c⋅2^384 < 2q; s = t - q, and b = 1 iff t + c⋅2^384 < q
+	var s [6]uint64
+	var b uint64
+	s[0], b = bits.Sub64(t[0], q0, 0)
+	s[1], b = bits.Sub64(t[1], q1, b)
+	s[2], b = bits.Sub64(t[2], q2, b)
+	s[3], b = bits.Sub64(t[3], q3, b)
+	s[4], b = bits.Sub64(t[4], q4, b)
+	s[5], b = bits.Sub64(t[5], q5, b)
+	_, b = bits.Sub64(c, 0, b)
+
+	// z = t if b == 1, s otherwise
+	mask := -b
+	z[0] = s[0] ^ (mask & (s[0] ^ t[0]))
+	z[1] = s[1] ^ (mask & (s[1] ^ t[1]))
+	z[2] = s[2] ^ (mask & (s[2] ^ t[2]))
+	z[3] = s[3] ^ (mask & (s[3] ^ t[3]))
+	z[4] = s[4] ^ (mask & (s[4] ^ t[4]))
+	z[5] = s[5] ^ (mask & (s[5] ^ t[5]))
+}
+
+// subCT z = x - y (mod q)
+//
+// unlike Sub, its running time doesn't depend on x and y
+func subCT(z, x, y *Element) {
+	var b uint64
+	z[0], b = bits.Sub64(x[0], y[0], 0)
+	z[1], b = bits.Sub64(x[1], y[1], b)
+	z[2], b = bits.Sub64(x[2], y[2], b)
+	z[3], b = bits.Sub64(x[3], y[3], b)
+	z[4], b = bits.Sub64(x[4], y[4], b)
+	z[5], b = bits.Sub64(x[5], y[5], b)
+
+	// if x < y, z += q
+	mask := -b
+	var c uint64
+	z[0], c = bits.Add64(z[0], q0&mask, 0)
+	z[1], c = bits.Add64(z[1], q1&mask, c)
+	z[2], c = bits.Add64(z[2], q2&mask, c)
+	z[3], c = bits.Add64(z[3], q3&mask, c)
+	z[4], c = bits.Add64(z[4], q4&mask, c)
+	z[5], _ = bits.Add64(z[5], q5&mask, c)
+}
+
+// MulCT z = x * y (mod q)
+//
+// unlike Mul, its running time doesn't depend on x and y. x may be any 6-word value, and
+// y must be less than q: in particular, z.MulCT(x, &Element{1}) converts x from Montgomery form.
+func (z *Element) MulCT(x, y *Element) *Element {
+	mulCT(z, x, y)
+	return z
+}
+
+// AddCT z = x + y (mod q)
+//
+// unlike Add, its running time doesn't depend on x and y
+func (z *Element) AddCT(x, y *Element) *Element {
+	addCT(z, x, y)
+	return z
+}
+
+// SubCT z = x - y (mod q)
+//
+// unlike Sub, its running time doesn't depend on x and y
+func (z *Element) SubCT(x, y *Element) *Element {
+	subCT(z, x, y)
+	return z
+}
+
+// expCT z = xᵉ (mod q), where e is encoded in big endian in buf
+//
+// expCT is a Montgomery ladder processing the 8*len(buf) bits of e with mulCT and
+// constant-time conditional swaps; its running time only depends on len(buf).
+func (z *Element) expCT(x Element, buf []byte) *Element {
+	// r0 = x^(e >> i), r1 = r0 * x
+	var r0, r1, t0, t1 Element
+	r0.SetOne()
+	r1.Set(&x)
+	for i := 8*len(buf) - 1; i >= 0; i-- {
+		bit := int((buf[len(buf)-1-i/8] >> (i % 8)) & 1)
+
+		// swap r0 and r1 if bit == 1
+		t0.Select(bit, &r0, &r1)
+		t1.Select(bit, &r1, &r0)
+
+		mulCT(&t1, &t0, &t1)
+		mulCT(&t0, &t0, &t0)
+
+		r0.Select(bit, &t0, &t1)
+		r1.Select(bit, &t1, &t0)
+	}
+
+	return z.Set(&r0)
+}
+
+// InverseCT z = x⁻¹ (mod q)
+//
+// if x == 0, sets and returns z = x
+//
+// InverseCT computes x^(q-2) with a fixed sequence of constant-time multiplications;
+// unlike Inverse, its running time doesn't depend on x.
+func (z *Element) InverseCT(x *Element) *Element {
+	return z.expCT(*x, _bInverseCTExponentElement[:])
+}
+
+// ExpCT z = xᵏ (mod q)
+//
+// ExpCT is a Montgomery ladder processing 8*Bytes bits of k, with constant-time multiplications
+// and conditional swaps; unlike Exp, for 0 ⩽ k < 2^Bits its running time doesn't depend on x
+// nor on k. The sign of k isn't hidden: if k < 0, x is inverted with InverseCT. Exponents
+// k ⩾ 2^Bits are first reduced modulo q-1 with math/big, which isn't constant-time.
+func (z *Element) ExpCT(x Element, k *big.Int) *Element {
+	e := k
+	if k.Sign() == -1 {
+		// if k < 0: xᵏ (mod q) == (x⁻¹)ᵏ (mod q)
+		x.InverseCT(&x)
+
+		e = pool.BigInt.Get()
+		defer pool.BigInt.Put(e)
+		e.Neg(k)
+	}
+
+	if e.BitLen() > Bits {
+		// xᵏ == x^((k-1 mod q-1) + 1), including for x == 0 since k > 0
+		one := big.NewInt(1)
+		qMinusOne := Modulus()
+		qMinusOne.Sub(qMinusOne, one)
+
+		r := pool.BigInt.Get()
+		defer pool.BigInt.Put(r)
+		r.Sub(e, one).Mod(r, qMinusOne).Add(r, one)
+		e = r
+	}
+
+	var buf [Bytes]byte
+	e.FillBytes(buf[:])
+	return z.expCT(x, buf[:])
+}
+
+// SqrtCT z = √x (mod q)
+// if the square root doesn't exist (x is not a square mod q)
+// SqrtCT leaves z unchanged and returns nil
+//
+// SqrtCT performs the same sequence of constant-time operations for every x; only the
+// returned value reveals whether x is a square. When both roots exist, the one returned
+// may differ from Sqrt's.
+func (z *Element) SqrtCT(x *Element) *Element {
+	var y, square Element
+	// q ≡ 1 (mod 4)
+	// constant-time Tonelli-Shanks, see RFC 9380, appendix I.4
+
+	var one, w, t, b, c, tmp Element
+	one.SetOne()
+
+	// w = x^((s-1)/2))
+	w.expCT(*x, _bSqrtCTExponentElement[:])
+
+	// y = x^((s+1)/2)) = w * x
+	mulCT(&y, x, &w)
+
+	// t = xˢ = w * y
+	mulCT(&t, &w, &y)
+
+	// c = nonResidue ^ s
+	c = Element{
+		7563926049028936178,
+		2688164645460651601,
+		12112688591437172399,
+		3177973240564633687,
+		14764383749841851163,
+		52487407124055189,
+	}
+
+	for i := uint64(46); i >= 2; i-- {
+		b = t
+		for j := uint64(1); j <= i-2; j++ {
+			mulCT(&b, &b, &b)
+		}
+		// e = 0 iff b == 1
+		e := int(b.NotEqual(&one))
+
+		mulCT(&tmp, &y, &c)
+		y.Select(e, &y, &tmp)
+
+		mulCT(&c, &c, &c)
+
+		mulCT(&tmp, &t, &c)
+		t.Select(e, &t, &tmp)
+	}
+
+	// ensure we found y such that y * y = x
+	mulCT(&square, &y, &y)
+	if square.NotEqual(x) != 0 {
+		return nil
+	}
+	return z.Set(&y)
+}
+
 // negL negates in place [x | xHi] and return the new most significant word xHi
 func negL(x *Element, xHi uint64) uint64 {
 	var b uint64
@@ -17,7 +17,10 @@
 package fr
 
 import (
+	"bytes"
 	"crypto/rand"
+	"encoding/base64"
+	"encoding/gob"
 	"encoding/json"
 	"fmt"
 	"math/big"
@@ -500,6 +503,115 @@ func TestElementInverseExp(t *testing.T) {
 
 }
 
+func TestElementConstantTime(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen()
+
+	properties.Property("MulCT, AddCT and SubCT must match Mul, Add and Sub", prop.ForAll(
+		func(a, b testPairElement) bool {
+			var c, d Element
+			c.Mul(&a.element, &b.element)
+			d.MulCT(&a.element, &b.element)
+			if !c.Equal(&d) {
+				return false
+			}
+			c.Add(&a.element, &b.element)
+			d.AddCT(&a.element, &b.element)
+			if !c.Equal(&d) {
+				return false
+			}
+			c.Sub(&a.element, &b.element)
+			d.SubCT(&a.element, &b.element)
+			return c.Equal(&d)
+		},
+		genA, genA,
+	))
+
+	properties.Property("MulCT by 1 must convert from Montgomery form", prop.ForAll(
+		func(a testPairElement) bool {
+			var d Element
+			d.MulCT(&a.element, &Element{1})
+			return [4]uint64(d) == a.element.Bits()
+		},
+		genA,
+	))
+
+	properties.Property("InverseCT must match Inverse", prop.ForAll(
+		func(a testPairElement) bool {
+			var b, c Element
+			b.Inverse(&a.element)
+			c.InverseCT(&a.element)
+			a.element.InverseCT(&a.element)
+			return b.Equal(&c) && b.Equal(&a.element)
+		},
+		genA,
+	))
+
+	properties.Property("ExpCT must match Exp", prop.ForAll(
+		func(a, b testPairElement) bool {
+			var nb, large big.Int
+			nb.Neg(&b.bigint)
+			large.Lsh(&b.bigint, Bits).Add(&large, &b.bigint)
+
+			var c, d Element
+			res := true
+			for _, k := range []*big.Int{&b.bigint, &nb, &large, big.NewInt(0), big.NewInt(1)} {
+				c.Exp(a.element, k)
+				d.ExpCT(a.element, k)
+				res = res && c.Equal(&d)
+			}
+			return res
+		},
+		genA, genA,
+	))
+
+	properties.Property("SqrtCT must match Sqrt, up to sign", prop.ForAll(
+		func(a testPairElement) bool {
+			var b, c, square Element
+			b.Square(&a.element)
+
+			// a is not necessarily a square, b is
+			for _, x := range []*Element{&a.element, &b} {
+				var s1, s2 Element
+				r1 := s1.Sqrt(x)
+				r2 := s2.SqrtCT(x)
+				if (r1 == nil) != (r2 == nil) {
+					return false
+				}
+				if r1 == nil {
+					continue
+				}
+				c.Neg(&s1)
+				square.Square(&s2)
+				if !(s2.Equal(&s1) || s2.Equal(&c)) || !square.Equal(x) {
+					return false
+				}
+			}
+			return true
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	var zero, z Element
+	if z.SqrtCT(&zero) == nil || !z.IsZero() {
+		t.Fatal("SqrtCT(0) must be 0")
+	}
+	if z.InverseCT(&zero); !z.IsZero() {
+		t.Fatal("InverseCT(0) must be 0")
+	}
+}
+
 func mulByConstant(z *Element, c uint8) {
 	var y Element
 	y.SetUint64(uint64(c))
@@ -2297,8 +2409,8 @@ func TestElementJSON(t *testing.T) {
 
 	assert.Equal(s, decoded, "element -> json -> element round trip failed")
 
-	// decode hex and string values
-	withHexValues := "{\"A\":\"-1\",\"B\":[0,\"0x00000\",\"0x2A\"],\"C\":null,\"D\":\"8000\"}"
+	// decode hex values
+	withHexValues := fmt.Sprintf("{\"A\":\"0x%s\",\"B\":[0,\"0x0\",\"0x%s\"],\"C\":null,\"D\":\"0x%s\"}", s.A.Text(16), s.B[2].Text(16), s.D.Text(16))
 
 	var decodedS S
 	err = json.Unmarshal([]byte(withHexValues), &decodedS)
@@ -2306,6 +2418,165 @@ func TestElementJSON(t *testing.T) {
 
 	assert.Equal(s, decodedS, " json with strings  -> element  failed")
 
+	// non-canonical encodings are rejected
+	for _, invalid := range []string{
+		"\"0x00000\"",
+		"\"0X2a\"",
+		"\"" + formatValue(8000) + "\"",
+		"0" + formatValue(8000),
+		new(big.Int).Add(Modulus(), big.NewInt(8000)).Text(10),
+	} {
+		var a Element
+		assert.Error(json.Unmarshal([]byte(invalid), &a), invalid)
+	}
+}
+
+func TestElementTextEncoding(t *testing.T) {
+	t.Parallel()
+	assert := require.New(t)
+
+	var a, b Element
+	a.SetRandom()
+
+	text, err := a.MarshalText()
+	assert.NoError(err)
+	assert.Equal(a.Text(10), string(text))
+
+	aBytes := a.Bytes()
+	for _, base := range []int{10, 16, 64} {
+		text, err := a.MarshalTextBase(base)
+		assert.NoError(err)
+		switch base {
+		case 10:
+			assert.Equal(a.Text(10), string(text))
+		case 16:
+			assert.Equal("0x"+a.Text(16), string(text))
+		case 64:
+			assert.Equal(base64.StdEncoding.EncodeToString(aBytes[:]), string(text))
+		}
+		b.SetZero()
+		assert.NoError(b.UnmarshalTextBase(text, base))
+		assert.True(a.Equal(&b), "element -> text -> element round trip failed")
+		if base != 64 {
+			b.SetZero()
+			assert.NoError(b.UnmarshalText(text))
+			assert.True(a.Equal(&b), "element -> text -> element round trip failed")
+		}
+
+		encoded, err := a.MarshalJSONBase(base)
+		assert.NoError(err)
+		if base == 10 {
+			expected, err := json.Marshal(&a)
+			assert.NoError(err)
+			assert.Equal(string(expected), string(encoded))
+		} else {
+			assert.Equal("\""+string(text)+"\"", string(encoded))
+		}
+		b.SetZero()
+		assert.NoError(b.UnmarshalJSONBase(encoded, base))
+		assert.True(a.Equal(&b), "element -> json -> element round trip failed")
+		if base != 64 {
+			b.SetZero()
+			assert.NoError(json.Unmarshal(encoded, &b))
+			assert.True(a.Equal(&b), "element -> json -> element round trip failed")
+		}
+	}
+
+	// non-canonical encodings are rejected
+	var aPlusQ big.Int
+	a.BigInt(&aPlusQ)
+	aPlusQ.Add(&aPlusQ, Modulus())
+	allOnes := make([]byte, Bytes)
+	for i := range allOnes {
+		allOnes[i] = 0xff
+	}
+	for _, invalid := range []struct {
+		text string
+		base int
+	}{
+		{"not a number", 10},
+		{aPlusQ.Text(10), 10},
+		{"+" + aPlusQ.Text(10), 10},
+		{"00", 10},
+		{"1_0", 10},
+		{"0x" + aPlusQ.Text(16), 16},
+		{"0x0" + a.Text(16), 16},
+		{"0xA", 16},
+		{"a", 16},
+		{base64.StdEncoding.EncodeToString(allOnes), 64},
+		{base64.StdEncoding.EncodeToString(aBytes[:]) + "\n", 64},
+		{base64.StdEncoding.EncodeToString(aBytes[1:]), 64},
+	} {
+		assert.Error(b.UnmarshalTextBase([]byte(invalid.text), invalid.base), invalid.text)
+		assert.Error(b.UnmarshalJSONBase([]byte("\""+invalid.text+"\""), invalid.base), invalid.text)
+	}
+
+	_, err = a.MarshalTextBase(2)
+	assert.Error(err)
+	_, err = a.MarshalJSONBase(2)
+	assert.Error(err)
+	assert.Error(b.UnmarshalTextBase(text, 2))
+}
+
+func TestElementGob(t *testing.T) {
+	t.Parallel()
+	assert := require.New(t)
+
+	type S struct {
+		A Element
+		B [3]Element
+	}
+	var s, decoded S
+	s.A.SetRandom()
+	s.B[1].SetRandom()
+
+	var buf bytes.Buffer
+	assert.NoError(gob.NewEncoder(&buf).Encode(&s))
+	assert.NoError(gob.NewDecoder(&buf).Decode(&decoded))
+	assert.Equal(s, decoded, "element -> gob -> element round trip failed")
+
+	var a Element
+	a.SetRandom()
+	encoded, err := a.GobEncode()
+	assert.NoError(err)
+	aBytes := a.Bytes()
+	assert.Equal(aBytes[:], encoded)
+	for i := range encoded {
+		encoded[i] = 0xff
+	}
+	assert.Error(a.GobDecode(encoded))
+	assert.Error(a.GobDecode(encoded[1:]))
+}
+
+func TestElementCBOR(t *testing.T) {
+	t.Parallel()
+	assert := require.New(t)
+
+	var a, b Element
+	a.SetRandom()
+
+	encoded, err := a.MarshalCBOR()
+	assert.NoError(err)
+	bytes := a.Bytes()
+	assert.Equal(append([]byte{0x58, Bytes}, bytes[:]...), encoded)
+
+	assert.NoError(b.UnmarshalCBOR(encoded))
+	assert.True(a.Equal(&b), "element -> cbor -> element round trip failed")
+
+	// invalid encodings
+	assert.Error(b.UnmarshalCBOR(nil))
+	assert.Error(b.UnmarshalCBOR(encoded[:len(encoded)-1]))
+	invalid := make([]byte, len(encoded))
+	copy(invalid, encoded)
+	invalid[0] ^= 0x20 // text string instead of byte string
+	assert.Error(b.UnmarshalCBOR(invalid))
+
+	// values greater than q are not canonical
+	copy(invalid, encoded)
+	for i := len(invalid) - Bytes; i < len(invalid); i++ {
+		invalid[i] = 0xff
+	}
+	assert.Error(b.UnmarshalCBOR(invalid))
 }
 
 type testPairElement struct {
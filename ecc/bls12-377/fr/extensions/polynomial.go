@@ -0,0 +1,265 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package extensions
+
+import (
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+)
+
+// PolynomialE2 is a polynomial with coefficients in E2, in canonical basis: p[i] is the coefficient of Xⁱ
+type PolynomialE2 []E2
+
+// NewPolynomialE2 returns the embedding in E2[X] of the polynomial p ∈ fr[X]
+func NewPolynomialE2(p []fr.Element) PolynomialE2 {
+	res := make(PolynomialE2, len(p))
+	for i := range p {
+		res[i].SetElement(&p[i])
+	}
+	return res
+}
+
+// Eval evaluates p at x
+func (p PolynomialE2) Eval(x *E2) E2 {
+	var res E2
+	for i := len(p) - 1; i >= 0; i-- {
+		res.Mul(&res, x).Add(&res, &p[i])
+	}
+	return res
+}
+
+// EvalBase evaluates p at x ∈ fr
+func (p PolynomialE2) EvalBase(x *fr.Element) E2 {
+	var res E2
+	for i := len(p) - 1; i >= 0; i-- {
+		res.MulByElement(&res, x).Add(&res, &p[i])
+	}
+	return res
+}
+
+// Clone returns a copy of the polynomial
+func (p PolynomialE2) Clone() PolynomialE2 {
+	res := make(PolynomialE2, len(p))
+	copy(res, p)
+	return res
+}
+
+// Equal checks equality between two polynomials; trailing zero coefficients are ignored
+func (p PolynomialE2) Equal(other PolynomialE2) bool {
+	if len(p) < len(other) {
+		p, other = other, p
+	}
+	for i := range other {
+		if !p[i].Equal(&other[i]) {
+			return false
+		}
+	}
+	for i := len(other); i < len(p); i++ {
+		if !p[i].IsZero() {
+			return false
+		}
+	}
+	return true
+}
+
+// Add adds p1 to p2, the polynomials may be of different sizes.
+// This function allocates a new slice unless p == p1 or p == p2 is large enough.
+func (p *PolynomialE2) Add(p1, p2 PolynomialE2) *PolynomialE2 {
+	if len(p1) < len(p2) {
+		p1, p2 = p2, p1
+	}
+	res := *p
+	if cap(res) < len(p1) {
+		res = make(PolynomialE2, len(p1))
+	}
+	res = res[:len(p1)]
+	for i := range p2 {
+		res[i].Add(&p1[i], &p2[i])
+	}
+	copy(res[len(p2):], p1[len(p2):])
+	*p = res
+	return p
+}
+
+// Sub subtracts p2 from p1, the polynomials may be of different sizes.
+// This function allocates a new slice unless p == p1 or p == p2 is large enough.
+func (p *PolynomialE2) Sub(p1, p2 PolynomialE2) *PolynomialE2 {
+	n := len(p1)
+	if len(p2) > n {
+		n = len(p2)
+	}
+	res := *p
+	if cap(res) < n {
+		res = make(PolynomialE2, n)
+	}
+	res = res[:n]
+	for i := 0; i < n; i++ {
+		switch {
+		case i < len(p1) && i < len(p2):
+			res[i].Sub(&p1[i], &p2[i])
+		case i < len(p1):
+			res[i] = p1[i]
+		default:
+			res[i].Neg(&p2[i])
+		}
+	}
+	*p = res
+	return p
+}
+
+// ScaleInPlace multiplies p by c
+func (p PolynomialE2) ScaleInPlace(c *E2) {
+	for i := range p {
+		p[i].Mul(&p[i], c)
+	}
+}
+
+// Fold returns p₀(X) + ζ⋅p₁(X), where p(X) = p₀(X²) + X⋅p₁(X²); this is the folding step
+// of FRI, with an extension field challenge
+func (p PolynomialE2) Fold(zeta *E2) PolynomialE2 {
+	res := make(PolynomialE2, (len(p)+1)/2)
+	var t E2
+	for i := range res {
+		res[i] = p[2*i]
+		if 2*i+1 < len(p) {
+			t.Mul(&p[2*i+1], zeta)
+			res[i].Add(&res[i], &t)
+		}
+	}
+	return res
+}
+
+// PolynomialE4 is a polynomial with coefficients in E4, in canonical basis: p[i] is the coefficient of Xⁱ
+type PolynomialE4 []E4
+
+// NewPolynomialE4 returns the embedding in E4[X] of the polynomial p ∈ fr[X]
+func NewPolynomialE4(p []fr.Element) PolynomialE4 {
+	res := make(PolynomialE4, len(p))
+	for i := range p {
+		res[i].SetElement(&p[i])
+	}
+	return res
+}
+
+// Eval evaluates p at x
+func (p PolynomialE4) Eval(x *E4) E4 {
+	var res E4
+	for i := len(p) - 1; i >= 0; i-- {
+		res.Mul(&res, x).Add(&res, &p[i])
+	}
+	return res
+}
+
+// EvalBase evaluates p at x ∈ fr
+func (p PolynomialE4) EvalBase(x *fr.Element) E4 {
+	var res E4
+	for i := len(p) - 1; i >= 0; i-- {
+		res.MulByElement(&res, x).Add(&res, &p[i])
+	}
+	return res
+}
+
+// Clone returns a copy of the polynomial
+func (p PolynomialE4) Clone() PolynomialE4 {
+	res := make(PolynomialE4, len(p))
+	copy(res, p)
+	return res
+}
+
+// Equal checks equality between two polynomials; trailing zero coefficients are ignored
+func (p PolynomialE4) Equal(other PolynomialE4) bool {
+	if len(p) < len(other) {
+		p, other = other, p
+	}
+	for i := range other {
+		if !p[i].Equal(&other[i]) {
+			return false
+		}
+	}
+	for i := len(other); i < len(p); i++ {
+		if !p[i].IsZero() {
+			return false
+		}
+	}
+	return true
+}
+
+// Add adds p1 to p2, the polynomials may be of different sizes.
+// This function allocates a new slice unless p == p1 or p == p2 is large enough.
+func (p *PolynomialE4) Add(p1, p2 PolynomialE4) *PolynomialE4 {
+	if len(p1) < len(p2) {
+		p1, p2 = p2, p1
+	}
+	res := *p
+	if cap(res) < len(p1) {
+		res = make(PolynomialE4, len(p1))
+	}
+	res = res[:len(p1)]
+	for i := range p2 {
+		res[i].Add(&p1[i], &p2[i])
+	}
+	copy(res[len(p2):], p1[len(p2):])
+	*p = res
+	return p
+}
+
+// Sub subtracts p2 from p1, the polynomials may be of different sizes.
+// This function allocates a new slice unless p == p1 or p == p2 is large enough.
+func (p *PolynomialE4) Sub(p1, p2 PolynomialE4) *PolynomialE4 {
+	n := len(p1)
+	if len(p2) > n {
+		n = len(p2)
+	}
+	res := *p
+	if cap(res) < n {
+		res = make(PolynomialE4, n)
+	}
+	res = res[:n]
+	for i := 0; i < n; i++ {
+		switch {
+		case i < len(p1) && i < len(p2):
+			res[i].Sub(&p1[i], &p2[i])
+		case i < len(p1):
+			res[i] = p1[i]
+		default:
+			res[i].Neg(&p2[i])
+		}
+	}
+	*p = res
+	return p
+}
+
+// ScaleInPlace multiplies p by c
+func (p PolynomialE4) ScaleInPlace(c *E4) {
+	for i := range p {
+		p[i].Mul(&p[i], c)
+	}
+}
+
+// Fold returns p₀(X) + ζ⋅p₁(X), where p(X) = p₀(X²) + X⋅p₁(X²); this is the folding step
+// of FRI, with an extension field challenge
+func (p PolynomialE4) Fold(zeta *E4) PolynomialE4 {
+	res := make(PolynomialE4, (len(p)+1)/2)
+	var t E4
+	for i := range res {
+		res[i] = p[2*i]
+		if 2*i+1 < len(p) {
+			t.Mul(&p[2*i+1], zeta)
+			res[i].Add(&res[i], &t)
+		}
+	}
+	return res
+}
@@ -257,7 +257,8 @@ func (c *eqTimesGateEvalSumcheckClaims) computeGJ() polynomial.Polynomial {
 	computeAll := func(start, end int) {
 		var step fr.Element
 
-		res := make([]fr.Element, degGJ)
+		// the sums of products are accumulated without reduction
+		res := make([]fr.Accumulator, degGJ)
 		operands := make([]fr.Element, degGJ*nbInner)
 
 		for i := start; i < end; i++ {
@@ -276,14 +277,14 @@ func (c *eqTimesGateEvalSumcheckClaims) computeGJ() polynomial.Polynomial {
 			_e := nbInner
 			for d := 0; d < degGJ; d++ {
 				summand := c.wire.Gate.Evaluate(operands[_s+1 : _e]...)
-				summand.Mul(&summand, &operands[_s])
-				res[d].Add(&res[d], &summand)
+				res[d].MulAcc(&summand, &operands[_s])
 				_s, _e = _e, _e+nbInner
 			}
 		}
 		mu.Lock()
 		for i := 0; i < len(gJ); i++ {
-			gJ[i].Add(&gJ[i], &res[i])
+			r := res[i].Reduce()
+			gJ[i].Add(&gJ[i], &r)
 		}
 		mu.Unlock()
 	}
@@ -25,32 +25,50 @@ import (
 
 func TestPolynomialEval(t *testing.T) {
 
-	// build polynomial
-	f := make(Polynomial, 20)
-	for i := 0; i < 20; i++ {
-		f[i].SetOne()
+	// small polynomials use Horner's rule, larger ones are evaluated by blocks
+	for _, size := range []int{20, 2 * evalBlockSize, 5*evalBlockSize + 3} {
+
+		// build polynomial
+		f := make(Polynomial, size)
+		for i := 0; i < size; i++ {
+			f[i].SetOne()
+		}
+
+		// random value
+		var point fr.Element
+		point.SetRandom()
+
+		// compute manually f(val)
+		var expectedEval, one, den fr.Element
+		var expo big.Int
+		one.SetOne()
+		expo.SetUint64(uint64(size))
+		expectedEval.Exp(point, &expo).
+			Sub(&expectedEval, &one)
+		den.Sub(&point, &one)
+		expectedEval.Div(&expectedEval, &den)
+
+		// compute purported evaluation
+		purportedEval := f.Eval(&point)
+
+		// check
+		if !purportedEval.Equal(&expectedEval) {
+			t.Fatalf("polynomial evaluation failed for size %d", size)
+		}
 	}
+}
 
-	// random value
+func BenchmarkPolynomialEval(b *testing.B) {
+	f := make(Polynomial, 1<<15)
+	for i := range f {
+		f[i].SetRandom()
+	}
 	var point fr.Element
 	point.SetRandom()
 
-	// compute manually f(val)
-	var expectedEval, one, den fr.Element
-	var expo big.Int
-	one.SetOne()
-	expo.SetUint64(20)
-	expectedEval.Exp(point, &expo).
-		Sub(&expectedEval, &one)
-	den.Sub(&point, &one)
-	expectedEval.Div(&expectedEval, &den)
-
-	// compute purported evaluation
-	purportedEval := f.Eval(&point)
-
-	// check
-	if !purportedEval.Equal(&expectedEval) {
-		t.Fatal("polynomial evaluation failed")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = f.Eval(&point)
 	}
 }
 
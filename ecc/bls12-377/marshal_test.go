@@ -19,6 +19,9 @@ package bls12377
 import (
 	"bytes"
 	crand "crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"io"
 	"math/big"
 	"math/rand/v2"
@@ -339,7 +342,137 @@ func TestG1AffineSerialization(t *testing.T) {
 		GenFp(),
 	))
 
+	properties.Property("[G1] Affine UnmarshalHex(MarshalHex()) should stay the same", prop.ForAll(
+		func(a fp.Element) bool {
+			var start, end G1Affine
+			var ab big.Int
+			a.BigInt(&ab)
+			start.ScalarMultiplication(&g1GenAff, &ab)
+
+			text, err := start.MarshalHex()
+			if err != nil {
+				return false
+			}
+			buf := start.Bytes()
+			if string(text) != hex.EncodeToString(buf[:]) {
+				return false
+			}
+			if err := end.UnmarshalHex(text); err != nil {
+				return false
+			}
+			return start.Equal(&end)
+		},
+		GenFp(),
+	))
+
+	properties.Property("[G1] Affine UnmarshalBase64(MarshalBase64()) should stay the same", prop.ForAll(
+		func(a fp.Element) bool {
+			var start, end G1Affine
+			var ab big.Int
+			a.BigInt(&ab)
+			start.ScalarMultiplication(&g1GenAff, &ab)
+
+			text, err := start.MarshalBase64()
+			if err != nil {
+				return false
+			}
+			buf := start.Bytes()
+			if string(text) != base64.StdEncoding.EncodeToString(buf[:]) {
+				return false
+			}
+			if err := end.UnmarshalBase64(text); err != nil {
+				return false
+			}
+			return start.Equal(&end)
+		},
+		GenFp(),
+	))
+
+	properties.Property("[G1] Affine json.Unmarshal(json.Marshal()) should stay the same", prop.ForAll(
+		func(a fp.Element) bool {
+			var start, end G1Affine
+			var ab big.Int
+			a.BigInt(&ab)
+			start.ScalarMultiplication(&g1GenAff, &ab)
+
+			encoded, err := json.Marshal(&start)
+			if err != nil {
+				return false
+			}
+			// the encoding is the {"X":…,"Y":…} object of the coordinates
+			var coordinates struct{ X, Y json.RawMessage }
+			if err := json.Unmarshal(encoded, &coordinates); err != nil || coordinates.X == nil || coordinates.Y == nil {
+				return false
+			}
+			if err := json.Unmarshal(encoded, &end); err != nil {
+				return false
+			}
+			return start.Equal(&end)
+		},
+		GenFp(),
+	))
+
+	properties.Property("[G1] Affine UnmarshalCBOR(MarshalCBOR()) should stay the same", prop.ForAll(
+		func(a fp.Element) bool {
+			var start, end G1Affine
+			var ab big.Int
+			a.BigInt(&ab)
+			start.ScalarMultiplication(&g1GenAff, &ab)
+
+			encoded, err := start.MarshalCBOR()
+			if err != nil {
+				return false
+			}
+			if err := end.UnmarshalCBOR(encoded); err != nil {
+				return false
+			}
+			// a truncated encoding must be rejected
+			if err := end.UnmarshalCBOR(encoded[:len(encoded)-1]); err == nil {
+				return false
+			}
+			return start.Equal(&end)
+		},
+		GenFp(),
+	))
+
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	// invalid hex encodings
+	var p G1Affine
+	if err := p.UnmarshalHex([]byte("zz")); err == nil {
+		t.Fatal("UnmarshalHex should fail on invalid hex")
+	}
+	text, _ := g1GenAff.MarshalHex()
+	if err := p.UnmarshalHex(append(text, '0', '0')); err == nil {
+		t.Fatal("UnmarshalHex should fail on trailing bytes")
+	}
+
+	// non-canonical encodings: upper case hex digits, uncompressed representation
+	var q G1Affine
+	q.ScalarMultiplication(&g1GenAff, big.NewInt(42))
+	text, _ = q.MarshalHex()
+	if err := p.UnmarshalHex(bytes.ToUpper(text)); err == nil {
+		t.Fatal("UnmarshalHex should fail on upper case digits")
+	}
+	raw := q.RawBytes()
+	if err := p.UnmarshalHex([]byte(hex.EncodeToString(raw[:]))); err == nil {
+		t.Fatal("UnmarshalHex should fail on the uncompressed representation")
+	}
+	if err := p.UnmarshalBase64([]byte(base64.StdEncoding.EncodeToString(raw[:]))); err == nil {
+		t.Fatal("UnmarshalBase64 should fail on the uncompressed representation")
+	}
+	text, _ = q.MarshalBase64()
+	if err := p.UnmarshalBase64(text[:len(text)-4]); err == nil {
+		t.Fatal("UnmarshalBase64 should fail on a truncated encoding")
+	}
+	if i := bytes.IndexByte(text, '='); i > 0 {
+		// set the lowest of the unused bits of the last character, ignored by the decoder
+		const alphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/"
+		text[i-1] = alphabet[bytes.IndexByte([]byte(alphabet), text[i-1])+1]
+		if err := p.UnmarshalBase64(text); err == nil {
+			t.Fatal("UnmarshalBase64 should fail on non-zero padding bits")
+		}
+	}
 }
 
 func TestG2AffineInvalidBitMask(t *testing.T) {
@@ -452,7 +585,137 @@ func TestG2AffineSerialization(t *testing.T) {
 		GenFp(),
 	))
 
+	properties.Property("[G2] Affine UnmarshalHex(MarshalHex()) should stay the same", prop.ForAll(
+		func(a fp.Element) bool {
+			var start, end G2Affine
+			var ab big.Int
+			a.BigInt(&ab)
+			start.ScalarMultiplication(&g2GenAff, &ab)
+
+			text, err := start.MarshalHex()
+			if err != nil {
+				return false
+			}
+			buf := start.Bytes()
+			if string(text) != hex.EncodeToString(buf[:]) {
+				return false
+			}
+			if err := end.UnmarshalHex(text); err != nil {
+				return false
+			}
+			return start.Equal(&end)
+		},
+		GenFp(),
+	))
+
+	properties.Property("[G2] Affine UnmarshalBase64(MarshalBase64()) should stay the same", prop.ForAll(
+		func(a fp.Element) bool {
+			var start, end G2Affine
+			var ab big.Int
+			a.BigInt(&ab)
+			start.ScalarMultiplication(&g2GenAff, &ab)
+
+			text, err := start.MarshalBase64()
+			if err != nil {
+				return false
+			}
+			buf := start.Bytes()
+			if string(text) != base64.StdEncoding.EncodeToString(buf[:]) {
+				return false
+			}
+			if err := end.UnmarshalBase64(text); err != nil {
+				return false
+			}
+			return start.Equal(&end)
+		},
+		GenFp(),
+	))
+
+	properties.Property("[G2] Affine json.Unmarshal(json.Marshal()) should stay the same", prop.ForAll(
+		func(a fp.Element) bool {
+			var start, end G2Affine
+			var ab big.Int
+			a.BigInt(&ab)
+			start.ScalarMultiplication(&g2GenAff, &ab)
+
+			encoded, err := json.Marshal(&start)
+			if err != nil {
+				return false
+			}
+			// the encoding is the {"X":…,"Y":…} object of the coordinates
+			var coordinates struct{ X, Y json.RawMessage }
+			if err := json.Unmarshal(encoded, &coordinates); err != nil || coordinates.X == nil || coordinates.Y == nil {
+				return false
+			}
+			if err := json.Unmarshal(encoded, &end); err != nil {
+				return false
+			}
+			return start.Equal(&end)
+		},
+		GenFp(),
+	))
+
+	properties.Property("[G2] Affine UnmarshalCBOR(MarshalCBOR()) should stay the same", prop.ForAll(
+		func(a fp.Element) bool {
+			var start, end G2Affine
+			var ab big.Int
+			a.BigInt(&ab)
+			start.ScalarMultiplication(&g2GenAff, &ab)
+
+			encoded, err := start.MarshalCBOR()
+			if err != nil {
+				return false
+			}
+			if err := end.UnmarshalCBOR(encoded); err != nil {
+				return false
+			}
+			// a truncated encoding must be rejected
+			if err := end.UnmarshalCBOR(encoded[:len(encoded)-1]); err == nil {
+				return false
+			}
+			return start.Equal(&end)
+		},
+		GenFp(),
+	))
+
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	// invalid hex encodings
+	var p G2Affine
+	if err := p.UnmarshalHex([]byte("zz")); err == nil {
+		t.Fatal("UnmarshalHex should fail on invalid hex")
+	}
+	text, _ := g2GenAff.MarshalHex()
+	if err := p.UnmarshalHex(append(text, '0', '0')); err == nil {
+		t.Fatal("UnmarshalHex should fail on trailing bytes")
+	}
+
+	// non-canonical encodings: upper case hex digits, uncompressed representation
+	var q G2Affine
+	q.ScalarMultiplication(&g2GenAff, big.NewInt(42))
+	text, _ = q.MarshalHex()
+	if err := p.UnmarshalHex(bytes.ToUpper(text)); err == nil {
+		t.Fatal("UnmarshalHex should fail on upper case digits")
+	}
+	raw := q.RawBytes()
+	if err := p.UnmarshalHex([]byte(hex.EncodeToString(raw[:]))); err == nil {
+		t.Fatal("UnmarshalHex should fail on the uncompressed representation")
+	}
+	if err := p.UnmarshalBase64([]byte(base64.StdEncoding.EncodeToString(raw[:]))); err == nil {
+		t.Fatal("UnmarshalBase64 should fail on the uncompressed representation")
+	}
+	text, _ = q.MarshalBase64()
+	if err := p.UnmarshalBase64(text[:len(text)-4]); err == nil {
+		t.Fatal("UnmarshalBase64 should fail on a truncated encoding")
+	}
+	if i := bytes.IndexByte(text, '='); i > 0 {
+		// set the lowest of the unused bits of the last character, ignored by the decoder
+		const alphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/"
+		text[i-1] = alphabet[bytes.IndexByte([]byte(alphabet), text[i-1])+1]
+		if err := p.UnmarshalBase64(text); err == nil {
+			t.Fatal("UnmarshalBase64 should fail on non-zero padding bits")
+		}
+	}
 }
 
 // define Gopters generators
@@ -0,0 +1,29 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package decaf provides a prime order group built on bls12-377's twisted edwards "companion curve".
+//
+// The companion curve E has cofactor 4: its points are of the form P + T, with P in the prime
+// order subgroup and T in the torsion subgroup E[4]. Following Decaf (Hamburg,
+// https://eprint.iacr.org/2015/673) and Ristretto (https://ristretto.group), the group is the
+// quotient E / E[4], which has prime order: any point of the curve represents an element, and
+// two points represent the same element if they differ by a point of E[4].
+//
+// Each element has a canonical encoding, the y-coordinate of a canonical representative, and the
+// decoding rejects any other encoding, so that encodings are not malleable and decoded elements
+// need neither a subgroup check nor a cofactor clearing. Elements can also be hashed to with
+// Elligator 2.
+package decaf
@@ -0,0 +1,42 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package decaf
+
+import (
+	fp "github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/twistededwards"
+)
+
+// HashToGroup hashes msg to an element, with the domain separation tag dst: following RFC 9380,
+// two field elements u₀ and u₁ are derived from msg (hash_to_field, with expand_message_xmd and
+// SHA-256) and mapped to the curve with Elligator 2, and the result is the class of the sum of the
+// two points: no cofactor clearing is needed, as any point of the curve represents an element.
+func HashToGroup(msg, dst []byte) (Element, error) {
+	u, err := fp.Hash(msg, dst, 2)
+	if err != nil {
+		return Element{}, err
+	}
+	q0, q1 := twistededwards.MapToCurve(&u[0]), twistededwards.MapToCurve(&u[1])
+	var p0, p1 twistededwards.PointExtended
+	p0.FromAffine(&q0)
+	p1.FromAffine(&q1)
+	p0.Add(&p0, &p1)
+
+	var res Element
+	res.FromExtended(&p0)
+	return res, nil
+}
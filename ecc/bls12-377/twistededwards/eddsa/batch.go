@@ -0,0 +1,175 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package eddsa
+
+import (
+	"crypto/rand"
+	"errors"
+	"hash"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/twistededwards"
+	scalarfield "github.com/consensys/gnark-crypto/ecc/bls12-377/twistededwards/fr"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var errBatchSize = errors.New("pubs, msgs and sigs must have the same length")
+
+// sizeBatchCoefficient size in bytes of the random coefficients of the linear combination
+const sizeBatchCoefficient = 16
+
+// batchEntry a signature to verify in a batch, once deserialized: zᵢ⋅S⋅B = zᵢ⋅(R + H(R,A,M)⋅A)
+// up to the cofactor, with the random coefficient zᵢ
+type batchEntry struct {
+	R, A twistededwards.PointAffine
+	s    scalarfield.Element // zᵢ⋅S
+	z    scalarfield.Element // zᵢ
+	zH   scalarfield.Element // zᵢ⋅H(R,A,M)
+}
+
+// BatchVerify verifies the eddsa signatures sigs of the messages msgs by the public keys pubs,
+// with the hash function hFunc, as PublicKey.Verify does for each of them.
+//
+// The signatures are checked all at once with a random linear combination of the verification
+// equations, and a single multi-exponentiation: with random 128-bit zᵢ,
+//
+//	cofactor⋅((∑ᵢ zᵢ⋅Sᵢ)⋅B - ∑ᵢ zᵢ⋅Rᵢ - ∑ᵢ zᵢ⋅H(Rᵢ,Aᵢ,Mᵢ)⋅Aᵢ) = 0
+//
+// If the check fails, the batch is split in halves to find the invalid signature.
+//
+// It returns -1 if all the signatures are valid, and otherwise the index of the first invalid one,
+// along with an error if this signature or its public key are malformed: the signatures before the
+// first malformed one are checked, and an invalid one among them takes precedence. An error is
+// returned with the index -1 if the inputs don't have the same length or if hFunc is nil.
+func BatchVerify(pubs []PublicKey, msgs [][]byte, sigs [][]byte, hFunc hash.Hash) (int, error) {
+
+	// hFunc cannot be nil.
+	// We need a hash function for the Fiat-Shamir.
+	if hFunc == nil {
+		return -1, errHashNeeded
+	}
+	if len(pubs) != len(msgs) || len(pubs) != len(sigs) {
+		return -1, errBatchSize
+	}
+
+	// deserialize the signatures and compute the coefficients of the linear combination, up to
+	// the first malformed signature
+	entries := make([]batchEntry, len(pubs))
+	malformed := -1
+	var errMalformed error
+	var zBytes [sizeBatchCoefficient]byte
+	var hram big.Int
+	for i := range entries {
+		e := &entries[i]
+
+		// verify that pubKey and R are on the curve
+		if !pubs[i].A.IsOnCurve() {
+			malformed, errMalformed = i, errNotOnCurve
+			break
+		}
+		e.A.Set(&pubs[i].A)
+
+		var sig Signature
+		if _, err := sig.SetBytes(sigs[i]); err != nil {
+			malformed, errMalformed = i, err
+			break
+		}
+		e.R.Set(&sig.R)
+
+		if err := computeHRAM(&hram, &e.R, &e.A, msgs[i], hFunc); err != nil {
+			return -1, err
+		}
+
+		if _, err := rand.Read(zBytes[:]); err != nil {
+			return -1, err
+		}
+		e.z.SetBytes(zBytes[:])
+		// S < r is checked by Signature.SetBytes
+		e.s.SetBytes(sig.S[:])
+		e.s.Mul(&e.s, &e.z)
+		e.zH.SetBigInt(&hram)
+		e.zH.Mul(&e.zH, &e.z)
+	}
+
+	// an invalid signature before the first malformed one is reported first
+	if malformed != -1 {
+		entries = entries[:malformed]
+	}
+	if len(entries) != 0 {
+		ok, err := batchCheck(entries)
+		if err != nil {
+			return -1, err
+		}
+		if !ok {
+			return firstInvalid(entries, 0)
+		}
+	}
+	return malformed, errMalformed
+}
+
+// firstInvalid returns the index of the first invalid signature in entries, which don't verify
+// as a batch, offset being the index of entries[0] in the batch
+func firstInvalid(entries []batchEntry, offset int) (int, error) {
+	if len(entries) == 1 {
+		return offset, nil
+	}
+	m := len(entries) / 2
+	ok, err := batchCheck(entries[:m])
+	if err != nil {
+		return -1, err
+	}
+	if !ok {
+		return firstInvalid(entries[:m], offset)
+	}
+	return firstInvalid(entries[m:], offset+m)
+}
+
+// batchCheck returns true if the random linear combination of the verification equations of
+// entries holds
+func batchCheck(entries []batchEntry) (bool, error) {
+	curveParams := twistededwards.GetEdwardsCurve()
+	n := len(entries)
+
+	// the points B, R₀, ..., Rₙ₋₁, A₀, ..., Aₙ₋₁ and the scalars -∑ᵢ zᵢ⋅Sᵢ, zᵢ, zᵢ⋅H(Rᵢ,Aᵢ,Mᵢ)
+	points := make([]twistededwards.PointAffine, 2*n+1)
+	scalars := make([]scalarfield.Element, 2*n+1)
+	points[0].Set(&curveParams.Base)
+	parallel.Execute(n, func(start, end int) {
+		for i := start; i < end; i++ {
+			points[1+i].Set(&entries[i].R)
+			points[1+n+i].Set(&entries[i].A)
+			scalars[1+i].Set(&entries[i].z)
+			scalars[1+n+i].Set(&entries[i].zH)
+		}
+	})
+	for i := range entries {
+		scalars[0].Add(&scalars[0], &entries[i].s)
+	}
+	scalars[0].Neg(&scalars[0])
+
+	var res twistededwards.PointExtended
+	if _, err := res.MultiExp(points, scalars, ecc.MultiExpConfig{}); err != nil {
+		return false, err
+	}
+
+	// multiply by the cofactor, the points being possibly outside of the prime order subgroup
+	var bCofactor big.Int
+	curveParams.Cofactor.BigInt(&bCofactor)
+	res.ScalarMultiplication(&res, &bCofactor)
+	return res.IsZero(), nil
+}
@@ -0,0 +1,229 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package twistededwards
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestHashToField(t *testing.T) {
+	for _, c := range encodeToCurveVector.cases {
+		elems, err := fr.Hash([]byte(c.msg), encodeToCurveVector.dst, 1)
+		if err != nil {
+			t.Error(err)
+		}
+		testMatchCoord(t, "u", c.msg, c.u, &elems[0])
+	}
+
+	for _, c := range hashToCurveVector.cases {
+		elems, err := fr.Hash([]byte(c.msg), hashToCurveVector.dst, 2)
+		if err != nil {
+			t.Error(err)
+		}
+		testMatchCoord(t, "u0", c.msg, c.u0, &elems[0])
+		testMatchCoord(t, "u1", c.msg, c.u1, &elems[1])
+	}
+}
+
+func TestMapToCurve(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("Elligator 2 output must be on curve", prop.ForAll(
+		func(s []byte) bool {
+			var u fr.Element
+			u.SetBytes(s)
+			p := MapToCurve(&u)
+			return p.IsOnCurve()
+		},
+		gopter.Gen(genBytes),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	// 1 + Z⋅u² is never zero, -1/Z not being a square, and u = 0 maps to x₁ = -J/K
+	var u fr.Element
+	p := MapToCurve(&u)
+	if !p.IsOnCurve() {
+		t.Error("Elligator 2 output of 0 not on curve")
+	}
+
+	for _, c := range encodeToCurveVector.cases {
+		setString(&u, c.u)
+		q := MapToCurve(&u)
+		testMatchPoint(t, "Q", c.msg, c.Q, &q)
+	}
+
+	for _, c := range hashToCurveVector.cases {
+		setString(&u, c.u0)
+		q := MapToCurve(&u)
+		testMatchPoint(t, "Q0", c.msg, c.Q0, &q)
+
+		setString(&u, c.u1)
+		q = MapToCurve(&u)
+		testMatchPoint(t, "Q1", c.msg, c.Q1, &q)
+	}
+}
+
+func TestEncodeToCurve(t *testing.T) {
+	t.Parallel()
+	for _, c := range encodeToCurveVector.cases {
+		p, err := EncodeToCurve([]byte(c.msg), encodeToCurveVector.dst)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testMatchPoint(t, "P", c.msg, c.P, &p)
+		testInSubgroup(t, c.msg, &p)
+	}
+}
+
+func TestHashToCurve(t *testing.T) {
+	t.Parallel()
+	for _, c := range hashToCurveVector.cases {
+		p, err := HashToCurve([]byte(c.msg), hashToCurveVector.dst)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testMatchPoint(t, "P", c.msg, c.P, &p)
+		testInSubgroup(t, c.msg, &p)
+	}
+
+	// the suite identifier is a suffix of the domain separation tag of the vectors
+	dst := string(hashToCurveVector.dst)
+	if dst[len(dst)-len(HashToCurveSuiteID):] != HashToCurveSuiteID {
+		t.Errorf("unexpected suite identifier %s", HashToCurveSuiteID)
+	}
+	dst = string(encodeToCurveVector.dst)
+	if dst[len(dst)-len(EncodeToCurveSuiteID):] != EncodeToCurveSuiteID {
+		t.Errorf("unexpected suite identifier %s", EncodeToCurveSuiteID)
+	}
+}
+
+func BenchmarkEncodeToCurve(b *testing.B) {
+	const size = 54
+	bytes := make([]byte, size)
+	dst := []byte(EncodeToCurveSuiteID)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		bytes[1] = byte(i)
+		bytes[2] = byte(i >> 8)
+		bytes[3] = byte(i >> 16)
+		bytes[4] = byte(i >> 24)
+		if _, err := EncodeToCurve(bytes, dst); err != nil {
+			b.Fail()
+		}
+	}
+}
+
+func BenchmarkHashToCurve(b *testing.B) {
+	const size = 54
+	bytes := make([]byte, size)
+	dst := []byte(HashToCurveSuiteID)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		bytes[1] = byte(i)
+		bytes[2] = byte(i >> 8)
+		bytes[3] = byte(i >> 16)
+		bytes[4] = byte(i >> 24)
+		if _, err := HashToCurve(bytes, dst); err != nil {
+			b.Fail()
+		}
+	}
+}
+
+type point struct {
+	x string
+	y string
+}
+
+type encodeTestVector struct {
+	dst   []byte
+	cases []encodeTestCase
+}
+
+type hashTestVector struct {
+	dst   []byte
+	cases []hashTestCase
+}
+
+type encodeTestCase struct {
+	msg string
+	P   point //final output
+	Q   point //pre-cofactor-clearing point
+	u   string
+}
+
+type hashTestCase struct {
+	msg string
+	P   point  //final output
+	Q0  point  //pre-cofactor-clearing point
+	Q1  point  //pre-cofactor-clearing point
+	u0  string //first hash output
+	u1  string //second hash output
+}
+
+var encodeToCurveVector encodeTestVector
+var hashToCurveVector hashTestVector
+
+func setString(z *fr.Element, s string) {
+	if _, err := z.SetString(s); err != nil {
+		panic(err)
+	}
+}
+
+func testMatchCoord(t *testing.T, coordName string, msg string, expectedStr string, seen *fr.Element) {
+	var expected fr.Element
+	setString(&expected, expectedStr)
+
+	if !expected.Equal(seen) {
+		t.Errorf("mismatch on \"%s\", %s:\n\texpected %s\n\tsaw      %s", msg, coordName, expected.String(), seen.String())
+	}
+}
+
+func testMatchPoint(t *testing.T, pointName string, msg string, expected point, seen *PointAffine) {
+	testMatchCoord(t, pointName+".x", msg, expected.x, &seen.X)
+	testMatchCoord(t, pointName+".y", msg, expected.y, &seen.Y)
+}
+
+func testInSubgroup(t *testing.T, msg string, p *PointAffine) {
+	params := GetEdwardsCurve()
+	var q PointExtended
+	q.FromAffine(p)
+	q.ScalarMultiplication(&q, &params.Order)
+	if !p.IsOnCurve() || !q.IsZero() {
+		t.Errorf("output of \"%s\" not in the prime order subgroup", msg)
+	}
+}
+
+func genBytes(genParams *gopter.GenParameters) *gopter.GenResult {
+	b := make([]byte, fr.Bytes)
+	genParams.Rng.Read(b) //#nosec G404 weak rng is fine here
+	return gopter.NewGenResult(b, gopter.NoShrinker)
+}
@@ -0,0 +1,308 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package twistededwards
+
+import (
+	"errors"
+	"math"
+	"runtime"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	scalarfield "github.com/consensys/gnark-crypto/ecc/bls12-377/twistededwards/fr"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// MultiExp implements section 4 of https://eprint.iacr.org/2012/549.pdf
+// and sets p to ∑ᵢ scalars[i]⋅points[i].
+//
+// The unified addition is used in the buckets, so that the points don't need to be in the prime
+// order subgroup.
+//
+// This call return an error if len(scalars) != len(points) or if provided config is invalid.
+func (p *PointExtended) MultiExp(points []PointAffine, scalars []scalarfield.Element, config ecc.MultiExpConfig) (*PointExtended, error) {
+	// note:
+	// step 1
+	// we compute, for each scalars over c-bit wide windows, nbChunk digits
+	// if the digit is larger than 2^{c-1}, then, we borrow 2^c from the next window and subtract
+	// 2^{c} to the current digit, making it negative.
+	// negative digits will be processed in the next step as adding -G into the bucket instead of G
+	// (computing -G is cheap, and this saves us half of the buckets)
+	// step 2
+	// each chunk is processed in its own go routine, which places points into buckets based on
+	// their digit and returns the weighted bucket sum
+	// step 3
+	// reduce the buckets weighed sums into our result (msmReduceChunk)
+
+	// ensure len(points) == len(scalars)
+	nbPoints := len(points)
+	if nbPoints != len(scalars) {
+		return nil, errors.New("len(points) != len(scalars)")
+	}
+
+	// if nbTasks is not set, use all available CPUs
+	if config.NbTasks <= 0 {
+		config.NbTasks = runtime.NumCPU() * 2
+	} else if config.NbTasks > 1024 {
+		return nil, errors.New("invalid config: config.NbTasks > 1024")
+	}
+
+	// here, we compute the best C for nbPoints
+	// approximate cost (in group operations)
+	// cost = bits/c * (nbPoints + 2^{c})
+	// c ≤ 14, so that the digits of the last window, with a carry, fit in the uint16 encoding
+	var c uint64
+	min := math.MaxFloat64
+	for _, cc := range []uint64{2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14} {
+		cost := float64((scalarfield.Bits+1)*(nbPoints+(1<<cc))) / float64(cc)
+		if cost < min {
+			min = cost
+			c = cc
+		}
+	}
+
+	digits := partitionScalars(scalars, c, config.NbTasks)
+	nbChunks := computeNbChunks(c)
+
+	// each go routine sends its result in chChunks[i] channel
+	chChunks := make([]chan PointExtended, nbChunks)
+	for i := range chChunks {
+		chChunks[i] = make(chan PointExtended, 1)
+	}
+
+	// we use a semaphore to limit the number of go routines running concurrently
+	// (only if nbTasks < nbCPU)
+	var sem chan struct{}
+	if config.NbTasks < runtime.NumCPU() {
+		sem = make(chan struct{}, config.NbTasks)
+		for i := 0; i < config.NbTasks; i++ {
+			sem <- struct{}{}
+		}
+		defer func() {
+			close(sem)
+		}()
+	}
+
+	// the last chunk may have one more bit, to accommodate the carry
+	for j := int(nbChunks - 1); j >= 0; j-- {
+		cj := c
+		if j == int(nbChunks-1) {
+			cj = lastC(c)
+		}
+		go processChunk(chChunks[j], cj, points, digits[j*nbPoints:(j+1)*nbPoints], sem)
+	}
+
+	return msmReduceChunk(p, int(c), chChunks), nil
+}
+
+// processChunk places the points in 2^{c-1} buckets according to their digit and sends the
+// weighted sum of the buckets ∑ₖ (k+1)⋅bucket[k] in chRes
+func processChunk(chRes chan<- PointExtended, c uint64, points []PointAffine, digits []uint16, sem chan struct{}) {
+	if sem != nil {
+		// if we are limited, wait for a token in the semaphore
+		<-sem
+	}
+
+	buckets := make([]PointExtended, 1<<(c-1))
+	for i := range buckets {
+		buckets[i].setInfinity()
+	}
+
+	// for each scalars, get the digit corresponding to the chunk we're processing.
+	var q PointExtended
+	for i, digit := range digits {
+		if digit == 0 {
+			continue
+		}
+
+		q.FromAffine(&points[i])
+		// if msbWindow bit is set, we need to subtract
+		if digit&1 == 0 {
+			// add
+			buckets[(digit>>1)-1].Add(&buckets[(digit>>1)-1], &q)
+		} else {
+			// sub
+			q.Neg(&q)
+			buckets[(digit>>1)].Add(&buckets[(digit>>1)], &q)
+		}
+	}
+
+	// reduce buckets into total
+	// total =  bucket[0] + 2*bucket[1] + 3*bucket[2] ... + n*bucket[n-1]
+	var runningSum, total PointExtended
+	runningSum.setInfinity()
+	total.setInfinity()
+	for k := len(buckets) - 1; k >= 0; k-- {
+		if !buckets[k].IsZero() {
+			runningSum.Add(&runningSum, &buckets[k])
+		}
+		total.Add(&total, &runningSum)
+	}
+
+	if sem != nil {
+		// release a token to the semaphore
+		// before sending to chRes
+		sem <- struct{}{}
+	}
+
+	chRes <- total
+}
+
+// msmReduceChunk reduces the weighted sum of the buckets into the result of the multiExp
+func msmReduceChunk(p *PointExtended, c int, chChunks []chan PointExtended) *PointExtended {
+	var _p PointExtended
+	_p = <-chChunks[len(chChunks)-1]
+	for j := len(chChunks) - 2; j >= 0; j-- {
+		for l := 0; l < c; l++ {
+			_p.Double(&_p)
+		}
+		totalj := <-chChunks[j]
+		_p.Add(&_p, &totalj)
+	}
+
+	return p.Set(&_p)
+}
+
+// return number of chunks for a given window size c
+// the last chunk may be bigger to accommodate a potential carry from the NAF decomposition
+func computeNbChunks(c uint64) uint64 {
+	return (scalarfield.Bits + c - 1) / c
+}
+
+// return the last window size for a scalar;
+// this last window should accommodate a carry (from the NAF decomposition)
+// it can be == c if we have 1 available bit
+// it can be > c if we have 0 available bit
+// it can be < c if we have 2+ available bits
+func lastC(c uint64) uint64 {
+	nbAvailableBits := (computeNbChunks(c) * c) - scalarfield.Bits
+	return c + 1 - nbAvailableBits
+}
+
+// partitionScalars  compute, for each scalars over c-bit wide windows, nbChunk digits
+// if the digit is larger than 2^{c-1}, then, we borrow 2^c from the next window and subtract
+// 2^{c} to the current digit, making it negative.
+// negative digits can be processed in a later step as adding -G into the bucket instead of G
+// (computing -G is cheap, and this saves us half of the buckets in the MultiExp)
+func partitionScalars(scalars []scalarfield.Element, c uint64, nbTasks int) []uint16 {
+	// no benefit here to have more tasks than CPUs
+	if nbTasks > runtime.NumCPU() {
+		nbTasks = runtime.NumCPU()
+	}
+
+	// number of c-bit radixes in a scalar
+	nbChunks := computeNbChunks(c)
+
+	digits := make([]uint16, len(scalars)*int(nbChunks))
+
+	max := int(1<<(c-1)) - 1 // max value (inclusive) we want for our digits
+
+	parallel.Execute(len(scalars), func(start, end int) {
+		for i := start; i < end; i++ {
+			if scalars[i].IsZero() {
+				// everything is 0, no need to process this scalar
+				continue
+			}
+			scalar := scalars[i].Bits()
+
+			var carry int
+
+			// for each chunk in the scalar, compute the current digit, and an eventual carry
+			for chunk := uint64(0); chunk < nbChunks; chunk++ {
+				// init with carry if any
+				digit := carry
+				carry = 0
+
+				// digit = value of the c-bit window
+				digit += int(window(scalar[:], chunk*c, c))
+
+				// for the last chunk, we don't want to borrow from a next window
+				// (but may have a larger max value)
+				if chunk == nbChunks-1 {
+					digits[int(chunk)*len(scalars)+i] = uint16(digit) << 1
+					break
+				}
+
+				// if the digit is larger than 2^{c-1}, then, we borrow 2^c from the next window and subtract
+				// 2^{c} to the current digit, making it negative.
+				if digit > max {
+					digit -= (1 << c)
+					carry = 1
+				}
+
+				// if digit is zero, no impact on result
+				if digit == 0 {
+					continue
+				}
+
+				var bits uint16
+				if digit > 0 {
+					bits = uint16(digit) << 1
+				} else {
+					bits = (uint16(-digit-1) << 1) + 1
+				}
+				digits[int(chunk)*len(scalars)+i] = bits
+			}
+		}
+
+	}, nbTasks)
+
+	return digits
+}
+
+// window returns the c bits of scalar starting at bit offset, possibly over two words
+func window(scalar []uint64, offset, c uint64) uint64 {
+	index, shift := offset/64, offset%64
+	res := scalar[index] >> shift
+	if shift+c > 64 && index+1 < uint64(len(scalar)) {
+		res |= scalar[index+1] << (64 - shift)
+	}
+	return res & ((1 << c) - 1)
+}
+
+// BatchFromExtended converts points in extended coordinates to affine coordinates, with a single
+// field inversion (Montgomery batch inversion trick).
+func BatchFromExtended(points []PointExtended) []PointAffine {
+	result := make([]PointAffine, len(points))
+	accumulator := fr.One()
+
+	// batch invert all points[].Z coordinates with Montgomery batch inversion trick
+	// (stores points[].Z^-1 in result[i].X to avoid allocating a slice of fr.Elements)
+	for i := 0; i < len(points); i++ {
+		result[i].X = accumulator
+		accumulator.Mul(&accumulator, &points[i].Z)
+	}
+
+	var accInverse fr.Element
+	accInverse.Inverse(&accumulator)
+
+	for i := len(points) - 1; i >= 0; i-- {
+		result[i].X.Mul(&result[i].X, &accInverse)
+		accInverse.Mul(&accInverse, &points[i].Z)
+	}
+
+	// batch convert to affine.
+	parallel.Execute(len(points), func(start, end int) {
+		for i := start; i < end; i++ {
+			zInv := result[i].X
+			result[i].X.Mul(&points[i].X, &zInv)
+			result[i].Y.Mul(&points[i].Y, &zInv)
+		}
+	})
+
+	return result
+}
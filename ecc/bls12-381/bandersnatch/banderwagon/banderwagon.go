@@ -0,0 +1,243 @@
+// Package banderwagon provides Banderwagon, a prime order group built on Bandersnatch.
+//
+// Bandersnatch has cofactor 4. Banderwagon is the quotient of the subgroup 2⋅E of Bandersnatch by
+// the 2-torsion point (0, -1): its elements are the pairs {(x, y), (-x, -y)}, and it has the prime
+// order of the scalar field fr. Much like Decaf and Ristretto, it comes with a canonical 32-byte
+// encoding, whose decoding checks that the point belongs to 2⋅E, so that the callers neither clear
+// cofactors nor pick canonical representatives.
+package banderwagon
+
+import (
+	"errors"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch/fr"
+	fp "github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+// SizeElement size of the encoding of an Element
+const SizeElement = fp.Bytes
+
+const (
+	windowSize = 4
+	windowMask = 1<<windowSize - 1
+	nbWindows  = (fr.Bits + windowSize - 1) / windowSize
+)
+
+var (
+	ErrInvalidEncoding = errors.New("invalid encoding of a Banderwagon element")
+	ErrNotInSubgroup   = errors.New("the point is not a Banderwagon element")
+)
+
+// Element element of Banderwagon, stored as either of its representatives.
+//
+// The zero value isn't a valid element, use SetIdentity.
+type Element struct {
+	inner bandersnatch.PointExtended
+}
+
+// Generator returns the generator of Banderwagon, the class of the base point of Bandersnatch
+func Generator() Element {
+	base := bandersnatch.GetEdwardsCurve().Base
+	var res Element
+	res.FromAffine(&base)
+	return res
+}
+
+// SetIdentity sets p to the identity element and returns it
+func (p *Element) SetIdentity() *Element {
+	p.inner.X.SetZero()
+	p.inner.Y.SetOne()
+	p.inner.Z.SetOne()
+	p.inner.T.SetZero()
+	return p
+}
+
+// Set sets p to p1 and returns it
+func (p *Element) Set(p1 *Element) *Element {
+	p.inner.Set(&p1.inner)
+	return p
+}
+
+// FromAffine sets p to the class of p1 and returns it. p1 must be a point of 2⋅E, such as the
+// points of the prime order subgroup of Bandersnatch.
+func (p *Element) FromAffine(p1 *bandersnatch.PointAffine) *Element {
+	p.inner.FromAffine(p1)
+	return p
+}
+
+// FromExtended sets p to the class of p1 and returns it. p1 must be a point of 2⋅E, such as the
+// points of the prime order subgroup of Bandersnatch.
+func (p *Element) FromExtended(p1 *bandersnatch.PointExtended) *Element {
+	p.inner.Set(p1)
+	return p
+}
+
+// Equal returns true if p and p1 are the same element, that is if x₁⋅y₂ = x₂⋅y₁
+func (p *Element) Equal(p1 *Element) bool {
+	var l, r fp.Element
+	l.Mul(&p.inner.X, &p1.inner.Y)
+	r.Mul(&p1.inner.X, &p.inner.Y)
+	return l.Equal(&r)
+}
+
+// IsIdentity returns true if p is the identity element, that is if x = 0
+func (p *Element) IsIdentity() bool {
+	return p.inner.X.IsZero()
+}
+
+// Add sets p to p1 + p2 and returns it
+func (p *Element) Add(p1, p2 *Element) *Element {
+	p.inner.Add(&p1.inner, &p2.inner)
+	return p
+}
+
+// Sub sets p to p1 - p2 and returns it
+func (p *Element) Sub(p1, p2 *Element) *Element {
+	var neg bandersnatch.PointExtended
+	neg.Neg(&p2.inner)
+	p.inner.Add(&p1.inner, &neg)
+	return p
+}
+
+// Neg sets p to -p1 and returns it
+func (p *Element) Neg(p1 *Element) *Element {
+	p.inner.Neg(&p1.inner)
+	return p
+}
+
+// Double sets p to 2⋅p1 and returns it
+func (p *Element) Double(p1 *Element) *Element {
+	p.inner.Double(&p1.inner)
+	return p
+}
+
+// ScalarMultiplication sets p to s⋅p1 and returns it.
+//
+// The GLV multiplication of Bandersnatch only applies to the points of the prime order subgroup,
+// while the representative of an element may be off by (0, -1): a 4-bit fixed window is used instead.
+func (p *Element) ScalarMultiplication(p1 *Element, s *fr.Element) *Element {
+	var table [windowMask]bandersnatch.PointExtended
+	table[0].Set(&p1.inner)
+	for i := 1; i < len(table); i++ {
+		table[i].Add(&table[i-1], &p1.inner)
+	}
+
+	var res Element
+	res.SetIdentity()
+	b := s.Bits()
+	const digitsPerWord = 64 / windowSize
+	for k := nbWindows - 1; k >= 0; k-- {
+		for i := 0; i < windowSize; i++ {
+			res.inner.Double(&res.inner)
+		}
+		if d := (b[k/digitsPerWord] >> (windowSize * (k % digitsPerWord))) & windowMask; d != 0 {
+			res.inner.Add(&res.inner, &table[d-1])
+		}
+	}
+	return p.Set(&res)
+}
+
+// Bytes returns the canonical encoding of p: the big-endian encoding of x, for the representative
+// (x, y) of p such that y is lexicographically largest.
+func (p *Element) Bytes() [SizeElement]byte {
+	var zInv fp.Element
+	zInv.Inverse(&p.inner.Z)
+	return p.bytes(&zInv)
+}
+
+// bytes returns the encoding of p, given 1/Z
+func (p *Element) bytes(zInv *fp.Element) [SizeElement]byte {
+	var x, y fp.Element
+	x.Mul(&p.inner.X, zInv)
+	y.Mul(&p.inner.Y, zInv)
+	if !y.LexicographicallyLargest() {
+		x.Neg(&x)
+	}
+	return x.Bytes()
+}
+
+// SetBytes sets p from its canonical encoding. It returns an error if buf isn't the encoding of an
+// element of Banderwagon.
+func (p *Element) SetBytes(buf []byte) error {
+	if len(buf) != SizeElement {
+		return ErrInvalidEncoding
+	}
+	var x fp.Element
+	if err := x.SetBytesCanonical(buf); err != nil {
+		return ErrInvalidEncoding
+	}
+
+	// (x, y) ∈ 2⋅E iff 1 - a⋅x² is a square, and then y² = (1 - a⋅x²) / (1 - d⋅x²)
+	params := bandersnatch.GetEdwardsCurve()
+	var one, xx, num, den, y fp.Element
+	one.SetOne()
+	xx.Square(&x)
+	num.Mul(&xx, &params.A).Sub(&one, &num)
+	if num.Legendre() != 1 {
+		return ErrNotInSubgroup
+	}
+	den.Mul(&xx, &params.D).Sub(&one, &den)
+	if den.IsZero() {
+		return ErrNotInSubgroup
+	}
+	num.Div(&num, &den)
+	if y.Sqrt(&num) == nil {
+		return ErrNotInSubgroup
+	}
+	if !y.LexicographicallyLargest() {
+		y.Neg(&y)
+	}
+
+	p.FromAffine(&bandersnatch.PointAffine{X: x, Y: y})
+	return nil
+}
+
+// MapToScalarField returns x/y, the same for both representatives of p, reduced modulo the order of
+// Banderwagon.
+func (p *Element) MapToScalarField() fr.Element {
+	var yInv fp.Element
+	yInv.Inverse(&p.inner.Y)
+	return p.mapToScalarField(&yInv)
+}
+
+// mapToScalarField returns the image of p by MapToScalarField, given 1/Y
+func (p *Element) mapToScalarField(yInv *fp.Element) fr.Element {
+	var q fp.Element
+	q.Mul(&p.inner.X, yInv)
+	b := q.Bytes()
+	var res fr.Element
+	res.SetBytes(b[:])
+	return res
+}
+
+// BatchBytes returns the encodings of elements, with a single field inversion
+func BatchBytes(elements []Element) [][SizeElement]byte {
+	zs := make([]fp.Element, len(elements))
+	for i := range elements {
+		zs[i] = elements[i].inner.Z
+	}
+	zs = fp.BatchInvert(zs)
+
+	res := make([][SizeElement]byte, len(elements))
+	for i := range elements {
+		res[i] = elements[i].bytes(&zs[i])
+	}
+	return res
+}
+
+// BatchMapToScalarField returns the images of elements by MapToScalarField, with a single field
+// inversion
+func BatchMapToScalarField(elements []Element) []fr.Element {
+	ys := make([]fp.Element, len(elements))
+	for i := range elements {
+		ys[i] = elements[i].inner.Y
+	}
+	ys = fp.BatchInvert(ys)
+
+	res := make([]fr.Element, len(elements))
+	for i := range elements {
+		res[i] = elements[i].mapToScalarField(&ys[i])
+	}
+	return res
+}
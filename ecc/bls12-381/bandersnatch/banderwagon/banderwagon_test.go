@@ -0,0 +1,184 @@
+package banderwagon
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch/fr"
+	fp "github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/stretchr/testify/require"
+)
+
+// randomElement returns s⋅G, G being the generator, and s
+func randomElement() (Element, fr.Element) {
+	var s fr.Element
+	s.SetRandom()
+	res := Generator()
+	res.ScalarMultiplication(&res, &s)
+	return res, s
+}
+
+// addTorsion returns the other representative of p: (x, y) + (0, -1) = (-x, -y)
+func addTorsion(p *Element) Element {
+	var res Element
+	res.Set(p)
+	res.inner.X.Neg(&res.inner.X)
+	res.inner.Y.Neg(&res.inner.Y)
+	return res
+}
+
+func TestElementEncoding(t *testing.T) {
+	assert := require.New(t)
+
+	for i := 0; i < 10; i++ {
+		p, _ := randomElement()
+		b := p.Bytes()
+
+		var q Element
+		assert.NoError(q.SetBytes(b[:]))
+		assert.True(q.Equal(&p))
+		assert.Equal(b, q.Bytes())
+
+		// both representatives have the same encoding
+		other := addTorsion(&p)
+		assert.True(other.Equal(&p))
+		assert.Equal(b, other.Bytes())
+	}
+
+	var identity, q Element
+	identity.SetIdentity()
+	b := identity.Bytes()
+	assert.NoError(q.SetBytes(b[:]))
+	assert.True(q.IsIdentity())
+
+	// non-canonical encoding
+	var modulus [SizeElement]byte
+	fp.Modulus().FillBytes(modulus[:])
+	assert.ErrorIs(q.SetBytes(modulus[:]), ErrInvalidEncoding)
+	assert.ErrorIs(q.SetBytes(b[1:]), ErrInvalidEncoding)
+
+	// x such that 1 - a⋅x² isn't a square
+	a := bandersnatch.GetEdwardsCurve().A
+	var x, one, y fp.Element
+	one.SetOne()
+	for {
+		x.Add(&x, &one)
+		y.Square(&x).Mul(&y, &a).Sub(&one, &y)
+		if y.Legendre() == -1 {
+			break
+		}
+	}
+	b = x.Bytes()
+	assert.ErrorIs(q.SetBytes(b[:]), ErrNotInSubgroup)
+}
+
+func TestElementArithmetic(t *testing.T) {
+	assert := require.New(t)
+
+	p, s := randomElement()
+	var expected bandersnatch.PointAffine
+	var sBig big.Int
+	base := bandersnatch.GetEdwardsCurve().Base
+	expected.ScalarMultiplication(&base, s.BigInt(&sBig))
+	var e Element
+	e.FromAffine(&expected)
+	assert.True(p.Equal(&e))
+
+	// the multiplication doesn't depend on the representative
+	other := addTorsion(&p)
+	var r fr.Element
+	r.SetRandom()
+	var q1, q2 Element
+	q1.ScalarMultiplication(&p, &r)
+	q2.ScalarMultiplication(&other, &r)
+	assert.True(q1.Equal(&q2))
+
+	// p + p - p = p
+	var sum Element
+	sum.Add(&p, &other).Sub(&sum, &p)
+	assert.True(sum.Equal(&p))
+	sum.Sub(&sum, &other)
+	assert.True(sum.IsIdentity())
+	assert.False(p.IsIdentity())
+
+	// 2⋅p - p + (-p) = 0
+	var double, neg Element
+	double.Double(&other).Sub(&double, &p)
+	assert.True(double.Equal(&p))
+	neg.Neg(&p)
+	double.Add(&double, &neg)
+	assert.True(double.IsIdentity())
+
+	// the order of the group is the one of the scalar field
+	var zero fr.Element
+	q1.ScalarMultiplication(&p, &zero)
+	assert.True(q1.IsIdentity())
+	r.SetOne().Neg(&r)
+	q1.ScalarMultiplication(&other, &r)
+	assert.True(q1.Equal(&neg))
+}
+
+func TestMapToScalarField(t *testing.T) {
+	assert := require.New(t)
+
+	p, _ := randomElement()
+	q, _ := randomElement()
+	other := addTorsion(&p)
+	hp, hq, hOther := p.MapToScalarField(), q.MapToScalarField(), other.MapToScalarField()
+	assert.True(hp.Equal(&hOther))
+	assert.False(hp.Equal(&hq))
+
+	var identity Element
+	identity.SetIdentity()
+	h := identity.MapToScalarField()
+	assert.True(h.IsZero())
+}
+
+func TestBatch(t *testing.T) {
+	assert := require.New(t)
+
+	elements := make([]Element, 5)
+	for i := range elements {
+		elements[i], _ = randomElement()
+	}
+	elements[1] = addTorsion(&elements[1])
+	elements[3].SetIdentity()
+
+	encodings, images := BatchBytes(elements), BatchMapToScalarField(elements)
+	assert.Equal(len(elements), len(encodings))
+	assert.Equal(len(elements), len(images))
+	for i := range elements {
+		assert.Equal(elements[i].Bytes(), encodings[i])
+		expected := elements[i].MapToScalarField()
+		assert.True(expected.Equal(&images[i]))
+	}
+}
+
+func BenchmarkBytes(b *testing.B) {
+	p, _ := randomElement()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = p.Bytes()
+	}
+}
+
+func BenchmarkBatchBytes(b *testing.B) {
+	elements := make([]Element, 256)
+	for i := range elements {
+		elements[i], _ = randomElement()
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = BatchBytes(elements)
+	}
+}
+
+func BenchmarkSetBytes(b *testing.B) {
+	p, _ := randomElement()
+	buf := p.Bytes()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = p.SetBytes(buf[:])
+	}
+}
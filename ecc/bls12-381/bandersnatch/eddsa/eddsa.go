@@ -150,23 +150,11 @@ func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error)
 		return nil, errNotOnCurve
 	}
 
-	// compute H(R, A, M), all parameters in data are in Montgomery form
-	hFunc.Reset()
-
-	resRX := res.R.X.Bytes()
-	resRY := res.R.Y.Bytes()
-	resAX := privKey.PublicKey.A.X.Bytes()
-	resAY := privKey.PublicKey.A.Y.Bytes()
-	toWrite := [][]byte{resRX[:], resRY[:], resAX[:], resAY[:], message}
-	for _, bytes := range toWrite {
-		if _, err := hFunc.Write(bytes); err != nil {
-			return nil, err
-		}
-	}
-
+	// compute H(R, A, M)
 	var hramInt big.Int
-	hramBin := hFunc.Sum(nil)
-	hramInt.SetBytes(hramBin)
+	if err := computeHRAM(&hramInt, &res.R, &privKey.PublicKey.A, message, hFunc); err != nil {
+		return nil, err
+	}
 
 	// Compute s = randScalarInt + H(R,A,M)*S
 	// going with big int to do ops mod curve order
@@ -207,25 +195,11 @@ func (pub *PublicKey) Verify(sigBin, message []byte, hFunc hash.Hash) (bool, err
 		return false, err
 	}
 
-	// compute H(R, A, M), all parameters in data are in Montgomery form
-
-	hFunc.Reset()
-
-	sigRX := sig.R.X.Bytes()
-	sigRY := sig.R.Y.Bytes()
-	sigAX := pub.A.X.Bytes()
-	sigAY := pub.A.Y.Bytes()
-
-	toWrite := [][]byte{sigRX[:], sigRY[:], sigAX[:], sigAY[:], message}
-	for _, bytes := range toWrite {
-		if _, err := hFunc.Write(bytes); err != nil {
-			return false, err
-		}
-	}
-
+	// compute H(R, A, M)
 	var hramInt big.Int
-	hramBin := hFunc.Sum(nil)
-	hramInt.SetBytes(hramBin)
+	if err := computeHRAM(&hramInt, &sig.R, &pub.A, message, hFunc); err != nil {
+		return false, err
+	}
 
 	// lhs = cofactor*S*Base
 	var lhs twistededwards.PointAffine
@@ -255,3 +229,23 @@ func (pub *PublicKey) Verify(sigBin, message []byte, hFunc hash.Hash) (bool, err
 
 	return true, nil
 }
+
+// computeHRAM sets res to H(R, A, M), the hash of the big endian encodings of the coordinates of R
+// and A, and of the message
+func computeHRAM(res *big.Int, R, A *twistededwards.PointAffine, message []byte, hFunc hash.Hash) error {
+	hFunc.Reset()
+
+	RX := R.X.Bytes()
+	RY := R.Y.Bytes()
+	AX := A.X.Bytes()
+	AY := A.Y.Bytes()
+	toWrite := [][]byte{RX[:], RY[:], AX[:], AY[:], message}
+	for _, bytes := range toWrite {
+		if _, err := hFunc.Write(bytes); err != nil {
+			return err
+		}
+	}
+
+	res.SetBytes(hFunc.Sum(nil))
+	return nil
+}
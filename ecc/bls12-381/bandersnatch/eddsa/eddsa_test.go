@@ -264,6 +264,128 @@ func TestEddsaSHA256(t *testing.T) {
 
 }
 
+func TestBatchVerify(t *testing.T) {
+
+	src := rand.NewSource(0)
+	r := rand.New(src) //#nosec G404 weak rng is fine here
+
+	hFunc := sha256.New()
+
+	const nbSignatures = 17
+	pubs, msgs, sigs := batchSignatures(t, r, nbSignatures)
+
+	// all signatures are valid
+	idx, err := BatchVerify(pubs, msgs, sigs, hFunc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if idx != -1 {
+		t.Fatalf("BatchVerify of valid signatures should return -1, got %d", idx)
+	}
+
+	// the batch of a single signature
+	idx, err = BatchVerify(pubs[:1], msgs[:1], sigs[:1], hFunc)
+	if err != nil || idx != -1 {
+		t.Fatal("BatchVerify of a single valid signature should return -1")
+	}
+
+	// wrong messages, the first one is identified
+	for _, wrong := range [][]int{{0}, {5}, {nbSignatures - 1}, {7, 12}} {
+		wrongMsgs := make([][]byte, nbSignatures)
+		copy(wrongMsgs, msgs)
+		for _, i := range wrong {
+			wrongMsgs[i] = []byte("wrong_message")
+		}
+		idx, err = BatchVerify(pubs, wrongMsgs, sigs, hFunc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if idx != wrong[0] {
+			t.Fatalf("BatchVerify should identify the invalid signature %d, got %d", wrong[0], idx)
+		}
+	}
+
+	// signatures swapped between public keys
+	swappedPubs := make([]PublicKey, nbSignatures)
+	copy(swappedPubs, pubs)
+	swappedPubs[3], swappedPubs[4] = swappedPubs[4], swappedPubs[3]
+	idx, err = BatchVerify(swappedPubs, msgs, sigs, hFunc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if idx != 3 {
+		t.Fatalf("BatchVerify should identify the invalid signature 3, got %d", idx)
+	}
+
+	// consistent with Verify
+	for i := range sigs {
+		res, err := pubs[i].Verify(sigs[i], msgs[i], hFunc)
+		if err != nil || !res {
+			t.Fatal("Verify correct signature should return true")
+		}
+	}
+
+	// malformed signature
+	malformedSigs := make([][]byte, nbSignatures)
+	copy(malformedSigs, sigs)
+	malformedSigs[9] = sigs[9][1:]
+	idx, err = BatchVerify(pubs, msgs, malformedSigs, hFunc)
+	if err != errWrongSize || idx != 9 {
+		t.Fatal("BatchVerify should identify the malformed signature 9")
+	}
+
+	// an invalid signature before the malformed one is identified first
+	wrongMsgs := make([][]byte, nbSignatures)
+	copy(wrongMsgs, msgs)
+	wrongMsgs[4] = []byte("wrong_message")
+	idx, err = BatchVerify(pubs, wrongMsgs, malformedSigs, hFunc)
+	if err != nil || idx != 4 {
+		t.Fatalf("BatchVerify should identify the invalid signature 4, got %d", idx)
+	}
+
+	// an invalid signature after the malformed one is not
+	wrongMsgs[4], wrongMsgs[12] = msgs[4], []byte("wrong_message")
+	idx, err = BatchVerify(pubs, wrongMsgs, malformedSigs, hFunc)
+	if err != errWrongSize || idx != 9 {
+		t.Fatal("BatchVerify should identify the malformed signature 9")
+	}
+
+	// the first signature is malformed
+	malformedSigs[0] = sigs[0][1:]
+	idx, err = BatchVerify(pubs, msgs, malformedSigs, hFunc)
+	if err != errWrongSize || idx != 0 {
+		t.Fatal("BatchVerify should identify the malformed signature 0")
+	}
+
+	// inconsistent sizes
+	if _, err = BatchVerify(pubs, msgs[1:], sigs, hFunc); err != errBatchSize {
+		t.Fatal("BatchVerify should fail with inputs of different lengths")
+	}
+	if _, err = BatchVerify(pubs, msgs, sigs, nil); err != errHashNeeded {
+		t.Fatal("BatchVerify should fail without hash function")
+	}
+}
+
+// batchSignatures returns n signatures of random messages by random keys
+func batchSignatures(tb testing.TB, r *rand.Rand, n int) ([]PublicKey, [][]byte, [][]byte) {
+	hFunc := sha256.New()
+	pubs := make([]PublicKey, n)
+	msgs := make([][]byte, n)
+	sigs := make([][]byte, n)
+	for i := 0; i < n; i++ {
+		privKey, err := GenerateKey(r)
+		if err != nil {
+			tb.Fatal(err)
+		}
+		pubs[i] = privKey.PublicKey
+		msgs[i] = []byte(fmt.Sprintf("message %d", i))
+		if sigs[i], err = privKey.Sign(msgs[i], hFunc); err != nil {
+			tb.Fatal(err)
+		}
+	}
+	return pubs, msgs, sigs
+}
+
 // benchmarks
 
 func BenchmarkVerify(b *testing.B) {
@@ -289,3 +411,18 @@ func BenchmarkVerify(b *testing.B) {
 		pubKey.Verify(signature, msgBin[:], hFunc)
 	}
 }
+
+func BenchmarkBatchVerify(b *testing.B) {
+
+	src := rand.NewSource(0)
+	r := rand.New(src) //#nosec G404 weak rng is fine here
+
+	const nbSignatures = 1 << 10
+	pubs, msgs, sigs := batchSignatures(b, r, nbSignatures)
+	hFunc := sha256.New()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = BatchVerify(pubs, msgs, sigs, hFunc)
+	}
+}
@@ -42,7 +42,7 @@ type PointExtended struct {
 
 const (
 	//following https://tools.ietf.org/html/rfc8032#section-3.1,
-	// an fr element x is negative if its binary encoding is
+	// an element x of the base field is negative if its binary encoding is
 	// lexicographically larger than -x.
 	mCompressedNegative = 0x80
 	mCompressedPositive = 0x00
@@ -0,0 +1,175 @@
+package verkle
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch/banderwagon"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch/ipa"
+	fp "github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// NodeWidth number of values of a node, and size of the evaluation domain
+const NodeWidth = 256
+
+const (
+	nbRounds   = 8 // log₂(NodeWidth) rounds of the inner-product argument
+	windowSize = 4 // the tables hold the multiples of the generators by 4-bit digits
+	windowMask = 1<<windowSize - 1
+	nbWindows  = (fr.Bits + windowSize - 1) / windowSize
+)
+
+var ErrInvalidVectorSize = errors.New("a vector can't have more than NodeWidth elements")
+
+// Config generators of the commitments and precomputed data. It is read-only once created, and
+// can be shared between goroutines.
+type Config struct {
+	// CRS generators G₀, ..., G₂₅₅ of the commitments
+	CRS []bandersnatch.PointAffine
+
+	// Q generator binding the inner products of the multiproofs
+	Q bandersnatch.PointAffine
+
+	// tables of multiples of the Gᵢ, then of Q
+	tables []precomputedTable
+
+	domain domain
+}
+
+// NewConfig returns the configuration whose generators are derived from seed.
+//
+// The generators are those of an IPA SRS of size NodeWidth, derived from the same seed.
+func NewConfig(seed []byte) (*Config, error) {
+	srs, err := ipa.NewSRS(NodeWidth, seed)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Config{
+		CRS:    srs.Basis,
+		Q:      srs.Q,
+		tables: make([]precomputedTable, NodeWidth+1),
+		domain: newDomain(),
+	}
+	parallel.Execute(len(res.tables), func(start, end int) {
+		for i := start; i < end; i++ {
+			if i < NodeWidth {
+				res.tables[i].init(&res.CRS[i])
+			} else {
+				res.tables[i].init(&res.Q)
+			}
+		}
+	})
+	return res, nil
+}
+
+// Commit returns the commitment ∑ᵢ values[i]⋅Gᵢ to the values of a node, len(values) ≤ NodeWidth
+func (c *Config) Commit(values []fr.Element) (banderwagon.Element, error) {
+	if len(values) > NodeWidth {
+		return banderwagon.Element{}, ErrInvalidVectorSize
+	}
+	return c.msm(values, 0), nil
+}
+
+// UpdateCommitment sets commitment to the commitment of the same values, but with the value at
+// index changed from oldValue to newValue: (newValue - oldValue)⋅Gᵢ is added to it.
+func (c *Config) UpdateCommitment(commitment *banderwagon.Element, index uint8, oldValue, newValue *fr.Element) {
+	var delta fr.Element
+	delta.Sub(newValue, oldValue)
+	d := c.tables[index].mul(&delta)
+	commitment.Add(commitment, &d)
+}
+
+// msm returns ∑ᵢ scalars[i]⋅Gₒ₊ᵢ, o being offset, from the tables
+func (c *Config) msm(scalars []fr.Element, offset int) banderwagon.Element {
+	var res banderwagon.Element
+	res.SetIdentity()
+	var lock sync.Mutex
+	parallel.Execute(len(scalars), func(start, end int) {
+		acc := newAccumulator()
+		for i := start; i < end; i++ {
+			c.tables[offset+i].mulAdd(&acc, &scalars[i])
+		}
+		var e banderwagon.Element
+		e.FromExtended(&acc)
+		lock.Lock()
+		res.Add(&res, &e)
+		lock.Unlock()
+	})
+	return res
+}
+
+// mulQ returns s⋅Q
+func (c *Config) mulQ(s *fr.Element) banderwagon.Element {
+	return c.tables[NodeWidth].mul(s)
+}
+
+// precomputedTable multiples of a point P for a fixed-base scalar multiplication:
+// table[k][d-1] = d⋅2⁴ᵏ⋅P, for each 4-bit digit d ≠ 0 at position k.
+type precomputedTable [nbWindows][windowMask]bandersnatch.PointAffine
+
+// init computes the table of p
+func (t *precomputedTable) init(p *bandersnatch.PointAffine) {
+	multiples := make([]bandersnatch.PointExtended, 0, nbWindows*windowMask)
+	var base bandersnatch.PointExtended
+	base.FromAffine(p)
+	for k := 0; k < nbWindows; k++ {
+		multiples = append(multiples, base)
+		for d := 1; d < windowMask; d++ {
+			var m bandersnatch.PointExtended
+			m.Add(&multiples[len(multiples)-1], &base)
+			multiples = append(multiples, m)
+		}
+		for i := 0; i < windowSize; i++ {
+			base.Double(&base)
+		}
+	}
+
+	// batch conversion to affine coordinates
+	zs := make([]fp.Element, len(multiples))
+	for i := range multiples {
+		zs[i] = multiples[i].Z
+	}
+	zs = fp.BatchInvert(zs)
+	for i := range multiples {
+		q := &t[i/windowMask][i%windowMask]
+		q.X.Mul(&multiples[i].X, &zs[i])
+		q.Y.Mul(&multiples[i].Y, &zs[i])
+	}
+}
+
+// mulAdd adds s⋅P to acc
+func (t *precomputedTable) mulAdd(acc *bandersnatch.PointExtended, s *fr.Element) {
+	b := s.Bits()
+	for k := 0; k < nbWindows; k++ {
+		if d := digit(&b, k); d != 0 {
+			acc.MixedAdd(acc, &t[k][d-1])
+		}
+	}
+}
+
+// mul returns s⋅P
+func (t *precomputedTable) mul(s *fr.Element) banderwagon.Element {
+	acc := newAccumulator()
+	t.mulAdd(&acc, s)
+	var res banderwagon.Element
+	res.FromExtended(&acc)
+	return res
+}
+
+// newAccumulator returns the neutral element in extended coordinates
+func newAccumulator() bandersnatch.PointExtended {
+	var res bandersnatch.PointExtended
+	res.Y.SetOne()
+	res.Z.SetOne()
+	return res
+}
+
+// digit returns the k-th 4-bit digit of the scalar of words b
+func digit(b *[fr.Limbs]uint64, k int) uint64 {
+	const digitsPerWord = 64 / windowSize
+	return (b[k/digitsPerWord] >> (windowSize * (k % digitsPerWord))) & windowMask
+}
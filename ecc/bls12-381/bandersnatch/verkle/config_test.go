@@ -0,0 +1,156 @@
+package verkle
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch/banderwagon"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch/fr"
+	"github.com/stretchr/testify/require"
+)
+
+// Test configuration re-used across tests
+var testConfig *Config
+
+func init() {
+	testConfig, _ = NewConfig([]byte("test"))
+}
+
+func randomVector(size int) []fr.Element {
+	v := make([]fr.Element, size)
+	for i := range v {
+		v[i].SetRandom()
+	}
+	return v
+}
+
+func TestCommit(t *testing.T) {
+	assert := require.New(t)
+
+	values := randomVector(NodeWidth)
+	values[3].SetZero()
+	commitment, err := testConfig.Commit(values)
+	assert.NoError(err)
+
+	var expected, t1 bandersnatch.PointExtended
+	expected.Y.SetOne()
+	expected.Z.SetOne()
+	for i := range values {
+		var s big.Int
+		t1.FromAffine(&testConfig.CRS[i])
+		t1.ScalarMultiplication(&t1, values[i].BigInt(&s))
+		expected.Add(&expected, &t1)
+	}
+	var e banderwagon.Element
+	e.FromExtended(&expected)
+	assert.True(commitment.Equal(&e))
+
+	// shorter vectors are padded with zeros
+	short, err := testConfig.Commit(values[:10])
+	assert.NoError(err)
+	padded := make([]fr.Element, NodeWidth)
+	copy(padded, values[:10])
+	expectedShort, err := testConfig.Commit(padded)
+	assert.NoError(err)
+	assert.True(short.Equal(&expectedShort))
+
+	_, err = testConfig.Commit(randomVector(NodeWidth + 1))
+	assert.ErrorIs(err, ErrInvalidVectorSize)
+
+	other, err := NewConfig([]byte("test"))
+	assert.NoError(err)
+	assert.Equal(testConfig.CRS, other.CRS, "the generators must be deterministic")
+}
+
+func TestNodeUpdate(t *testing.T) {
+	assert := require.New(t)
+
+	values := randomVector(NodeWidth / 2)
+	node, err := testConfig.NewNode(values)
+	assert.NoError(err)
+
+	child, err := testConfig.NewNode(randomVector(5))
+	assert.NoError(err)
+
+	node.Update(0, fr.NewElement(42))
+	node.Update(200, fr.NewElement(7))
+	node.Update(255, fr.NewElement(1))
+	node.Update(200, fr.Element{})
+	node.UpdateChild(17, child)
+
+	h := child.Hash()
+	v := node.Value(17)
+	assert.True(h.Equal(&v))
+	v = node.Value(0)
+	assert.Equal(fr.NewElement(42), v)
+
+	expected, err := testConfig.Commit(node.Values())
+	assert.NoError(err)
+	actual := node.Commitment()
+	assert.True(expected.Equal(&actual))
+
+	// the child changes
+	child.Update(3, fr.NewElement(3))
+	node.UpdateChild(17, child)
+	expected, err = testConfig.Commit(node.Values())
+	assert.NoError(err)
+	actual = node.Commitment()
+	assert.True(expected.Equal(&actual))
+}
+
+func TestDomain(t *testing.T) {
+	assert := require.New(t)
+
+	// f(X) = 3X² + 2X + 1
+	eval := func(x fr.Element) fr.Element {
+		res, two, one := fr.NewElement(3), fr.NewElement(2), fr.One()
+		res.Mul(&res, &x).Add(&res, &two).Mul(&res, &x).Add(&res, &one)
+		return res
+	}
+	f := make([]fr.Element, NodeWidth)
+	for i := range f {
+		f[i] = eval(fr.NewElement(uint64(i)))
+	}
+
+	// (f(X) - f(z)) / (X - z) = 3X + 3z + 2
+	for _, z := range []uint8{0, 1, 100, 255} {
+		q := testConfig.domain.divide(f, z)
+		for i := range q {
+			var expected fr.Element
+			expected.SetUint64(3*uint64(i) + 3*uint64(z) + 2)
+			assert.True(expected.Equal(&q[i]), "z=%d, i=%d", z, i)
+		}
+	}
+
+	var x fr.Element
+	x.SetRandom()
+	lagrange, _, err := testConfig.domain.barycentric(&x)
+	assert.NoError(err)
+	actual, expected := innerProduct(lagrange, f), eval(x)
+	assert.True(expected.Equal(&actual))
+
+	x.SetUint64(12)
+	_, _, err = testConfig.domain.barycentric(&x)
+	assert.ErrorIs(err, ErrPointInDomain)
+}
+
+func BenchmarkCommit(b *testing.B) {
+	values := randomVector(NodeWidth)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = testConfig.Commit(values)
+	}
+}
+
+func BenchmarkNodeUpdate(b *testing.B) {
+	node, err := testConfig.NewNode(randomVector(NodeWidth))
+	if err != nil {
+		b.Fatal(err)
+	}
+	value := randomVector(1)[0]
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		node.Update(uint8(i), value)
+	}
+}
@@ -0,0 +1,18 @@
+// Package verkle provides the vector commitments and proofs of verkle trees, over Bandersnatch.
+//
+// A node of a verkle tree holds NodeWidth = 256 scalars, its commitment being the Pedersen vector
+// commitment C = ∑ᵢ vᵢ⋅Gᵢ, where the Gᵢ are transparent generators of the prime order subgroup of
+// Bandersnatch. Commitments are elements of the prime order group of the banderwagon package,
+// with its canonical 32-byte encoding. A child node is bound to its parent through its
+// commitment, mapped to a scalar.
+//
+// The values of a node are seen as the evaluations of a polynomial on the domain {0, ..., 255}.
+// Commitments are computed from tables of multiples of the generators, and updated with the
+// commitment to the difference between the old and new values.
+//
+// A multiproof proves the evaluations of several committed polynomials at points of the domain:
+// the openings are aggregated with a random linear combination into a single polynomial, whose
+// evaluation outside of the domain is proven with an inner-product argument.
+//
+// See https://dankradfeist.de/ethereum/2021/06/18/pcs-multiproofs.html for the multiproof.
+package verkle
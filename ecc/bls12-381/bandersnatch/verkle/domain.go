@@ -0,0 +1,86 @@
+package verkle
+
+import (
+	"errors"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch/fr"
+)
+
+var ErrPointInDomain = errors.New("the evaluation point is in the domain")
+
+// domain precomputed data for the polynomials given by their evaluations on {0, ..., 255}.
+//
+// With A(X) = ∏ᵢ (X - i), the Lagrange polynomials are Lᵢ(X) = A(X) / (A'(i)⋅(X - i)).
+type domain struct {
+	aPrime    [NodeWidth]fr.Element // A'(i) = ∏ⱼ≠ᵢ (i - j)
+	aPrimeInv [NodeWidth]fr.Element // 1 / A'(i)
+	inv       [NodeWidth]fr.Element // 1 / i, for i ≠ 0
+}
+
+func newDomain() domain {
+	var res domain
+	var t fr.Element
+	for i := 0; i < NodeWidth; i++ {
+		res.aPrime[i].SetOne()
+		for j := 0; j < NodeWidth; j++ {
+			if j != i {
+				t.SetInt64(int64(i - j))
+				res.aPrime[i].Mul(&res.aPrime[i], &t)
+			}
+		}
+		res.inv[i].SetUint64(uint64(i))
+	}
+	copy(res.aPrimeInv[:], fr.BatchInvert(res.aPrime[:]))
+	copy(res.inv[:], fr.BatchInvert(res.inv[:]))
+	return res
+}
+
+// invDiff sets res to 1 / (i - j), for i ≠ j
+func (d *domain) invDiff(res *fr.Element, i, j int) {
+	if i > j {
+		res.Set(&d.inv[i-j])
+	} else {
+		res.Neg(&d.inv[j-i])
+	}
+}
+
+// divide returns the evaluations of q(X) = (f(X) - f(z)) / (X - z), for f of evaluations f:
+// qᵢ = (fᵢ - f(z)) / (i - z) for i ≠ z, and q(z) = f'(z) = -∑ᵢ≠z qᵢ⋅A'(z)/A'(i).
+func (d *domain) divide(f []fr.Element, z uint8) []fr.Element {
+	q := make([]fr.Element, NodeWidth)
+	var t, sum fr.Element
+	for i := range q {
+		if i == int(z) {
+			continue
+		}
+		d.invDiff(&t, i, int(z))
+		q[i].Sub(&f[i], &f[z]).Mul(&q[i], &t)
+		t.Mul(&q[i], &d.aPrimeInv[i])
+		sum.Add(&sum, &t)
+	}
+	q[z].Mul(&sum, &d.aPrime[z]).Neg(&q[z])
+	return q
+}
+
+// barycentric returns the Lagrange polynomials evaluated at t, Lᵢ(t) = A(t) / (A'(i)⋅(t - i)), and
+// the inverses 1 / (t - i). t must not be in the domain.
+func (d *domain) barycentric(t *fr.Element) (lagrange, invT []fr.Element, err error) {
+	invT = make([]fr.Element, NodeWidth)
+	var a, i fr.Element
+	a.SetOne()
+	for k := range invT {
+		i.SetUint64(uint64(k))
+		invT[k].Sub(t, &i)
+		if invT[k].IsZero() {
+			return nil, nil, ErrPointInDomain
+		}
+		a.Mul(&a, &invT[k])
+	}
+	invT = fr.BatchInvert(invT)
+
+	lagrange = make([]fr.Element, NodeWidth)
+	for k := range lagrange {
+		lagrange[k].Mul(&a, &d.aPrimeInv[k]).Mul(&lagrange[k], &invT[k])
+	}
+	return lagrange, invT, nil
+}
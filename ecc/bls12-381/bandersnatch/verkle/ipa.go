@@ -0,0 +1,219 @@
+package verkle
+
+import (
+	"strconv"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch/banderwagon"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch/fr"
+	"github.com/consensys/gnark-crypto/fiat-shamir"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// IPAProof proof that ⟨a, b⟩ = v, for a vector a committed in C = ∑ᵢ aᵢ⋅Gᵢ and public b and v.
+//
+// The argument is the one of the ipa package, on Banderwagon: the points bound to the transcript
+// are encoded as Banderwagon elements, and the verification equation holds in the quotient group.
+type IPAProof struct {
+	// L, R commitments to the cross terms of each folding round
+	L, R [nbRounds]banderwagon.Element
+
+	// A the vector a, folded down to a single element
+	A fr.Element
+}
+
+// proveInnerProduct proves ⟨a, b⟩, with len(a) = len(b) = NodeWidth, the statement being bound to
+// the challenge "w" of fs. With Q' = w⋅Q, each round j commits to the cross terms
+//
+//	L = ⟨a_R, G_L⟩ + ⟨a_R, b_L⟩⋅Q', R = ⟨a_L, G_R⟩ + ⟨a_L, b_R⟩⋅Q'
+//
+// and folds a ← a_L + xⱼ⋅a_R, b ← b_L + xⱼ⁻¹⋅b_R and G ← G_L + xⱼ⁻¹⋅G_R. a and b are modified.
+func (c *Config) proveInnerProduct(fs *fiatshamir.Transcript, a, b []fr.Element) (IPAProof, error) {
+	var res IPAProof
+	w, err := deriveChallenge(fs, "w")
+	if err != nil {
+		return IPAProof{}, err
+	}
+
+	var g []banderwagon.Element // the folded generators, nil until the first round, which uses the tables
+	var x, xInv, t fr.Element
+	for j := 0; j < nbRounds; j++ {
+		m := len(a) / 2
+		aL, aR := a[:m], a[m:]
+		bL, bR := b[:m], b[m:]
+
+		var l, r banderwagon.Element
+		if g == nil {
+			l, r = c.msm(aR, 0), c.msm(aL, m)
+		} else {
+			l, r = multiExp(g[:m], aR), multiExp(g[m:], aL)
+		}
+		cL, cR := innerProduct(aR, bL), innerProduct(aL, bR)
+		cL.Mul(&cL, &w)
+		cR.Mul(&cR, &w)
+		qL, qR := c.mulQ(&cL), c.mulQ(&cR)
+		res.L[j].Add(&l, &qL)
+		res.R[j].Add(&r, &qR)
+
+		if x, err = deriveRoundChallenge(fs, j, &res.L[j], &res.R[j]); err != nil {
+			return IPAProof{}, err
+		}
+		xInv.Inverse(&x)
+
+		for i := 0; i < m; i++ {
+			t.Mul(&aR[i], &x)
+			aL[i].Add(&aL[i], &t)
+			t.Mul(&bR[i], &xInv)
+			bL[i].Add(&bL[i], &t)
+		}
+		a, b = aL, bL
+		if g == nil {
+			g = c.foldCRS(xInv)
+		} else {
+			g = foldPoints(g[:m], g[m:], &xInv)
+		}
+	}
+	res.A = a[0]
+
+	return res, nil
+}
+
+// verifyInnerProduct verifies proof for the vector committed in commitment, b and the value v.
+// With the folded generator ∑ᵢ sᵢ⋅Gᵢ and the folded b, ⟨s, b⟩, it checks that
+//
+//	C + v⋅w⋅Q + ∑ⱼ (xⱼ⋅Lⱼ + xⱼ⁻¹⋅Rⱼ) = A⋅∑ᵢ sᵢ⋅Gᵢ + A⋅⟨s, b⟩⋅w⋅Q
+func (c *Config) verifyInnerProduct(fs *fiatshamir.Transcript, commitment *banderwagon.Element, b []fr.Element, v *fr.Element, proof *IPAProof) error {
+	w, err := deriveChallenge(fs, "w")
+	if err != nil {
+		return err
+	}
+	xs := make([]fr.Element, nbRounds)
+	for j := range xs {
+		if xs[j], err = deriveRoundChallenge(fs, j, &proof.L[j], &proof.R[j]); err != nil {
+			return err
+		}
+	}
+	xInv := fr.BatchInvert(xs)
+
+	// sᵢ = ∏ⱼ xⱼ⁻¹ for the rounds j where i is in the right half, the first round splitting on the
+	// most significant bit
+	s := make([]fr.Element, NodeWidth)
+	s[0].SetOne()
+	for j, size := 0, 1; j < nbRounds; j, size = j+1, size*2 {
+		for i := size - 1; i >= 0; i-- {
+			s[2*i+1].Mul(&s[i], &xInv[j])
+			s[2*i] = s[i]
+		}
+	}
+	bFolded := innerProduct(s, b)
+
+	points := make([]banderwagon.Element, 0, 2*nbRounds+1)
+	scalars := make([]fr.Element, 0, 2*nbRounds+1)
+	points = append(points, *commitment)
+	scalars = append(scalars, fr.One())
+	for j := range xs {
+		points = append(points, proof.L[j], proof.R[j])
+		scalars = append(scalars, xs[j], xInv[j])
+	}
+	res := multiExp(points, scalars)
+
+	for i := range s {
+		s[i].Mul(&s[i], &proof.A).Neg(&s[i])
+	}
+	g := c.msm(s, 0)
+	res.Add(&res, &g)
+
+	var qCoeff fr.Element
+	qCoeff.Mul(&proof.A, &bFolded).Sub(v, &qCoeff).Mul(&qCoeff, &w)
+	q := c.mulQ(&qCoeff)
+	res.Add(&res, &q)
+
+	if !res.IsIdentity() {
+		return ErrVerifyMultiProof
+	}
+	return nil
+}
+
+// foldCRS returns the generators Gᵢ + s⋅Gₘ₊ᵢ, m being NodeWidth/2
+func (c *Config) foldCRS(s fr.Element) []banderwagon.Element {
+	const m = NodeWidth / 2
+	res := make([]banderwagon.Element, m)
+	parallel.Execute(m, func(start, end int) {
+		for i := start; i < end; i++ {
+			t := c.tables[m+i].mul(&s)
+			res[i].FromAffine(&c.CRS[i]).Add(&res[i], &t)
+		}
+	})
+	return res
+}
+
+// foldPoints returns the points lo[i] + s⋅hi[i]
+func foldPoints(lo, hi []banderwagon.Element, s *fr.Element) []banderwagon.Element {
+	res := make([]banderwagon.Element, len(lo))
+	parallel.Execute(len(lo), func(start, end int) {
+		for i := start; i < end; i++ {
+			res[i].ScalarMultiplication(&hi[i], s).Add(&res[i], &lo[i])
+		}
+	})
+	return res
+}
+
+// multiExp returns ∑ᵢ scalars[i]⋅points[i], with len(points) = len(scalars)
+func multiExp(points []banderwagon.Element, scalars []fr.Element) banderwagon.Element {
+	var res banderwagon.Element
+	res.SetIdentity()
+	var lock sync.Mutex
+	parallel.Execute(len(points), func(start, end int) {
+		var acc, t banderwagon.Element
+		acc.SetIdentity()
+		for i := start; i < end; i++ {
+			t.ScalarMultiplication(&points[i], &scalars[i])
+			acc.Add(&acc, &t)
+		}
+		lock.Lock()
+		res.Add(&res, &acc)
+		lock.Unlock()
+	})
+	return res
+}
+
+func roundChallengeName(j int) string {
+	return "x" + strconv.Itoa(j)
+}
+
+// deriveRoundChallenge binds L and R to the challenge of round j and computes it
+func deriveRoundChallenge(fs *fiatshamir.Transcript, j int, l, r *banderwagon.Element) (fr.Element, error) {
+	name := roundChallengeName(j)
+	lBytes, rBytes := l.Bytes(), r.Bytes()
+	if err := fs.Bind(name, lBytes[:]); err != nil {
+		return fr.Element{}, err
+	}
+	if err := fs.Bind(name, rBytes[:]); err != nil {
+		return fr.Element{}, err
+	}
+	return deriveChallenge(fs, name)
+}
+
+// deriveChallenge computes the challenge of the given name as a non-zero field element
+func deriveChallenge(fs *fiatshamir.Transcript, name string) (fr.Element, error) {
+	b, err := fs.ComputeChallenge(name)
+	if err != nil {
+		return fr.Element{}, err
+	}
+	var res fr.Element
+	res.SetBytes(b)
+	if res.IsZero() {
+		return fr.Element{}, ErrZeroChallenge
+	}
+	return res, nil
+}
+
+// innerProduct returns ∑ᵢ a[i]⋅b[i], with len(a) = len(b)
+func innerProduct(a, b []fr.Element) fr.Element {
+	var res, t fr.Element
+	for i := range a {
+		t.Mul(&a[i], &b[i])
+		res.Add(&res, &t)
+	}
+	return res
+}
@@ -0,0 +1,254 @@
+package verkle
+
+import (
+	"errors"
+	"hash"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch/banderwagon"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch/fr"
+	"github.com/consensys/gnark-crypto/fiat-shamir"
+)
+
+// SizeMultiProof size of the encoding of a MultiProof: D, the Lⱼ, the Rⱼ and A
+const SizeMultiProof = (1+2*nbRounds)*banderwagon.SizeElement + fr.Bytes
+
+var (
+	ErrInvalidNbQueries = errors.New("a multiproof needs at least one query")
+	ErrZeroChallenge    = errors.New("a challenge is zero")
+	ErrVerifyMultiProof = errors.New("can't verify multiproof")
+)
+
+// ProverQuery opening of a committed polynomial at a point of the domain
+type ProverQuery struct {
+	Commitment banderwagon.Element
+
+	// Values evaluations of the polynomial on the domain, len(Values) ≤ NodeWidth, the other
+	// evaluations being zero
+	Values []fr.Element
+
+	Point uint8
+}
+
+// VerifierQuery claimed evaluation of a committed polynomial at a point of the domain
+type VerifierQuery struct {
+	Commitment banderwagon.Element
+	Point      uint8
+	Result     fr.Element
+}
+
+// MultiProof proof of the evaluations of several polynomials, at points of the domain.
+//
+// With the challenge r, the openings are aggregated in g(X) = ∑ᵢ rⁱ⋅(fᵢ(X) - yᵢ)/(X - zᵢ). With the
+// challenge t, h(X) = ∑ᵢ rⁱ⋅fᵢ(X)/(t - zᵢ) is such that (h - g)(t) = ∑ᵢ rⁱ⋅yᵢ/(t - zᵢ), which is
+// proven with an inner-product argument against the commitment E - D, where E = ∑ᵢ rⁱ/(t - zᵢ)⋅Cᵢ.
+type MultiProof struct {
+	// D commitment to g
+	D banderwagon.Element
+
+	// IPA proof of the evaluation of h - g at t
+	IPA IPAProof
+}
+
+// CreateMultiProof returns a proof of the evaluations of the polynomials of queries. The challenges
+// are derived with hf, dataTranscript being bound to the first one.
+func (c *Config) CreateMultiProof(queries []ProverQuery, hf hash.Hash, dataTranscript ...[]byte) (MultiProof, error) {
+	if len(queries) == 0 {
+		return MultiProof{}, ErrInvalidNbQueries
+	}
+	commitments := make([]banderwagon.Element, len(queries))
+	points := make([]uint8, len(queries))
+	results := make([]fr.Element, len(queries))
+	for i := range queries {
+		if len(queries[i].Values) > NodeWidth {
+			return MultiProof{}, ErrInvalidVectorSize
+		}
+		commitments[i] = queries[i].Commitment
+		points[i] = queries[i].Point
+		if int(points[i]) < len(queries[i].Values) {
+			results[i] = queries[i].Values[points[i]]
+		}
+	}
+
+	fs := newTranscript(hf, commitments, points, results, dataTranscript)
+	r, err := deriveChallenge(fs, "r")
+	if err != nil {
+		return MultiProof{}, err
+	}
+
+	// the polynomials are aggregated by point: fₖ = ∑ᵢ rⁱ⋅fᵢ for zᵢ = k
+	var aggregated [NodeWidth][]fr.Element
+	var rPow, t fr.Element
+	rPow.SetOne()
+	for i := range queries {
+		z := queries[i].Point
+		if aggregated[z] == nil {
+			aggregated[z] = make([]fr.Element, NodeWidth)
+		}
+		for k := range queries[i].Values {
+			t.Mul(&queries[i].Values[k], &rPow)
+			aggregated[z][k].Add(&aggregated[z][k], &t)
+		}
+		rPow.Mul(&rPow, &r)
+	}
+
+	// g = ∑ₖ (fₖ(X) - fₖ(k)) / (X - k)
+	g := make([]fr.Element, NodeWidth)
+	for z := range aggregated {
+		if aggregated[z] == nil {
+			continue
+		}
+		q := c.domain.divide(aggregated[z], uint8(z))
+		for k := range g {
+			g[k].Add(&g[k], &q[k])
+		}
+	}
+
+	var res MultiProof
+	res.D = c.msm(g, 0)
+	tChallenge, err := bindAndDeriveT(fs, &res.D)
+	if err != nil {
+		return MultiProof{}, err
+	}
+	lagrange, invT, err := c.domain.barycentric(&tChallenge)
+	if err != nil {
+		return MultiProof{}, err
+	}
+
+	// h - g, with h = ∑ₖ fₖ / (t - k)
+	a := make([]fr.Element, NodeWidth)
+	for z := range aggregated {
+		if aggregated[z] == nil {
+			continue
+		}
+		for k := range a {
+			t.Mul(&aggregated[z][k], &invT[z])
+			a[k].Add(&a[k], &t)
+		}
+	}
+	for k := range a {
+		a[k].Sub(&a[k], &g[k])
+	}
+
+	if res.IPA, err = c.proveInnerProduct(fs, a, lagrange); err != nil {
+		return MultiProof{}, err
+	}
+	return res, nil
+}
+
+// VerifyMultiProof verifies that proof proves the evaluations claimed by queries
+func (c *Config) VerifyMultiProof(queries []VerifierQuery, proof *MultiProof, hf hash.Hash, dataTranscript ...[]byte) error {
+	if len(queries) == 0 {
+		return ErrInvalidNbQueries
+	}
+	commitments := make([]banderwagon.Element, len(queries))
+	points := make([]uint8, len(queries))
+	results := make([]fr.Element, len(queries))
+	for i := range queries {
+		commitments[i] = queries[i].Commitment
+		points[i] = queries[i].Point
+		results[i] = queries[i].Result
+	}
+
+	fs := newTranscript(hf, commitments, points, results, dataTranscript)
+	r, err := deriveChallenge(fs, "r")
+	if err != nil {
+		return err
+	}
+	tChallenge, err := bindAndDeriveT(fs, &proof.D)
+	if err != nil {
+		return err
+	}
+	lagrange, invT, err := c.domain.barycentric(&tChallenge)
+	if err != nil {
+		return err
+	}
+
+	// E = ∑ᵢ rⁱ/(t - zᵢ)⋅Cᵢ and the evaluation of h - g at t, v = ∑ᵢ rⁱ⋅yᵢ/(t - zᵢ)
+	coefficients := make([]fr.Element, len(queries))
+	var rPow, v, t fr.Element
+	rPow.SetOne()
+	for i := range queries {
+		coefficients[i].Mul(&rPow, &invT[points[i]])
+		t.Mul(&coefficients[i], &results[i])
+		v.Add(&v, &t)
+		rPow.Mul(&rPow, &r)
+	}
+	e := multiExp(commitments, coefficients)
+	e.Sub(&e, &proof.D)
+
+	return c.verifyInnerProduct(fs, &e, lagrange, &v, &proof.IPA)
+}
+
+// Bytes returns the encoding of p: D ‖ L₀ ‖ ... ‖ L₇ ‖ R₀ ‖ ... ‖ R₇ ‖ A
+func (p *MultiProof) Bytes() []byte {
+	points := make([]banderwagon.Element, 0, 1+2*nbRounds)
+	points = append(points, p.D)
+	points = append(points, p.IPA.L[:]...)
+	points = append(points, p.IPA.R[:]...)
+
+	res := make([]byte, 0, SizeMultiProof)
+	for _, b := range banderwagon.BatchBytes(points) {
+		res = append(res, b[:]...)
+	}
+	a := p.IPA.A.Bytes()
+	return append(res, a[:]...)
+}
+
+// SetBytes sets p from its encoding
+func (p *MultiProof) SetBytes(buf []byte) error {
+	if len(buf) != SizeMultiProof {
+		return banderwagon.ErrInvalidEncoding
+	}
+	next := func() []byte {
+		res := buf[:banderwagon.SizeElement]
+		buf = buf[banderwagon.SizeElement:]
+		return res
+	}
+	if err := p.D.SetBytes(next()); err != nil {
+		return err
+	}
+	for j := range p.IPA.L {
+		if err := p.IPA.L[j].SetBytes(next()); err != nil {
+			return err
+		}
+	}
+	for j := range p.IPA.R {
+		if err := p.IPA.R[j].SetBytes(next()); err != nil {
+			return err
+		}
+	}
+	if err := p.IPA.A.SetBytesCanonical(buf); err != nil {
+		return banderwagon.ErrInvalidEncoding
+	}
+	return nil
+}
+
+// newTranscript returns a transcript with the challenges r, t, w, x₀, ..., x₇, the queries and
+// dataTranscript being bound to r
+func newTranscript(hf hash.Hash, commitments []banderwagon.Element, points []uint8, results []fr.Element, dataTranscript [][]byte) *fiatshamir.Transcript {
+	challenges := []string{"r", "t", "w"}
+	for j := 0; j < nbRounds; j++ {
+		challenges = append(challenges, roundChallengeName(j))
+	}
+	fs := fiatshamir.NewTranscript(hf, challenges...)
+
+	// can't fail, the challenge "r" exists and isn't computed yet
+	for i, c := range banderwagon.BatchBytes(commitments) {
+		_ = fs.Bind("r", c[:])
+		_ = fs.Bind("r", []byte{points[i]})
+		_ = fs.Bind("r", results[i].Marshal())
+	}
+	for _, d := range dataTranscript {
+		_ = fs.Bind("r", d)
+	}
+	return fs
+}
+
+// bindAndDeriveT binds D to the challenge t and computes it
+func bindAndDeriveT(fs *fiatshamir.Transcript, d *banderwagon.Element) (fr.Element, error) {
+	b := d.Bytes()
+	if err := fs.Bind("t", b[:]); err != nil {
+		return fr.Element{}, err
+	}
+	return deriveChallenge(fs, "t")
+}
@@ -0,0 +1,120 @@
+package verkle
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch/banderwagon"
+	"github.com/stretchr/testify/require"
+)
+
+// testQueries returns queries opening nodes, some of them at several points, or several of them
+// at the same point
+func testQueries(t require.TestingT) ([]ProverQuery, []VerifierQuery) {
+	assert := require.New(t)
+
+	nodes := make([]*Node, 3)
+	for i := range nodes {
+		var err error
+		nodes[i], err = testConfig.NewNode(randomVector(NodeWidth))
+		assert.NoError(err)
+	}
+	openings := []struct {
+		node  int
+		point uint8
+	}{
+		{0, 0}, {0, 255}, {1, 0}, {2, 17}, {1, 42}, {2, 17},
+	}
+
+	proverQueries := make([]ProverQuery, len(openings))
+	verifierQueries := make([]VerifierQuery, len(openings))
+	for i, o := range openings {
+		proverQueries[i] = nodes[o.node].ProverQuery(o.point)
+		verifierQueries[i] = nodes[o.node].VerifierQuery(o.point)
+	}
+	return proverQueries, verifierQueries
+}
+
+func TestMultiProof(t *testing.T) {
+	assert := require.New(t)
+
+	proverQueries, verifierQueries := testQueries(t)
+	proof, err := testConfig.CreateMultiProof(proverQueries, sha256.New(), []byte("data"))
+	assert.NoError(err)
+	assert.NoError(testConfig.VerifyMultiProof(verifierQueries, &proof, sha256.New(), []byte("data")))
+
+	// a single query
+	single, err := testConfig.CreateMultiProof(proverQueries[1:2], sha256.New())
+	assert.NoError(err)
+	assert.NoError(testConfig.VerifyMultiProof(verifierQueries[1:2], &single, sha256.New()))
+
+	// wrong transcript
+	assert.ErrorIs(testConfig.VerifyMultiProof(verifierQueries, &proof, sha256.New(), []byte("other data")), ErrVerifyMultiProof)
+
+	// wrong result
+	wrongQueries := make([]VerifierQuery, len(verifierQueries))
+	copy(wrongQueries, verifierQueries)
+	wrongQueries[3].Result.SetRandom()
+	assert.ErrorIs(testConfig.VerifyMultiProof(wrongQueries, &proof, sha256.New(), []byte("data")), ErrVerifyMultiProof)
+
+	// wrong point
+	copy(wrongQueries, verifierQueries)
+	wrongQueries[0].Point++
+	assert.ErrorIs(testConfig.VerifyMultiProof(wrongQueries, &proof, sha256.New(), []byte("data")), ErrVerifyMultiProof)
+
+	// wrong proof
+	wrongProof := proof
+	wrongProof.IPA.A.SetRandom()
+	assert.ErrorIs(testConfig.VerifyMultiProof(verifierQueries, &wrongProof, sha256.New(), []byte("data")), ErrVerifyMultiProof)
+	wrongProof = proof
+	wrongProof.D = proof.IPA.L[0]
+	assert.ErrorIs(testConfig.VerifyMultiProof(verifierQueries, &wrongProof, sha256.New(), []byte("data")), ErrVerifyMultiProof)
+
+	_, err = testConfig.CreateMultiProof(nil, sha256.New())
+	assert.ErrorIs(err, ErrInvalidNbQueries)
+	assert.ErrorIs(testConfig.VerifyMultiProof(nil, &proof, sha256.New()), ErrInvalidNbQueries)
+}
+
+func TestMultiProofEncoding(t *testing.T) {
+	assert := require.New(t)
+
+	proverQueries, verifierQueries := testQueries(t)
+	proof, err := testConfig.CreateMultiProof(proverQueries, sha256.New())
+	assert.NoError(err)
+
+	b := proof.Bytes()
+	assert.Equal(SizeMultiProof, len(b))
+	var decoded MultiProof
+	assert.NoError(decoded.SetBytes(b))
+	assert.Equal(b, decoded.Bytes())
+
+	// the decoded points may be other representatives of the same elements, and so may be the
+	// commitments of the verifier
+	for i := range verifierQueries {
+		c := verifierQueries[i].Commitment.Bytes()
+		assert.NoError(verifierQueries[i].Commitment.SetBytes(c[:]))
+	}
+	assert.NoError(testConfig.VerifyMultiProof(verifierQueries, &decoded, sha256.New()))
+
+	assert.ErrorIs(decoded.SetBytes(b[1:]), banderwagon.ErrInvalidEncoding)
+}
+
+func BenchmarkCreateMultiProof(b *testing.B) {
+	proverQueries, _ := testQueries(b)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = testConfig.CreateMultiProof(proverQueries, sha256.New())
+	}
+}
+
+func BenchmarkVerifyMultiProof(b *testing.B) {
+	proverQueries, verifierQueries := testQueries(b)
+	proof, err := testConfig.CreateMultiProof(proverQueries, sha256.New())
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = testConfig.VerifyMultiProof(verifierQueries, &proof, sha256.New())
+	}
+}
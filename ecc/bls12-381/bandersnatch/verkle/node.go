@@ -0,0 +1,76 @@
+package verkle
+
+import (
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch/banderwagon"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch/fr"
+)
+
+// Node node of a verkle tree: NodeWidth values and their commitment, kept up to date with the
+// values.
+type Node struct {
+	config     *Config
+	values     [NodeWidth]fr.Element
+	commitment banderwagon.Element
+}
+
+// NewNode returns a node holding values, len(values) ≤ NodeWidth, the other values being zero
+func (c *Config) NewNode(values []fr.Element) (*Node, error) {
+	commitment, err := c.Commit(values)
+	if err != nil {
+		return nil, err
+	}
+	res := &Node{config: c, commitment: commitment}
+	copy(res.values[:], values)
+	return res, nil
+}
+
+// Commitment returns the commitment to the values of n
+func (n *Node) Commitment() banderwagon.Element {
+	return n.commitment
+}
+
+// Hash returns the commitment of n mapped to a scalar, the value of n in its parent
+func (n *Node) Hash() fr.Element {
+	return n.commitment.MapToScalarField()
+}
+
+// Value returns the value of n at index
+func (n *Node) Value(index uint8) fr.Element {
+	return n.values[index]
+}
+
+// Values returns a copy of the values of n
+func (n *Node) Values() []fr.Element {
+	res := make([]fr.Element, NodeWidth)
+	copy(res, n.values[:])
+	return res
+}
+
+// Update sets the value of n at index and updates the commitment with the delta commitment
+func (n *Node) Update(index uint8, value fr.Element) {
+	n.config.UpdateCommitment(&n.commitment, index, &n.values[index], &value)
+	n.values[index] = value
+}
+
+// UpdateChild sets the value of n at index to the hash of child
+func (n *Node) UpdateChild(index uint8, child *Node) {
+	n.Update(index, child.Hash())
+}
+
+// ProverQuery returns the query opening n at index
+func (n *Node) ProverQuery(index uint8) ProverQuery {
+	return ProverQuery{
+		Commitment: n.commitment,
+		Values:     n.Values(),
+		Point:      index,
+	}
+}
+
+// VerifierQuery returns the query checking the value of n at index
+func (n *Node) VerifierQuery(index uint8) VerifierQuery {
+	return VerifierQuery{
+		Commitment: n.commitment,
+		Point:      index,
+		Result:     n.values[index],
+	}
+}
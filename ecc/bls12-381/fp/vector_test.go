@@ -80,6 +80,89 @@ func TestVectorEmptyRoundTrip(t *testing.T) {
 	assert.True(reflect.DeepEqual(v3, v2))
 }
 
+func TestVectorInnerProduct(t *testing.T) {
+	assert := require.New(t)
+
+	for _, n := range []int{0, 1, 7, 256} {
+		a, b := make(Vector, n), make(Vector, n)
+		for i := 0; i < n; i++ {
+			a[i].SetRandom()
+			b[i].SetRandom()
+		}
+		// q-1 maximizes the unreduced products
+		if n > 1 {
+			a[0].SetOne().Neg(&a[0])
+			b[0].Set(&a[0])
+		}
+
+		var expected, tmp Element
+		for i := 0; i < n; i++ {
+			tmp.Mul(&a[i], &b[i])
+			expected.Add(&expected, &tmp)
+		}
+
+		ip := a.InnerProduct(b)
+		assert.True(ip.Equal(&expected), "inner product of size %d", n)
+	}
+}
+
+func TestAccumulator(t *testing.T) {
+	assert := require.New(t)
+
+	var minusOne, expected, tmp Element
+	minusOne.SetOne().Neg(&minusOne)
+
+	// many maximal products, to exercise the carry word
+	var acc, acc2 Accumulator
+	for i := 0; i < 1000; i++ {
+		acc.MulAcc(&minusOne, &minusOne)
+		tmp.Mul(&minusOne, &minusOne)
+		expected.Add(&expected, &tmp)
+	}
+	r := acc.Reduce()
+	assert.True(r.Equal(&expected))
+
+	// splitting the sum in two accumulators
+	var x, y Element
+	x.SetRandom()
+	y.SetRandom()
+	acc2.MulAcc(&x, &y)
+	acc.Add(&acc, &acc2)
+	tmp.Mul(&x, &y)
+	expected.Add(&expected, &tmp)
+	r = acc.Reduce()
+	assert.True(r.Equal(&expected))
+
+	acc.Reset()
+	r = acc.Reduce()
+	assert.True(r.IsZero())
+}
+
+func BenchmarkVectorInnerProduct(b *testing.B) {
+	const n = 1 << 16
+	x, y := make(Vector, n), make(Vector, n)
+	for i := 0; i < n; i++ {
+		x[i].SetRandom()
+		y[i].SetRandom()
+	}
+
+	b.Run("naive", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			var res, tmp Element
+			for i := 0; i < n; i++ {
+				tmp.Mul(&x[i], &y[i])
+				res.Add(&res, &tmp)
+			}
+		}
+	})
+
+	b.Run("accumulator", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			_ = x.InnerProduct(y)
+		}
+	})
+}
+
 func (vector *Vector) unmarshalBinaryAsync(data []byte) error {
 	r := bytes.NewReader(data)
 	_, err, chErr := vector.AsyncReadFrom(r)
@@ -43,6 +43,11 @@ func (domain *Domain) FFT(a []fr.Element, decimation Decimation, opts ...Option)
 
 	opt := fftOptions(opts...)
 
+	if domain.isMixedRadix() {
+		domain.fftMixedRadix(a, false, opt)
+		return
+	}
+
 	// find the stage where we should stop spawning go routines in our recursive calls
 	// (ie when we have as many go routines running as we have available CPUs)
 	maxSplits := bits.TrailingZeros64(ecc.NextPowerOfTwo(uint64(opt.nbTasks)))
@@ -121,6 +126,11 @@ func (domain *Domain) FFT(a []fr.Element, decimation Decimation, opts ...Option)
 func (domain *Domain) FFTInverse(a []fr.Element, decimation Decimation, opts ...Option) {
 	opt := fftOptions(opts...)
 
+	if domain.isMixedRadix() {
+		domain.fftMixedRadix(a, true, opt)
+		return
+	}
+
 	// find the stage where we should stop spawning go routines in our recursive calls
 	// (ie when we have as many go routines running as we have available CPUs)
 	maxSplits := bits.TrailingZeros64(ecc.NextPowerOfTwo(uint64(opt.nbTasks)))
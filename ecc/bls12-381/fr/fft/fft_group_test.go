@@ -0,0 +1,120 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+
+	curve "github.com/consensys/gnark-crypto/ecc/bls12-381"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFFTG1(t *testing.T) {
+	assert := require.New(t)
+
+	const n = 16
+	base, _, _, _ := curve.Generators()
+
+	// a[i] = [sᵢ]base, so that the transform of a is [ŝᵢ]base where ŝ is the transform of s
+	check := func(a []curve.G1Jac, s []fr.Element) {
+		for i := range a {
+			var e big.Int
+			var expected curve.G1Jac
+			expected.ScalarMultiplication(&base, s[i].BigInt(&e))
+			assert.True(expected.Equal(&a[i]), "index %d", i)
+		}
+	}
+
+	for _, domain := range []*Domain{NewDomain(n), NewDomain(n, WithoutPrecompute())} {
+		for _, decimation := range []Decimation{DIF, DIT} {
+			for _, opts := range [][]Option{nil, {OnCoset()}, {WithNbTasks(1)}, {OnCoset(), WithNbTasks(4)}} {
+				s := make([]fr.Element, n)
+				a := make([]curve.G1Jac, n)
+				for i := range s {
+					s[i].SetRandom()
+					var e big.Int
+					a[i].ScalarMultiplication(&base, s[i].BigInt(&e))
+				}
+
+				domain.FFTG1(a, decimation, opts...)
+				domain.FFT(s, decimation, opts...)
+				check(a, s)
+
+				domain.FFTInverseG1(a, decimation, opts...)
+				domain.FFTInverse(s, decimation, opts...)
+				check(a, s)
+			}
+		}
+	}
+}
+
+func TestFFTG2(t *testing.T) {
+	assert := require.New(t)
+
+	const n = 16
+	_, base, _, _ := curve.Generators()
+
+	// a[i] = [sᵢ]base, so that the transform of a is [ŝᵢ]base where ŝ is the transform of s
+	check := func(a []curve.G2Jac, s []fr.Element) {
+		for i := range a {
+			var e big.Int
+			var expected curve.G2Jac
+			expected.ScalarMultiplication(&base, s[i].BigInt(&e))
+			assert.True(expected.Equal(&a[i]), "index %d", i)
+		}
+	}
+
+	for _, domain := range []*Domain{NewDomain(n), NewDomain(n, WithoutPrecompute())} {
+		for _, decimation := range []Decimation{DIF, DIT} {
+			for _, opts := range [][]Option{nil, {OnCoset()}, {WithNbTasks(1)}, {OnCoset(), WithNbTasks(4)}} {
+				s := make([]fr.Element, n)
+				a := make([]curve.G2Jac, n)
+				for i := range s {
+					s[i].SetRandom()
+					var e big.Int
+					a[i].ScalarMultiplication(&base, s[i].BigInt(&e))
+				}
+
+				domain.FFTG2(a, decimation, opts...)
+				domain.FFT(s, decimation, opts...)
+				check(a, s)
+
+				domain.FFTInverseG2(a, decimation, opts...)
+				domain.FFTInverse(s, decimation, opts...)
+				check(a, s)
+			}
+		}
+	}
+}
+
+func BenchmarkFFTG1(b *testing.B) {
+	const n = 1 << 10
+	domain := NewDomain(n)
+	g1, _, _, _ := curve.Generators()
+	a := make([]curve.G1Jac, n)
+	for i := range a {
+		a[i] = g1
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		domain.FFTG1(a, DIF)
+	}
+}
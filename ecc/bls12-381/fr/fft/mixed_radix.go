@@ -0,0 +1,366 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/internal/parallel"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+// ErrNoRootOfUnity is returned when the field doesn't have the roots of unity
+// needed to build a domain of the requested size.
+var ErrNoRootOfUnity = errors.New("fft: the field has no multiplicative subgroup of the requested size")
+
+// mixedRadices are the radices supported by mixed-radix domains, see NewDomainMixedRadix.
+var mixedRadices = [...]uint64{2, 3, 5}
+
+// NewDomainMixedRadix returns a multiplicative subgroup of cardinality n = 2ᵃ⋅3ᵇ⋅5ᶜ, the smallest such
+// n ≥ m that divides r-1. It returns ErrNoRootOfUnity if the field has no such subgroup.
+//
+// If n is a power of 2, this is the domain returned by NewDomain(n). Otherwise, the FFTs on the domain
+// use a mixed-radix Cooley-Tukey algorithm, and take and return their inputs in natural order, regardless
+// of the decimation.
+func NewDomainMixedRadix(m uint64, opts ...DomainOption) (*Domain, error) {
+	n, ok := mixedRadixCardinality(m)
+	if !ok {
+		return nil, ErrNoRootOfUnity
+	}
+	if n&(n-1) == 0 {
+		return NewDomain(n, opts...), nil
+	}
+
+	opt := domainOptions(opts...)
+	domain := &Domain{}
+	domain.Cardinality = n
+	domain.FrMultiplicativeGen = GeneratorFullMultiplicativeGroup()
+	if opt.shift != nil {
+		domain.FrMultiplicativeGen.Set(opt.shift)
+	}
+	domain.FrMultiplicativeGenInv.Inverse(&domain.FrMultiplicativeGen)
+
+	var err error
+	domain.Generator, err = mixedRadixGenerator(n)
+	if err != nil {
+		return nil, err
+	}
+	domain.GeneratorInv.Inverse(&domain.Generator)
+	domain.CardinalityInv.SetUint64(n).Inverse(&domain.CardinalityInv)
+
+	domain.withPrecompute = opt.withPrecompute
+	if domain.withPrecompute {
+		domain.preComputeTwiddles()
+	}
+
+	return domain, nil
+}
+
+// mixedRadixCardinality returns the smallest n = 2ᵃ⋅3ᵇ⋅5ᶜ ≥ m dividing r-1, or false if there is none.
+func mixedRadixCardinality(m uint64) (uint64, bool) {
+	if m <= 1 {
+		return 1, true
+	}
+
+	// max exponents of 2, 3 and 5 in r-1
+	var maxExp [len(mixedRadices)]int
+	rMinusOne := fr.Modulus()
+	rMinusOne.Sub(rMinusOne, big.NewInt(1))
+	var q, rem big.Int
+	for i, p := range mixedRadices {
+		bp := new(big.Int).SetUint64(p)
+		t := new(big.Int).Set(rMinusOne)
+		for {
+			q.QuoRem(t, bp, &rem)
+			if rem.Sign() != 0 {
+				break
+			}
+			t.Set(&q)
+			maxExp[i]++
+		}
+	}
+
+	best, found := uint64(0), false
+	const maxCardinality = uint64(1) << 62
+	for n5, c := uint64(1), 0; c <= maxExp[2] && n5 <= maxCardinality; n5, c = n5*5, c+1 {
+		for n3, b := n5, 0; b <= maxExp[1] && n3 <= maxCardinality; n3, b = n3*3, b+1 {
+			for n, a := n3, 0; a <= maxExp[0] && n <= maxCardinality; n, a = n*2, a+1 {
+				if n >= m {
+					if !found || n < best {
+						best, found = n, true
+					}
+					break
+				}
+			}
+		}
+	}
+
+	return best, found
+}
+
+// mixedRadixGenerator returns a primitive n-th root of unity ω, or ErrNoRootOfUnity if n doesn't divide r-1.
+// Writing n = P⋅2ᵏ with P odd, ω is chosen such that ω^P = Generator(2ᵏ), so that the radix-2 part of
+// the mixed-radix FFTs can be delegated to the power of 2 domain of size 2ᵏ.
+func mixedRadixGenerator(n uint64) (fr.Element, error) {
+	var res fr.Element
+	rMinusOne := fr.Modulus()
+	rMinusOne.Sub(rMinusOne, big.NewInt(1))
+
+	var rem big.Int
+	rem.Mod(rMinusOne, new(big.Int).SetUint64(n))
+	if rem.Sign() != 0 {
+		return res, ErrNoRootOfUnity
+	}
+
+	m := n & -n // 2ᵏ
+	p := n / m  // P
+
+	// ζ, a primitive P-th root of unity
+	var e big.Int
+	e.Quo(rMinusOne, new(big.Int).SetUint64(p))
+	g := GeneratorFullMultiplicativeGroup()
+	res.Exp(g, &e)
+
+	// γ, the 2ᵏ-th root of unity such that γ^P = Generator(2ᵏ)
+	if m > 1 {
+		gamma, err := Generator(m)
+		if err != nil {
+			return res, ErrNoRootOfUnity
+		}
+		var pInv big.Int
+		pInv.ModInverse(new(big.Int).SetUint64(p), new(big.Int).SetUint64(m))
+		gamma.Exp(gamma, &pInv)
+		res.Mul(&res, &gamma)
+	}
+
+	// res has order exactly n iff res^(n/p) != 1 for the prime factors p of n
+	for _, q := range mixedRadices {
+		if n%q != 0 {
+			continue
+		}
+		var t fr.Element
+		t.Exp(res, new(big.Int).SetUint64(n/q))
+		if t.IsOne() {
+			return res, ErrNoRootOfUnity
+		}
+	}
+
+	return res, nil
+}
+
+// isMixedRadix returns true if the cardinality of the domain is not a power of 2
+func (domain *Domain) isMixedRadix() bool {
+	return domain.Cardinality&(domain.Cardinality-1) != 0
+}
+
+// radices returns the factorization of the cardinality of the domain in radices 5, 3 and 2;
+// the largest radices are used first, at the outer levels of the recursion.
+func (domain *Domain) radices() []int {
+	var res []int
+	n := domain.Cardinality
+	for i := len(mixedRadices) - 1; i >= 0; i-- {
+		p := mixedRadices[i]
+		for n%p == 0 {
+			res = append(res, int(p))
+			n /= p
+		}
+	}
+	if n != 1 {
+		panic("fft: invalid mixed-radix domain cardinality")
+	}
+	return res
+}
+
+// preComputeTwiddlesMixedRadix computes the tables used by mixed-radix FFTs:
+// the n powers of Generator and GeneratorInv, the coset tables and the power of 2 domain
+// on which the radix-2 stages are computed.
+func (domain *Domain) preComputeTwiddlesMixedRadix() {
+	domain.radix2Domain = NewDomain(domain.Cardinality & -domain.Cardinality)
+	domain.twiddles = [][]fr.Element{make([]fr.Element, domain.Cardinality)}
+	domain.twiddlesInv = [][]fr.Element{make([]fr.Element, domain.Cardinality)}
+	domain.cosetTable = make([]fr.Element, domain.Cardinality)
+	domain.cosetTableInv = make([]fr.Element, domain.Cardinality)
+
+	var wg sync.WaitGroup
+	expTable := func(w fr.Element, t []fr.Element) {
+		BuildExpTable(w, t)
+		wg.Done()
+	}
+	wg.Add(4)
+	go expTable(domain.Generator, domain.twiddles[0])
+	go expTable(domain.GeneratorInv, domain.twiddlesInv[0])
+	go expTable(domain.FrMultiplicativeGen, domain.cosetTable)
+	go expTable(domain.FrMultiplicativeGenInv, domain.cosetTableInv)
+	wg.Wait()
+}
+
+// fftMixedRadix computes the (inverse) discrete Fourier transform of a, in natural order, on a
+// mixed-radix domain.
+func (domain *Domain) fftMixedRadix(a []fr.Element, inverse bool, opt fftConfig) {
+	if uint64(len(a)) != domain.Cardinality {
+		panic("fft: len(a) must be equal to the cardinality of the domain")
+	}
+
+	// the n powers of the (inverse) generator
+	var twiddles []fr.Element
+	var w fr.Element
+	if inverse {
+		w = domain.GeneratorInv
+		if domain.withPrecompute {
+			twiddles = domain.twiddlesInv[0]
+		}
+	} else {
+		w = domain.Generator
+		if domain.withPrecompute {
+			twiddles = domain.twiddles[0]
+		}
+	}
+	if twiddles == nil {
+		twiddles = make([]fr.Element, domain.Cardinality)
+		BuildExpTable(w, twiddles)
+	}
+
+	var cosetTable, cosetTableInv []fr.Element
+	if opt.coset {
+		if domain.withPrecompute {
+			cosetTable, cosetTableInv = domain.cosetTable, domain.cosetTableInv
+		} else if inverse {
+			cosetTableInv = make([]fr.Element, domain.Cardinality)
+			BuildExpTable(domain.FrMultiplicativeGenInv, cosetTableInv)
+		} else {
+			cosetTable = make([]fr.Element, domain.Cardinality)
+			BuildExpTable(domain.FrMultiplicativeGen, cosetTable)
+		}
+	}
+
+	// the transform is out of place: a is copied in the input buffer
+	in := make([]fr.Element, len(a))
+	parallel.Execute(len(a), func(start, end int) {
+		if opt.coset && !inverse {
+			for i := start; i < end; i++ {
+				in[i].Mul(&a[i], &cosetTable[i])
+			}
+		} else {
+			copy(in[start:end], a[start:end])
+		}
+	}, opt.nbTasks)
+
+	radix2Domain := domain.radix2Domain
+	if radix2Domain == nil {
+		radix2Domain = NewDomain(domain.Cardinality&-domain.Cardinality, WithoutPrecompute())
+	}
+
+	mixedRadixFFT(a, in, len(a), 1, domain.radices(), twiddles, 1, radix2Domain, inverse, opt.nbTasks)
+
+	if inverse {
+		parallel.Execute(len(a), func(start, end int) {
+			for i := start; i < end; i++ {
+				a[i].Mul(&a[i], &domain.CardinalityInv)
+				if opt.coset {
+					a[i].Mul(&a[i], &cosetTableInv[i])
+				}
+			}
+		}, opt.nbTasks)
+	}
+}
+
+// minMixedRadixParallel is the size of the sub-transforms below which mixedRadixFFT runs sequentially
+const minMixedRadixParallel = 1 << 10
+
+// mixedRadixFFT computes the DFT of size n of (in[0], in[stride], ..., in[(n-1)⋅stride]) into out[:n],
+// decimating in time along the radices. twiddles holds the N powers of a primitive N-th root of unity ω,
+// where N = n⋅twStride: the n-th root of unity used at this level is ω^twStride.
+// Once only radix 2 remains, the transform is computed by radix2Domain, whose generator (or its inverse)
+// is the n-th root of unity of this level.
+func mixedRadixFFT(out, in []fr.Element, n, stride int, radices []int, twiddles []fr.Element, twStride int, radix2Domain *Domain, inverse bool, nbTasks int) {
+	if n == 1 {
+		out[0] = in[0]
+		return
+	}
+	if radices[0] == 2 {
+		for i := 0; i < n; i++ {
+			out[i] = in[i*stride]
+		}
+		radix2Domain.FFT(out[:n], DIF, WithNbTasks(nbTasks))
+		BitReverse(out[:n])
+		if inverse {
+			// the transform with the inverse root of unity is the same, with indices negated mod n;
+			// unlike FFTInverse, this doesn't scale by 1/n, which is done once for the full transform
+			for i, j := 1, n-1; i < j; i, j = i+1, j-1 {
+				out[i], out[j] = out[j], out[i]
+			}
+		}
+		return
+	}
+	p := radices[0]
+	m := n / p
+
+	// p sub-transforms of size m, on the inputs of index j mod p
+	if nbTasks > 1 && m >= minMixedRadixParallel {
+		var wg sync.WaitGroup
+		wg.Add(p)
+		for j := 0; j < p; j++ {
+			go func(j int) {
+				mixedRadixFFT(out[j*m:(j+1)*m], in[j*stride:], m, stride*p, radices[1:], twiddles, twStride*p, radix2Domain, inverse, nbTasks/p)
+				wg.Done()
+			}(j)
+		}
+		wg.Wait()
+	} else {
+		for j := 0; j < p; j++ {
+			mixedRadixFFT(out[j*m:(j+1)*m], in[j*stride:], m, stride*p, radices[1:], twiddles, twStride*p, radix2Domain, inverse, 1)
+		}
+	}
+
+	// out[k + m⋅s] = ∑ⱼ ωₙ^(j⋅k) ⋅ ωₚ^(j⋅s) ⋅ outⱼ[k]
+	N := len(twiddles)
+	combine := func(start, end int) {
+		var t [5]fr.Element
+		var u, acc fr.Element
+		for k := start; k < end; k++ {
+			t[0] = out[k]
+			for j := 1; j < p; j++ {
+				t[j].Mul(&out[j*m+k], &twiddles[j*k*twStride])
+			}
+			if p == 3 {
+				// with ζ = ωₚ, 1 + ζ + ζ² = 0 hence
+				// out[k] = t₀ + t₁ + t₂, out[k + m] = t₀ - t₂ + ζ(t₁ - t₂), out[k + 2m] = t₀ - t₁ - ζ(t₁ - t₂)
+				u.Sub(&t[1], &t[2]).Mul(&u, &twiddles[N/3])
+				out[k].Add(&t[0], &t[1]).Add(&out[k], &t[2])
+				out[k+m].Sub(&t[0], &t[2]).Add(&out[k+m], &u)
+				out[k+2*m].Sub(&t[0], &t[1]).Sub(&out[k+2*m], &u)
+				continue
+			}
+			for s := 0; s < p; s++ {
+				acc = t[0]
+				for j := 1; j < p; j++ {
+					u.Mul(&t[j], &twiddles[((j*s)%p)*(N/p)])
+					acc.Add(&acc, &u)
+				}
+				out[k+m*s] = acc
+			}
+		}
+	}
+	if nbTasks > 1 && m >= minMixedRadixParallel {
+		parallel.Execute(m, combine, nbTasks)
+	} else {
+		combine(0, m)
+	}
+}
@@ -0,0 +1,418 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package polynomial
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/fft"
+)
+
+// below these sizes, schoolbook algorithms are faster than their FFT-based counterparts
+const (
+	mulFFTThreshold    = 64
+	divNewtonThreshold = 128
+)
+
+var (
+	ErrDivisionByZero   = errors.New("polynomial: division by zero")
+	ErrDuplicatePoints  = errors.New("polynomial: interpolation points are not distinct")
+	ErrInconsistentSize = errors.New("polynomial: number of points and values differ")
+)
+
+// mulDomains caches the FFT domains of Mul by cardinality, since DivRem, SubproductTree and
+// Interpolate multiply many times at the same sizes; it holds at most one domain per power of 2.
+var mulDomains sync.Map
+
+// mulDomain returns the (cached) FFT domain of cardinality the smallest power of 2 ≥ size.
+func mulDomain(size int) *fft.Domain {
+	cardinality := ecc.NextPowerOfTwo(uint64(size))
+	if domain, ok := mulDomains.Load(cardinality); ok {
+		return domain.(*fft.Domain)
+	}
+	domain, _ := mulDomains.LoadOrStore(cardinality, fft.NewDomain(cardinality))
+	return domain.(*fft.Domain)
+}
+
+// normalize removes the leading zero coefficients of p; the zero polynomial is
+// represented by an empty slice.
+func (p Polynomial) normalize() Polynomial {
+	n := len(p)
+	for n > 0 && p[n-1].IsZero() {
+		n--
+	}
+	return p[:n]
+}
+
+// Mul sets p to p1 * p2 and returns p.
+// Large products are computed with FFTs over a domain of size ≥ len(p1) + len(p2) - 1.
+// The result is normalized: it has no leading zero coefficients.
+// This function allocates a new slice, so p may alias p1 or p2.
+func (p *Polynomial) Mul(p1, p2 Polynomial) *Polynomial {
+	p1, p2 = p1.normalize(), p2.normalize()
+	if len(p1) == 0 || len(p2) == 0 {
+		*p = Polynomial{}
+		return p
+	}
+	if len(p1) < mulFFTThreshold || len(p2) < mulFFTThreshold {
+		*p = mulSchoolbook(p1, p2)
+		return p
+	}
+
+	size := len(p1) + len(p2) - 1
+	domain := mulDomain(size)
+
+	a := make([]fr.Element, domain.Cardinality)
+	b := make([]fr.Element, domain.Cardinality)
+	copy(a, p1)
+	copy(b, p2)
+
+	// a and b are evaluated in bit reversed order, which is what the DIT inverse FFT expects
+	domain.FFT(a, fft.DIF)
+	domain.FFT(b, fft.DIF)
+	for i := range a {
+		a[i].Mul(&a[i], &b[i])
+	}
+	domain.FFTInverse(a, fft.DIT)
+
+	*p = Polynomial(a[:size]).normalize()
+	return p
+}
+
+func mulSchoolbook(p1, p2 Polynomial) Polynomial {
+	res := make(Polynomial, len(p1)+len(p2)-1)
+	var t fr.Element
+	for i := range p1 {
+		for j := range p2 {
+			t.Mul(&p1[i], &p2[j])
+			res[i+j].Add(&res[i+j], &t)
+		}
+	}
+	return res
+}
+
+// DivRem returns the quotient and remainder of the euclidean division of a by b,
+// such that a = q*b + r and deg(r) < deg(b).
+// The quotient is computed with a Newton iteration (inversion of the reversed divisor
+// modulo a power of X) when the degrees are large, and with a long division otherwise.
+// Both outputs are normalized. It returns ErrDivisionByZero if b is the zero polynomial.
+func DivRem(a, b Polynomial) (q, r Polynomial, err error) {
+	a, b = a.normalize(), b.normalize()
+	if len(b) == 0 {
+		return nil, nil, ErrDivisionByZero
+	}
+	if len(a) < len(b) {
+		return Polynomial{}, a.Clone(), nil
+	}
+
+	m := len(a) - len(b) + 1 // number of coefficients of q
+	if len(b) < divNewtonThreshold || m < divNewtonThreshold {
+		q, r = divRemSchoolbook(a, b)
+		return q, r, nil
+	}
+
+	// rev(q) = rev(a) * rev(b)⁻¹ mod Xᵐ, where rev(f) = X^deg(f) f(1/X)
+	bRevInv := reverse(b)
+	if len(bRevInv) > m {
+		bRevInv = bRevInv[:m]
+	}
+	bRevInv = invModXn(bRevInv, m)
+
+	aRev := reverse(a)[:m]
+	q.Mul(aRev, bRevInv)
+	if len(q) > m {
+		q = q[:m]
+	}
+	for len(q) < m {
+		q = append(q, fr.Element{})
+	}
+	q = reverse(q).normalize()
+
+	// r = a - q*b, of degree < deg(b)
+	var qb Polynomial
+	qb.Mul(q, b)
+	r = make(Polynomial, len(b)-1)
+	for i := range r {
+		if i < len(qb) {
+			r[i].Sub(&a[i], &qb[i])
+		} else {
+			r[i].Set(&a[i])
+		}
+	}
+
+	return q, r.normalize(), nil
+}
+
+func divRemSchoolbook(a, b Polynomial) (q, r Polynomial) {
+	r = a.Clone()
+	q = make(Polynomial, len(a)-len(b)+1)
+
+	var lcInv, t fr.Element
+	lcInv.Inverse(&b[len(b)-1])
+
+	for i := len(q) - 1; i >= 0; i-- {
+		// q[i] = r[i + deg(b)] / lc(b)
+		q[i].Mul(&r[i+len(b)-1], &lcInv)
+		if q[i].IsZero() {
+			continue
+		}
+		for j := range b {
+			t.Mul(&q[i], &b[j])
+			r[i+j].Sub(&r[i+j], &t)
+		}
+	}
+
+	return q.normalize(), r[:len(b)-1].normalize()
+}
+
+// reverse returns the coefficients of p in reverse order, in a new slice.
+func reverse(p Polynomial) Polynomial {
+	res := make(Polynomial, len(p))
+	for i := range p {
+		res[len(p)-1-i] = p[i]
+	}
+	return res
+}
+
+// invModXn returns f⁻¹ mod Xⁿ, f[0] must be non zero.
+// It uses the Newton iteration g ← g(2 - fg) mod X²ᵏ, which doubles the precision at each step.
+func invModXn(f Polynomial, n int) Polynomial {
+	g := make(Polynomial, 1, n)
+	g[0].Inverse(&f[0])
+
+	var two fr.Element
+	two.SetUint64(2)
+
+	for k := 1; k < n; {
+		k *= 2
+		if k > n {
+			k = n
+		}
+
+		// fg mod Xᵏ
+		fk := f
+		if len(fk) > k {
+			fk = fk[:k]
+		}
+		var fg Polynomial
+		fg.Mul(fk, g)
+		if len(fg) > k {
+			fg = fg[:k]
+		}
+
+		// 2 - fg
+		for i := range fg {
+			fg[i].Neg(&fg[i])
+		}
+		if len(fg) == 0 {
+			fg = append(fg, fr.Element{})
+		}
+		fg[0].Add(&fg[0], &two)
+
+		g.Mul(g, fg)
+		if len(g) > k {
+			g = g[:k]
+		}
+	}
+
+	// Mul normalizes its output; pad back to n coefficients
+	for len(g) < n {
+		g = append(g, fr.Element{})
+	}
+	return g
+}
+
+// GCD returns the monic greatest common divisor of a and b, computed with the euclidean algorithm.
+// GCD(0, 0) is the zero polynomial.
+func GCD(a, b Polynomial) Polynomial {
+	a, b = a.normalize(), b.normalize()
+	a, b = a.Clone(), b.Clone()
+	for len(b) != 0 {
+		_, r, _ := DivRem(a, b)
+		a, b = b, r
+	}
+	if len(a) == 0 {
+		return a
+	}
+	var lcInv fr.Element
+	lcInv.Inverse(&a[len(a)-1])
+	a.ScaleInPlace(&lcInv)
+	return a
+}
+
+// SubproductTree is the binary tree of the products ∏ (X - xᵢ) over a set of points;
+// each node is the product of its two children, and the leaves are the linear factors X - xᵢ.
+// It is used for multipoint evaluation, fast interpolation and division by the vanishing polynomial
+// of the points.
+type SubproductTree struct {
+	points []fr.Element
+
+	// levels[0][i] = X - points[i]
+	// levels[k][i] = levels[k-1][2i] * levels[k-1][2i+1], or levels[k-1][2i] for the last node of an odd level
+	levels [][]Polynomial
+}
+
+// NewSubproductTree builds the subproduct tree of points.
+func NewSubproductTree(points []fr.Element) *SubproductTree {
+	t := &SubproductTree{points: make([]fr.Element, len(points))}
+	copy(t.points, points)
+	if len(points) == 0 {
+		return t
+	}
+
+	leaves := make([]Polynomial, len(points))
+	for i := range points {
+		leaves[i] = make(Polynomial, 2)
+		leaves[i][0].Neg(&points[i])
+		leaves[i][1].SetOne()
+	}
+	t.levels = append(t.levels, leaves)
+
+	for level := leaves; len(level) > 1; {
+		next := make([]Polynomial, (len(level)+1)/2)
+		for i := range next {
+			if 2*i+1 < len(level) {
+				next[i].Mul(level[2*i], level[2*i+1])
+			} else {
+				next[i] = level[2*i]
+			}
+		}
+		t.levels = append(t.levels, next)
+		level = next
+	}
+
+	return t
+}
+
+// Vanishing returns ∏ (X - xᵢ), the monic polynomial vanishing on the points of the tree.
+func (t *SubproductTree) Vanishing() Polynomial {
+	if len(t.levels) == 0 {
+		res := make(Polynomial, 1)
+		res[0].SetOne()
+		return res
+	}
+	return t.levels[len(t.levels)-1][0].Clone()
+}
+
+// Evaluate returns p(xᵢ) for all the points of the tree, by successive reductions of p modulo
+// the nodes of the tree, from the root to the leaves.
+func (t *SubproductTree) Evaluate(p Polynomial) []fr.Element {
+	res := make([]fr.Element, len(t.points))
+	if len(t.points) == 0 {
+		return res
+	}
+
+	top := len(t.levels) - 1
+	_, r, _ := DivRem(p, t.levels[top][0])
+	rems := []Polynomial{r}
+	for k := top - 1; k >= 0; k-- {
+		next := make([]Polynomial, len(t.levels[k]))
+		for i := range next {
+			_, next[i], _ = DivRem(rems[i/2], t.levels[k][i])
+		}
+		rems = next
+	}
+
+	// the remainders modulo X - xᵢ are the constants p(xᵢ)
+	for i := range res {
+		if len(rems[i]) != 0 {
+			res[i] = rems[i][0]
+		}
+	}
+	return res
+}
+
+// Interpolate returns the unique polynomial of degree < len(points) such that p(xᵢ) = values[i].
+// It returns ErrInconsistentSize if len(values) differs from the number of points, and ErrDuplicatePoints
+// if the points of the tree are not distinct.
+func (t *SubproductTree) Interpolate(values []fr.Element) (Polynomial, error) {
+	if len(values) != len(t.points) {
+		return nil, ErrInconsistentSize
+	}
+	if len(values) == 0 {
+		return Polynomial{}, nil
+	}
+
+	// Lagrange: p = ∑ values[i] / M'(xᵢ) ⋅ M / (X - xᵢ), where M is the vanishing polynomial
+	root := t.levels[len(t.levels)-1][0]
+	dM := make(Polynomial, len(root)-1)
+	var c fr.Element
+	for i := 1; i < len(root); i++ {
+		c.SetUint64(uint64(i))
+		dM[i-1].Mul(&root[i], &c)
+	}
+	weights := t.Evaluate(dM)
+	for i := range weights {
+		if weights[i].IsZero() {
+			return nil, ErrDuplicatePoints
+		}
+	}
+	weights = fr.BatchInvert(weights)
+
+	// combine the weighted leaves up the tree: a node is left * M_right + right * M_left
+	level := make([]Polynomial, len(values))
+	for i := range values {
+		level[i] = Polynomial{weights[i]}
+		level[i][0].Mul(&level[i][0], &values[i])
+	}
+	for k := 0; k < len(t.levels)-1; k++ {
+		next := make([]Polynomial, len(t.levels[k+1]))
+		for i := range next {
+			if 2*i+1 >= len(level) {
+				next[i] = level[2*i]
+				continue
+			}
+			var left, right Polynomial
+			left.Mul(level[2*i], t.levels[k][2*i+1])
+			right.Mul(level[2*i+1], t.levels[k][2*i])
+			if len(left) < len(right) {
+				left, right = right, left
+			}
+			for j := range right {
+				left[j].Add(&left[j], &right[j])
+			}
+			next[i] = left
+		}
+		level = next
+	}
+
+	return level[0].normalize(), nil
+}
+
+// EvaluateMulti returns p(xᵢ) for each of the points, using a subproduct tree.
+func (p *Polynomial) EvaluateMulti(points []fr.Element) []fr.Element {
+	return NewSubproductTree(points).Evaluate(*p)
+}
+
+// Interpolate returns the unique polynomial of degree < len(points) such that p(xᵢ) = values[i],
+// using a subproduct tree.
+func Interpolate(points, values []fr.Element) (Polynomial, error) {
+	if len(points) != len(values) {
+		return nil, ErrInconsistentSize
+	}
+	return NewSubproductTree(points).Interpolate(values)
+}
+
+// DivideByVanishing returns the quotient and remainder of the division of p by ∏ (X - xᵢ),
+// the vanishing polynomial of points.
+func (p *Polynomial) DivideByVanishing(points []fr.Element) (q, r Polynomial) {
+	q, r, _ = DivRem(*p, NewSubproductTree(points).Vanishing())
+	return q, r
+}
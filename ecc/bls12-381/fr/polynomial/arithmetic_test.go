@@ -0,0 +1,207 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package polynomial
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/stretchr/testify/require"
+)
+
+func randomPolynomial(size int) Polynomial {
+	p := make(Polynomial, size)
+	for i := range p {
+		p[i].SetRandom()
+	}
+	return p
+}
+
+// addPolynomials returns a + b in a new slice
+func addPolynomials(a, b Polynomial) Polynomial {
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+	res := a.Clone()
+	for i := range b {
+		res[i].Add(&res[i], &b[i])
+	}
+	return res
+}
+
+func TestPolynomialMul(t *testing.T) {
+	assert := require.New(t)
+
+	// sizes below and above the FFT threshold
+	for _, sizes := range [][2]int{{1, 1}, {3, 17}, {mulFFTThreshold, mulFFTThreshold + 5}, {300, 200}} {
+		p1, p2 := randomPolynomial(sizes[0]), randomPolynomial(sizes[1])
+
+		var p Polynomial
+		p.Mul(p1, p2)
+		assert.Equal(sizes[0]+sizes[1]-1, len(p))
+
+		var x fr.Element
+		x.SetRandom()
+		e1, e2, e := p1.Eval(&x), p2.Eval(&x), p.Eval(&x)
+		e1.Mul(&e1, &e2)
+		assert.True(e.Equal(&e1), "product of sizes %v", sizes)
+
+		// naive product
+		assert.True(p.Equal(mulSchoolbook(p1, p2)), "product of sizes %v", sizes)
+	}
+
+	// zero polynomial
+	var p Polynomial
+	p.Mul(randomPolynomial(10), make(Polynomial, 3))
+	assert.Equal(0, len(p))
+}
+
+func TestPolynomialDivRem(t *testing.T) {
+	assert := require.New(t)
+
+	// sizes below and above the Newton threshold
+	for _, sizes := range [][2]int{{10, 1}, {10, 4}, {4, 10}, {300, 150}, {600, 200}, {2*divNewtonThreshold + 1, divNewtonThreshold}} {
+		a, b := randomPolynomial(sizes[0]), randomPolynomial(sizes[1])
+
+		q, r, err := DivRem(a, b)
+		assert.NoError(err)
+		assert.Less(len(r), len(b), "remainder of sizes %v", sizes)
+
+		// a = q*b + r
+		var qb Polynomial
+		qb.Mul(q, b)
+		qb = addPolynomials(qb, r).normalize()
+		assert.True(qb.Equal(a.normalize()), "division of sizes %v", sizes)
+
+		// schoolbook division
+		if len(a) >= len(b) {
+			q2, r2 := divRemSchoolbook(a, b)
+			assert.True(q.Equal(q2))
+			assert.True(r.Equal(r2))
+		}
+	}
+
+	_, _, err := DivRem(randomPolynomial(4), make(Polynomial, 2))
+	assert.ErrorIs(err, ErrDivisionByZero)
+}
+
+func TestPolynomialGCD(t *testing.T) {
+	assert := require.New(t)
+
+	// gcd(a*c, b*c) = c, up to a constant, for random a, b
+	a, b, c := randomPolynomial(20), randomPolynomial(15), randomPolynomial(7)
+	var ac, bc Polynomial
+	ac.Mul(a, c)
+	bc.Mul(b, c)
+
+	g := GCD(ac, bc)
+	assert.Equal(len(c), len(g))
+
+	var lcInv fr.Element
+	lcInv.Inverse(&c[len(c)-1])
+	c.ScaleInPlace(&lcInv)
+	assert.True(g.Equal(c))
+}
+
+func TestSubproductTree(t *testing.T) {
+	assert := require.New(t)
+
+	for _, n := range []int{1, 2, 7, 64, 257} {
+		points := make([]fr.Element, n)
+		for i := range points {
+			points[i].SetRandom()
+		}
+		tree := NewSubproductTree(points)
+
+		// vanishing polynomial
+		m := tree.Vanishing()
+		assert.Equal(n+1, len(m))
+		assert.True(m[n].IsOne())
+		for i := range points {
+			e := m.Eval(&points[i])
+			assert.True(e.IsZero())
+		}
+
+		// multipoint evaluation
+		p := randomPolynomial(2*n + 3)
+		evals := p.EvaluateMulti(points)
+		for i := range points {
+			e := p.Eval(&points[i])
+			assert.True(e.Equal(&evals[i]), "evaluation at point %d / %d", i, n)
+		}
+
+		// interpolation
+		values := make([]fr.Element, n)
+		for i := range values {
+			values[i].SetRandom()
+		}
+		f, err := Interpolate(points, values)
+		assert.NoError(err)
+		assert.LessOrEqual(len(f), n)
+		for i := range points {
+			e := f.Eval(&points[i])
+			assert.True(e.Equal(&values[i]))
+		}
+
+		// division by the vanishing polynomial
+		q, r := p.DivideByVanishing(points)
+		var qm Polynomial
+		qm.Mul(q, m)
+		qm = addPolynomials(qm, r).normalize()
+		assert.True(qm.Equal(p.normalize()))
+		rEvals := tree.Evaluate(r)
+		for i := range rEvals {
+			assert.True(rEvals[i].Equal(&evals[i]))
+		}
+	}
+}
+
+func TestInterpolateErrors(t *testing.T) {
+	assert := require.New(t)
+
+	points := make([]fr.Element, 3)
+	points[0].SetUint64(1)
+	points[1].SetUint64(2)
+	points[2].SetUint64(1)
+
+	_, err := Interpolate(points, make([]fr.Element, 3))
+	assert.ErrorIs(err, ErrDuplicatePoints)
+
+	_, err = Interpolate(points, make([]fr.Element, 2))
+	assert.ErrorIs(err, ErrInconsistentSize)
+}
+
+func BenchmarkPolynomialMul(b *testing.B) {
+	p1, p2 := randomPolynomial(1<<12), randomPolynomial(1<<12)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var p Polynomial
+		p.Mul(p1, p2)
+	}
+}
+
+func BenchmarkPolynomialEvaluateMulti(b *testing.B) {
+	p := randomPolynomial(1 << 10)
+	points := make([]fr.Element, 1<<10)
+	for i := range points {
+		points[i].SetRandom()
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p.EvaluateMulti(points)
+	}
+}
@@ -0,0 +1,220 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package polynomial
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// parallelThreshold is the size of the tables under which the MultiLin operations are not parallelized
+const parallelThreshold = 1 << 10
+
+// ToMonomialForm converts m, in place, from evaluation form (the values of the polynomial on the
+// hypercube, see MultiLin) to monomial form: the multilinear coefficients, where m[∑ᵢ 2ⁱ⁻¹ bₙ₋ᵢ]
+// is the coefficient of ∏_{bᵢ=1} Xᵢ.
+func (m MultiLin) ToMonomialForm() {
+	// for each variable, f = f₀ + Xᵢ(f₁ - f₀)
+	for stride := len(m) >> 1; stride > 0; stride >>= 1 {
+		m.butterflies(stride, func(lo, hi *fr.Element) {
+			hi.Sub(hi, lo)
+		})
+	}
+}
+
+// ToEvaluationForm converts m, in place, from monomial form to evaluation form; it is the inverse
+// of ToMonomialForm.
+func (m MultiLin) ToEvaluationForm() {
+	for stride := len(m) >> 1; stride > 0; stride >>= 1 {
+		m.butterflies(stride, func(lo, hi *fr.Element) {
+			hi.Add(hi, lo)
+		})
+	}
+}
+
+// butterflies calls f(m[i], m[i+stride]) for all i with the bit of weight stride unset
+func (m MultiLin) butterflies(stride int, f func(lo, hi *fr.Element)) {
+	nbBlocks := len(m) / (2 * stride)
+	apply := func(start, end int) {
+		for k := start; k < end; k++ {
+			block := (k / stride) * 2 * stride
+			i := block + k%stride
+			f(&m[i], &m[i+stride])
+		}
+	}
+	if len(m) < parallelThreshold {
+		apply(0, nbBlocks*stride)
+		return
+	}
+	parallel.Execute(nbBlocks*stride, apply)
+}
+
+// EvaluateMonomialForm evaluates, at the given coordinates, the multilinear polynomial whose
+// coefficients are m (see ToMonomialForm)
+func (m MultiLin) EvaluateMonomialForm(coordinates []fr.Element) fr.Element {
+	if len(m) != 1<<len(coordinates) {
+		panic("the number of coordinates must be the number of variables")
+	}
+	// f = f₀(X₂, ..., Xₙ) + X₁ f₁(X₂, ..., Xₙ)
+	bkCopy := m.Clone()
+	for _, r := range coordinates {
+		mid := len(bkCopy) / 2
+		for i := 0; i < mid; i++ {
+			var t fr.Element
+			t.Mul(&bkCopy[i+mid], &r)
+			bkCopy[i].Add(&bkCopy[i], &t)
+		}
+		bkCopy = bkCopy[:mid]
+	}
+	return bkCopy[0]
+}
+
+// PartialEval returns the multilinear polynomial obtained by setting X_{variables[k]+1} = values[k]
+// (variables are 0-indexed) in m; the remaining variables keep their relative order. m is not modified.
+func (m MultiLin) PartialEval(variables []int, values []fr.Element) MultiLin {
+	if len(variables) != len(values) {
+		panic("variables and values must have the same length")
+	}
+	n := m.NumVars()
+	fixed := make([]bool, n)
+	for _, v := range variables {
+		if v < 0 || v >= n {
+			panic(fmt.Sprintf("variable index %d out of range [0, %d)", v, n))
+		}
+		if fixed[v] {
+			panic(fmt.Sprintf("variable %d is set twice", v))
+		}
+		fixed[v] = true
+	}
+
+	res := m.Clone()
+	for k, v := range variables {
+		// position of X_{v+1} among the remaining variables
+		pos := v
+		for _, w := range variables[:k] {
+			if w < v {
+				pos--
+			}
+		}
+		nbVars := res.NumVars()
+		res = res.foldVariable(nbVars-1-pos, &values[k])
+	}
+	return res
+}
+
+// foldVariable sets the variable corresponding to the bit b of the indices of m to r, in place,
+// and returns the resulting (half size) table
+func (m MultiLin) foldVariable(b int, r *fr.Element) MultiLin {
+	stride := 1 << b
+	half := len(m) >> 1
+	var t fr.Element
+	for i := 0; i < half; i++ {
+		// i = hi⋅stride + lo, with lo < stride
+		lo, hi := i&(stride-1), i>>b
+		i0 := hi*2*stride + lo
+		t.Sub(&m[i0+stride], &m[i0])
+		t.Mul(&t, r)
+		m[i].Add(&m[i0], &t)
+	}
+	return m[:half]
+}
+
+// EqParallel sets m to the representation of the polynomial Eq(q₁, ..., qₙ, *, ..., *) × m[0],
+// like Eq, processing the larger tensor products in parallel.
+func (m *MultiLin) EqParallel(q []fr.Element) {
+	n := len(q)
+
+	if len(*m) != 1<<n {
+		panic("destination must have size 2 raised to the size of source")
+	}
+
+	// Eq(q₁, ..., qᵢ₊₁, ...) = Eq(q₁, ..., qᵢ, ...) ⊗ (1-qᵢ₊₁, qᵢ₊₁)
+	for i := range q {
+		qi := q[i]
+		step := func(start, end int) {
+			for j := start; j < end; j++ {
+				j0 := j << (n - i)
+				j1 := j0 + 1<<(n-1-i)
+				(*m)[j1].Mul(&qi, &(*m)[j0])
+				(*m)[j0].Sub(&(*m)[j0], &(*m)[j1])
+			}
+		}
+		if 1<<i < parallelThreshold {
+			step(0, 1<<i)
+		} else {
+			parallel.Execute(1<<i, step)
+		}
+	}
+}
+
+// TensorProduct returns the table of the polynomial left(X₁, ..., Xₖ)⋅right(Xₖ₊₁, ..., Xₙ), where k is the
+// number of variables of left
+func TensorProduct(left, right MultiLin) MultiLin {
+	res := make(MultiLin, len(left)*len(right))
+	parallel.Execute(len(left), func(start, end int) {
+		for i := start; i < end; i++ {
+			row := res[i*len(right) : (i+1)*len(right)]
+			for j := range row {
+				row[j].Mul(&left[i], &right[j])
+			}
+		}
+	})
+	return res
+}
+
+// Mul sets m to the product of left and right on the hypercube, that is, the multilinear extension of
+// the point-wise product of their evaluations (the Hadamard product of the tables).
+//
+// left and right may have different numbers of variables: the one with k < n variables is seen
+// as a polynomial in X₁, ..., Xₖ, constant in the other variables. m is resized if needed.
+func (m *MultiLin) Mul(left, right MultiLin) {
+	m.combine(left, right, func(z, x, y *fr.Element) {
+		z.Mul(x, y)
+	})
+}
+
+// combine sets (*m)[i] to f(left[i'], right[i"]), where i' and i" are i restricted to the variables of
+// left and right respectively
+func (m *MultiLin) combine(left, right MultiLin, f func(z, x, y *fr.Element)) {
+	if bits.OnesCount(uint(len(left))) != 1 || bits.OnesCount(uint(len(right))) != 1 {
+		panic("left and right must have a power of 2 size")
+	}
+	size := len(left)
+	if len(right) > size {
+		size = len(right)
+	}
+	if len(*m) != size {
+		*m = make(MultiLin, size)
+	}
+	shiftLeft := bits.TrailingZeros(uint(size / len(left)))
+	shiftRight := bits.TrailingZeros(uint(size / len(right)))
+
+	res := *m
+	apply := func(start, end int) {
+		for i := start; i < end; i++ {
+			f(&res[i], &left[i>>shiftLeft], &right[i>>shiftRight])
+		}
+	}
+	if size < parallelThreshold {
+		apply(0, size)
+		return
+	}
+	parallel.Execute(size, apply)
+}
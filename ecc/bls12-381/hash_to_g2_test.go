@@ -20,6 +20,7 @@ import (
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/internal/fptower"
 	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
 	"github.com/leanovate/gopter/prop"
 	"math/rand"
 	"strings"
@@ -165,6 +166,19 @@ func TestMapToG2(t *testing.T) {
 		GenE2(),
 	))
 
+	properties.Property("[G2] encoding and hashing to curve should output points in the subgroup", prop.ForAll(
+		func(msg string) bool {
+			dst := []byte("BLS12-381_XMD:SHA-256_SSWU_TEST_")
+			p, err := EncodeToG2([]byte(msg), dst)
+			if err != nil || !p.IsInSubGroup() {
+				return false
+			}
+			q, err := HashToG2([]byte(msg), dst)
+			return err == nil && q.IsInSubGroup()
+		},
+		gen.AnyString(),
+	))
+
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
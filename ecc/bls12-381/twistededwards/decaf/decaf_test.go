@@ -0,0 +1,251 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package decaf
+
+import (
+	"math/big"
+	"testing"
+
+	fp "github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/twistededwards"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/twistededwards/fr"
+	"github.com/stretchr/testify/require"
+)
+
+// randomElement returns s⋅G, G being the generator, and s
+func randomElement() (Element, fr.Element) {
+	var s fr.Element
+	s.SetRandom()
+	res := Generator()
+	res.ScalarMultiplication(&res, &s)
+	return res, s
+}
+
+// torsion returns the points of E[8]
+func torsion() []twistededwards.PointExtended {
+	initOnce.Do(initConstants)
+	var generator twistededwards.PointExtended
+	generator.Set(&torsion8)
+
+	res := make([]twistededwards.PointExtended, 8)
+	res[0].FromAffine(&twistededwards.PointAffine{Y: fp.One()})
+	for i := 1; i < len(res); i++ {
+		res[i].Add(&res[i-1], &generator)
+	}
+	return res
+}
+
+func TestTorsion(t *testing.T) {
+	assert := require.New(t)
+
+	points := torsion()
+	for i := range points {
+		var p twistededwards.PointAffine
+		p.FromExtended(&points[i])
+		assert.True(p.IsOnCurve())
+		for j := 0; j < i; j++ {
+			assert.False(points[i].Equal(&points[j]), "the torsion points must be distinct")
+		}
+	}
+	var next twistededwards.PointExtended
+	next.Add(&points[len(points)-1], &points[1])
+	assert.True(next.IsZero(), "the torsion subgroup must be cyclic of order 8")
+}
+
+func TestEncoding(t *testing.T) {
+	assert := require.New(t)
+
+	var identity Element
+	identity.SetIdentity()
+	expected := fp.One()
+	assert.Equal(expected.Bytes(), identity.Bytes())
+
+	elements := []Element{identity, Generator()}
+	for i := 0; i < 10; i++ {
+		p, _ := randomElement()
+		elements = append(elements, p)
+	}
+
+	for _, p := range elements {
+		b := p.Bytes()
+		var q Element
+		assert.NoError(q.SetBytes(b[:]))
+		assert.True(q.Equal(&p))
+		assert.Equal(b, q.Bytes())
+
+		// all the representatives have the same encoding
+		for _, torsionPoint := range torsion() {
+			var r Element
+			r.inner.Add(&p.inner, &torsionPoint)
+			assert.True(r.Equal(&p))
+			assert.Equal(b, r.Bytes())
+		}
+	}
+}
+
+func TestMalleability(t *testing.T) {
+	assert := require.New(t)
+
+	p, _ := randomElement()
+	b := p.Bytes()
+	var q Element
+	var y fp.Element
+	assert.NoError(y.SetBytesCanonical(b[:]))
+
+	// y + modulus
+	var yBig, modulus = y.BigInt(new(big.Int)), fp.Modulus()
+	var nonCanonical [SizeElement]byte
+	yBig.Add(yBig, modulus).FillBytes(nonCanonical[:])
+	assert.ErrorIs(q.SetBytes(nonCanonical[:]), ErrInvalidEncoding)
+
+	// -y
+	var minusY fp.Element
+	minusY.Neg(&y)
+	other := minusY.Bytes()
+	assert.ErrorIs(q.SetBytes(other[:]), ErrInvalidEncoding)
+
+	// the y-coordinates of all the representatives: only the canonical one decodes to p, the
+	// others are rejected or decode to another element (-p)
+	nbDecoded := 0
+	for _, torsionPoint := range torsion() {
+		var r twistededwards.PointExtended
+		r.Add(&p.inner, &torsionPoint)
+		var ra twistededwards.PointAffine
+		ra.FromExtended(&r)
+		other = ra.Y.Bytes()
+		if q.SetBytes(other[:]) == nil && q.Equal(&p) {
+			nbDecoded++
+			assert.Equal(b, other)
+		}
+	}
+	assert.Equal(1, nbDecoded)
+
+	// the points (±i, 0) of order 4 are other representatives of the identity
+	var zero [SizeElement]byte
+	assert.ErrorIs(q.SetBytes(zero[:]), ErrInvalidEncoding)
+
+	// not on the curve
+	var one fp.Element
+	one.SetOne()
+	y.SetOne()
+	for {
+		y.Add(&y, &one)
+		if _, ok := recoverX(&y); !ok && !y.LexicographicallyLargest() {
+			break
+		}
+	}
+	other = y.Bytes()
+	assert.ErrorIs(q.SetBytes(other[:]), ErrNotOnCurve)
+
+	assert.ErrorIs(q.SetBytes(b[1:]), ErrInvalidEncoding)
+}
+
+func TestArithmetic(t *testing.T) {
+	assert := require.New(t)
+
+	p, s := randomElement()
+	q, r := randomElement()
+
+	// (s + r)⋅G = s⋅G + r⋅G
+	var sum, expected Element
+	sum.Add(&p, &q)
+	var sr fr.Element
+	sr.Add(&s, &r)
+	expected = Generator()
+	expected.ScalarMultiplication(&expected, &sr)
+	assert.True(sum.Equal(&expected))
+
+	// 2⋅p - p - p = 0
+	var d, neg Element
+	d.Double(&p).Sub(&d, &p)
+	assert.True(d.Equal(&p))
+	neg.Neg(&p)
+	d.Add(&d, &neg)
+	assert.True(d.IsIdentity())
+	assert.False(p.IsIdentity())
+	assert.False(p.Equal(&q))
+
+	// the order of the group is the one of the scalar field
+	var minusOne fr.Element
+	minusOne.SetOne().Neg(&minusOne)
+	d.ScalarMultiplication(&p, &minusOne)
+	assert.True(d.Equal(&neg))
+
+	// any point of the curve is a representative, (r - 1)⋅(p + T) = -p for T in E[8]
+	for _, torsionPoint := range torsion() {
+		var pt Element
+		pt.inner.Add(&p.inner, &torsionPoint)
+		d.ScalarMultiplication(&pt, &minusOne)
+		assert.True(d.Equal(&neg))
+	}
+}
+
+func TestHashToGroup(t *testing.T) {
+	assert := require.New(t)
+
+	dst := []byte("test")
+	p, err := HashToGroup([]byte("abc"), dst)
+	assert.NoError(err)
+	q, err := HashToGroup([]byte("abc"), dst)
+	assert.NoError(err)
+	assert.True(p.Equal(&q))
+	assert.False(p.IsIdentity())
+
+	b := p.Bytes()
+	assert.NoError(q.SetBytes(b[:]))
+	assert.True(p.Equal(&q))
+
+	q, err = HashToGroup([]byte("abd"), dst)
+	assert.NoError(err)
+	assert.False(p.Equal(&q))
+	q, err = HashToGroup([]byte("abc"), []byte("other"))
+	assert.NoError(err)
+	assert.False(p.Equal(&q))
+
+	// the map outputs points of the curve, including for the exceptional inputs
+	var u fp.Element
+	for i := 0; i < 10; i++ {
+		point := twistededwards.MapToCurve(&u)
+		assert.True(point.IsOnCurve())
+		u.SetRandom()
+	}
+}
+
+func BenchmarkBytes(b *testing.B) {
+	p, _ := randomElement()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = p.Bytes()
+	}
+}
+
+func BenchmarkSetBytes(b *testing.B) {
+	p, _ := randomElement()
+	buf := p.Bytes()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = p.SetBytes(buf[:])
+	}
+}
+
+func BenchmarkHashToGroup(b *testing.B) {
+	msg, dst := []byte("abc"), []byte("test")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = HashToGroup(msg, dst)
+	}
+}
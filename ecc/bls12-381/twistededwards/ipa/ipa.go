@@ -0,0 +1,458 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ipa
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash"
+	"math/big"
+	"math/bits"
+	"strconv"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	curve "github.com/consensys/gnark-crypto/ecc/bls12-381/twistededwards"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/twistededwards/fr"
+	"github.com/consensys/gnark-crypto/fiat-shamir"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var (
+	ErrInvalidSRSSize     = errors.New("the size of the SRS must be a power of 2")
+	ErrInvalidVectorSize  = errors.New("invalid vector size (empty, or larger than the SRS)")
+	ErrInvalidProofSize   = errors.New("the number of rounds of the proof doesn't match the size of the SRS or of the vectors")
+	ErrInvalidNbDigests   = errors.New("number of digests is not the same as the number of proofs or points")
+	ErrZeroChallenge      = errors.New("a challenge is zero")
+	ErrVerifyOpeningProof = errors.New("can't verify opening proof")
+)
+
+// Digest Pedersen vector commitment ∑ᵢ aᵢGᵢ
+type Digest = curve.PointAffine
+
+// SRS generators of the Pedersen vector commitments, and the generator Q binding the inner products.
+//
+// It is transparent: the discrete logarithms between the generators are unknown, and anyone can
+// recompute the SRS from its seed.
+type SRS struct {
+	Basis []curve.PointAffine
+	Q     curve.PointAffine
+}
+
+// OpeningProof proof that a vector a, committed in a digest, satisfies ⟨a, b⟩ = ClaimedValue,
+// for a public vector b.
+type OpeningProof struct {
+	// L, R commitments to the cross terms of each folding round
+	L, R []curve.PointAffine
+
+	// A the vector a, folded down to a single element
+	A fr.Element
+
+	// ClaimedValue purported value of ⟨a, b⟩
+	ClaimedValue fr.Element
+}
+
+// NewSRS returns the SRS of the given size (a power of 2), whose generators are derived from seed.
+func NewSRS(size uint64, seed []byte) (*SRS, error) {
+	if size == 0 || size&(size-1) != 0 {
+		return nil, ErrInvalidSRSSize
+	}
+
+	points := make([]curve.PointAffine, size+1)
+	errs := make([]error, len(points))
+	parallel.Execute(len(points), func(start, end int) {
+		for i := start; i < end; i++ {
+			points[i], errs[i] = deriveGenerator(seed, uint64(i))
+		}
+	})
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &SRS{Basis: points[:size:size], Q: points[size]}, nil
+}
+
+// generatorMessage returns the message from which the i-th generator is derived: seed ‖ i
+func generatorMessage(seed []byte, i uint64) []byte {
+	msg := make([]byte, len(seed)+8)
+	copy(msg, seed)
+	binary.BigEndian.PutUint64(msg[len(seed):], i)
+	return msg
+}
+
+// Commit computes the Pedersen vector commitment ∑ᵢ a[i]⋅srs.Basis[i] of a, with len(a) ≤ len(srs.Basis).
+//
+// A polynomial is committed through its coefficients in the canonical basis.
+func Commit(a []fr.Element, srs *SRS) (Digest, error) {
+	if len(a) == 0 || len(a) > len(srs.Basis) {
+		return Digest{}, ErrInvalidVectorSize
+	}
+	return multiExp(srs.Basis[:len(a)], a)
+}
+
+// Open computes an opening proof of the polynomial p, given by its coefficients and committed in
+// digest, at point. It's an interactive protocol, made non-interactive using Fiat Shamir;
+// dataTranscript is extra data bound to the challenges. p is not modified.
+func Open(p []fr.Element, point fr.Element, digest Digest, hf hash.Hash, srs *SRS, dataTranscript ...[]byte) (OpeningProof, error) {
+	if len(p) == 0 || len(p) > len(srs.Basis) {
+		return OpeningProof{}, ErrInvalidVectorSize
+	}
+	b := powers(point, int(ecc.NextPowerOfTwo(uint64(len(p)))))
+	return ProveInnerProduct(p, b, digest, hf, srs, dataTranscript...)
+}
+
+// Verify verifies that the polynomial committed in digest evaluates to proof.ClaimedValue at point.
+func Verify(digest *Digest, proof *OpeningProof, point fr.Element, hf hash.Hash, srs *SRS, dataTranscript ...[]byte) error {
+	if !validNbRounds(len(proof.L), srs) {
+		return ErrInvalidProofSize
+	}
+	b := powers(point, 1<<len(proof.L))
+	return VerifyInnerProduct(digest, b, proof, hf, srs, dataTranscript...)
+}
+
+// BatchVerify verifies the opening proofs of several polynomials, each at its own point,
+// with a single multi-scalar multiplication.
+func BatchVerify(digests []Digest, proofs []OpeningProof, points []fr.Element, hf hash.Hash, srs *SRS, dataTranscript ...[]byte) error {
+	if len(digests) != len(proofs) || len(points) != len(proofs) {
+		return ErrInvalidNbDigests
+	}
+	b := make([][]fr.Element, len(proofs))
+	for i := range proofs {
+		if !validNbRounds(len(proofs[i].L), srs) {
+			return ErrInvalidProofSize
+		}
+		b[i] = powers(points[i], 1<<len(proofs[i].L))
+	}
+	return BatchVerifyInnerProduct(digests, b, proofs, hf, srs, dataTranscript...)
+}
+
+// ProveInnerProduct computes a proof that the vector a, committed in digest, satisfies
+// ⟨a, b⟩ = proof.ClaimedValue. The vectors are padded with zeros to the next power of 2,
+// which must not exceed the size of the SRS. a and b are not modified.
+func ProveInnerProduct(a, b []fr.Element, digest Digest, hf hash.Hash, srs *SRS, dataTranscript ...[]byte) (OpeningProof, error) {
+	n := int(ecc.NextPowerOfTwo(uint64(max(len(a), len(b)))))
+	if len(a) == 0 || n > len(srs.Basis) {
+		return OpeningProof{}, ErrInvalidVectorSize
+	}
+	a, b = pad(a, n), pad(b, n)
+	g := srs.Basis[:n]
+	nbRounds := bits.TrailingZeros(uint(n))
+
+	res := OpeningProof{
+		L:            make([]curve.PointAffine, nbRounds),
+		R:            make([]curve.PointAffine, nbRounds),
+		ClaimedValue: innerProduct(a, b),
+	}
+
+	fs := newTranscript(hf, nbRounds, &digest, b, &res.ClaimedValue, dataTranscript)
+	w, err := deriveChallenge(fs, "w")
+	if err != nil {
+		return OpeningProof{}, err
+	}
+
+	// Q' = [w]Q binds the inner product to the commitment: C' = C + vQ' = ⟨a, G⟩ + ⟨a, b⟩Q'
+	var q curve.PointAffine
+	var wBigInt big.Int
+	q.ScalarMultiplication(&srs.Q, w.BigInt(&wBigInt))
+
+	var t, x, xInv fr.Element
+	for j := 0; j < nbRounds; j++ {
+		m := len(a) / 2
+		aL, aR := a[:m], a[m:]
+		bL, bR := b[:m], b[m:]
+		gL, gR := g[:m], g[m:]
+
+		// L = ⟨a_R, G_L⟩ + ⟨a_R, b_L⟩Q', R = ⟨a_L, G_R⟩ + ⟨a_L, b_R⟩Q'
+		if res.L[j], err = multiExp(append(gL[:m:m], q), append(aR[:m:m], innerProduct(aR, bL))); err != nil {
+			return OpeningProof{}, err
+		}
+		if res.R[j], err = multiExp(append(gR[:m:m], q), append(aL[:m:m], innerProduct(aL, bR))); err != nil {
+			return OpeningProof{}, err
+		}
+
+		if x, err = deriveRoundChallenge(fs, j, &res.L[j], &res.R[j]); err != nil {
+			return OpeningProof{}, err
+		}
+		xInv.Inverse(&x)
+
+		// a ← a_L + x⋅a_R, b ← b_L + x⁻¹⋅b_R, G ← G_L + x⁻¹⋅G_R, so that C ← C + x⋅L + x⁻¹⋅R
+		for i := 0; i < m; i++ {
+			t.Mul(&aR[i], &x)
+			aL[i].Add(&aL[i], &t)
+			t.Mul(&bR[i], &xInv)
+			bL[i].Add(&bL[i], &t)
+		}
+		a, b = aL, bL
+		g = foldBasis(g, xInv)
+	}
+	res.A = a[0]
+
+	return res, nil
+}
+
+// VerifyInnerProduct verifies a proof that the vector committed in digest has inner product
+// proof.ClaimedValue with b.
+func VerifyInnerProduct(digest *Digest, b []fr.Element, proof *OpeningProof, hf hash.Hash, srs *SRS, dataTranscript ...[]byte) error {
+	v, err := newVerification(digest, b, proof, hf, srs, dataTranscript)
+	if err != nil {
+		return err
+	}
+
+	points := make([]curve.PointAffine, 0, len(v.basis)+2+2*len(proof.L))
+	points = append(points, srs.Basis[:len(v.basis)]...)
+	points = append(points, srs.Q, *digest)
+	points = append(points, proof.L...)
+	points = append(points, proof.R...)
+
+	scalars := make([]fr.Element, 0, len(points))
+	scalars = append(scalars, v.basis...)
+	scalars = append(scalars, v.q, fr.One())
+	scalars = append(scalars, v.x...)
+	scalars = append(scalars, v.xInv...)
+
+	res, err := multiExp(points, scalars)
+	if err != nil {
+		return err
+	}
+	if !isIdentity(&res) {
+		return ErrVerifyOpeningProof
+	}
+	return nil
+}
+
+// BatchVerifyInnerProduct verifies several inner-product proofs, proofs[i] being a proof that the
+// vector committed in digests[i] has inner product proofs[i].ClaimedValue with b[i].
+//
+// The verification equations are combined with random coefficients, so that a single
+// multi-scalar multiplication is performed. The proofs may have different sizes.
+func BatchVerifyInnerProduct(digests []Digest, b [][]fr.Element, proofs []OpeningProof, hf hash.Hash, srs *SRS, dataTranscript ...[]byte) error {
+	if len(digests) != len(proofs) || len(b) != len(proofs) {
+		return ErrInvalidNbDigests
+	}
+	if len(proofs) == 0 {
+		return nil
+	}
+
+	nbPoints := 1
+	verifications := make([]verification, len(proofs))
+	for i := range proofs {
+		var err error
+		if verifications[i], err = newVerification(&digests[i], b[i], &proofs[i], hf, srs, dataTranscript); err != nil {
+			return err
+		}
+		nbPoints += 1 + 2*len(proofs[i].L)
+	}
+
+	// random coefficients ρᵢ, the first one being 1
+	rho := make([]fr.Element, len(proofs))
+	rho[0].SetOne()
+	for i := 1; i < len(rho); i++ {
+		if _, err := rho[i].SetRandom(); err != nil {
+			return err
+		}
+	}
+
+	// ∑ᵢ ρᵢ⋅(basis terms), ∑ᵢ ρᵢ⋅(Q term), and the ρᵢ-scaled terms of each proof
+	var basis []fr.Element
+	var q, t fr.Element
+	points := make([]curve.PointAffine, 0, nbPoints)
+	scalars := make([]fr.Element, 0, nbPoints)
+	for i := range verifications {
+		v := &verifications[i]
+		if len(v.basis) > len(basis) {
+			basis = append(basis, make([]fr.Element, len(v.basis)-len(basis))...)
+		}
+		for j := range v.basis {
+			t.Mul(&v.basis[j], &rho[i])
+			basis[j].Add(&basis[j], &t)
+		}
+		t.Mul(&v.q, &rho[i])
+		q.Add(&q, &t)
+
+		points = append(points, digests[i])
+		scalars = append(scalars, rho[i])
+		for j := range proofs[i].L {
+			points = append(points, proofs[i].L[j], proofs[i].R[j])
+			scalars = append(scalars, *t.Mul(&v.x[j], &rho[i]))
+			scalars = append(scalars, *t.Mul(&v.xInv[j], &rho[i]))
+		}
+	}
+	points = append(points, srs.Q)
+	scalars = append(scalars, q)
+	points = append(points, srs.Basis[:len(basis)]...)
+	scalars = append(scalars, basis...)
+
+	res, err := multiExp(points, scalars)
+	if err != nil {
+		return err
+	}
+	if !isIdentity(&res) {
+		return ErrVerifyOpeningProof
+	}
+	return nil
+}
+
+// verification coefficients of the verification equation of a proof:
+//
+//	C + ∑ⱼ (xⱼ⋅Lⱼ + xⱼ⁻¹⋅Rⱼ) + w⋅(v - a⋅⟨s, b⟩)⋅Q - a⋅∑ᵢ sᵢ⋅Gᵢ = 0
+//
+// where sᵢ = ∏ⱼ xⱼ^(-bⱼ), bⱼ being the bit of i split at round j (most significant first),
+// so that ∑ᵢ sᵢ⋅Gᵢ and ⟨s, b⟩ are the basis and the vector b folded by the prover.
+type verification struct {
+	basis   []fr.Element // coefficients -a⋅sᵢ of the Gᵢ
+	q       fr.Element   // coefficient of Q
+	x, xInv []fr.Element // coefficients of the Lⱼ and Rⱼ
+}
+
+func newVerification(digest *Digest, b []fr.Element, proof *OpeningProof, hf hash.Hash, srs *SRS, dataTranscript [][]byte) (verification, error) {
+	nbRounds := len(proof.L)
+	if len(proof.R) != nbRounds || !validNbRounds(nbRounds, srs) {
+		return verification{}, ErrInvalidProofSize
+	}
+	n := 1 << nbRounds
+	if len(b) > n {
+		return verification{}, ErrInvalidProofSize
+	}
+	b = pad(b, n)
+
+	fs := newTranscript(hf, nbRounds, digest, b, &proof.ClaimedValue, dataTranscript)
+	w, err := deriveChallenge(fs, "w")
+	if err != nil {
+		return verification{}, err
+	}
+
+	res := verification{
+		basis: make([]fr.Element, n),
+		x:     make([]fr.Element, nbRounds),
+	}
+	for j := 0; j < nbRounds; j++ {
+		if res.x[j], err = deriveRoundChallenge(fs, j, &proof.L[j], &proof.R[j]); err != nil {
+			return verification{}, err
+		}
+	}
+	res.xInv = fr.BatchInvert(res.x)
+
+	// s = (1, x₀⁻¹) ⊗ (1, x₁⁻¹) ⊗ ... ⊗ (1, xₖ₋₁⁻¹)
+	s := res.basis
+	s[0].SetOne()
+	for j, size := 0, 1; j < nbRounds; j, size = j+1, size*2 {
+		for i := size - 1; i >= 0; i-- {
+			s[2*i+1].Mul(&s[i], &res.xInv[j])
+			s[2*i] = s[i]
+		}
+	}
+
+	// q = w⋅(v - a⋅⟨s, b⟩)
+	folded := innerProduct(s, b)
+	res.q.Mul(&folded, &proof.A).
+		Sub(&proof.ClaimedValue, &res.q).
+		Mul(&res.q, &w)
+
+	var minusA fr.Element
+	minusA.Neg(&proof.A)
+	for i := range s {
+		s[i].Mul(&s[i], &minusA)
+	}
+
+	return res, nil
+}
+
+// validNbRounds returns true if a proof in nbRounds rounds is compatible with the SRS
+func validNbRounds(nbRounds int, srs *SRS) bool {
+	return nbRounds < bits.Len(uint(len(srs.Basis)))
+}
+
+// newTranscript returns a transcript with the challenges w, x₀, ..., xₖ₋₁, the statement being
+// bound to w
+func newTranscript(hf hash.Hash, nbRounds int, digest *Digest, b []fr.Element, claimedValue *fr.Element, dataTranscript [][]byte) *fiatshamir.Transcript {
+	challenges := make([]string, nbRounds+1)
+	challenges[0] = "w"
+	for j := 0; j < nbRounds; j++ {
+		challenges[j+1] = roundChallengeName(j)
+	}
+	fs := fiatshamir.NewTranscript(hf, challenges...)
+
+	toBind := [][]byte{marshal(digest)}
+	for i := range b {
+		toBind = append(toBind, b[i].Marshal())
+	}
+	toBind = append(toBind, claimedValue.Marshal())
+	toBind = append(toBind, dataTranscript...)
+	for _, d := range toBind {
+		// can't fail, the challenge "w" exists and isn't computed yet
+		_ = fs.Bind("w", d)
+	}
+	return fs
+}
+
+func roundChallengeName(j int) string {
+	return "x" + strconv.Itoa(j)
+}
+
+// deriveRoundChallenge binds L and R to the challenge of round j and computes it
+func deriveRoundChallenge(fs *fiatshamir.Transcript, j int, l, r *curve.PointAffine) (fr.Element, error) {
+	name := roundChallengeName(j)
+	if err := fs.Bind(name, marshal(l)); err != nil {
+		return fr.Element{}, err
+	}
+	if err := fs.Bind(name, marshal(r)); err != nil {
+		return fr.Element{}, err
+	}
+	return deriveChallenge(fs, name)
+}
+
+// deriveChallenge computes the challenge of the given name as a non-zero field element
+func deriveChallenge(fs *fiatshamir.Transcript, name string) (fr.Element, error) {
+	b, err := fs.ComputeChallenge(name)
+	if err != nil {
+		return fr.Element{}, err
+	}
+	var res fr.Element
+	res.SetBytes(b)
+	if res.IsZero() {
+		return fr.Element{}, ErrZeroChallenge
+	}
+	return res, nil
+}
+
+// powers returns (1, x, x², ..., xⁿ⁻¹)
+func powers(x fr.Element, n int) []fr.Element {
+	res := make([]fr.Element, n)
+	res[0].SetOne()
+	for i := 1; i < n; i++ {
+		res[i].Mul(&res[i-1], &x)
+	}
+	return res
+}
+
+// innerProduct returns ∑ᵢ a[i]⋅b[i], with len(a) = len(b)
+func innerProduct(a, b []fr.Element) fr.Element {
+	var res, t fr.Element
+	for i := range a {
+		t.Mul(&a[i], &b[i])
+		res.Add(&res, &t)
+	}
+	return res
+}
+
+// pad returns a copy of v, padded with zeros to size n ≥ len(v)
+func pad(v []fr.Element, n int) []fr.Element {
+	res := make([]fr.Element, n)
+	copy(res, v)
+	return res
+}
@@ -0,0 +1,241 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ipa
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	curve "github.com/consensys/gnark-crypto/ecc/bls12-381/twistededwards"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/twistededwards/fr"
+	"github.com/stretchr/testify/require"
+)
+
+const testSrsSize = 16
+
+// Test SRS re-used across tests
+var testSrs *SRS
+
+func init() {
+	testSrs, _ = NewSRS(testSrsSize, []byte("test"))
+}
+
+func randomVector(size int) []fr.Element {
+	v := make([]fr.Element, size)
+	for i := range v {
+		v[i].SetRandom()
+	}
+	return v
+}
+
+// eval evaluates the polynomial of coefficients p at x
+func eval(p []fr.Element, x fr.Element) fr.Element {
+	var res fr.Element
+	for i := len(p) - 1; i >= 0; i-- {
+		res.Mul(&res, &x).Add(&res, &p[i])
+	}
+	return res
+}
+
+func TestNewSRS(t *testing.T) {
+	assert := require.New(t)
+
+	srs, err := NewSRS(testSrsSize, []byte("test"))
+	assert.NoError(err)
+	assert.Equal(testSrs, srs, "the SRS must be deterministic")
+
+	for i := range srs.Basis {
+		assert.True(srs.Basis[i].IsOnCurve())
+		assert.False(isIdentity(&srs.Basis[i]))
+		assert.False(srs.Basis[i].Equal(&srs.Q))
+		for j := 0; j < i; j++ {
+			assert.False(srs.Basis[i].Equal(&srs.Basis[j]))
+		}
+	}
+
+	other, err := NewSRS(testSrsSize, []byte("other"))
+	assert.NoError(err)
+	assert.False(other.Q.Equal(&srs.Q))
+
+	_, err = NewSRS(12, []byte("test"))
+	assert.ErrorIs(err, ErrInvalidSRSSize)
+	_, err = NewSRS(0, []byte("test"))
+	assert.ErrorIs(err, ErrInvalidSRSSize)
+}
+
+func TestCommit(t *testing.T) {
+	assert := require.New(t)
+
+	a, b := randomVector(testSrsSize), randomVector(5)
+	ca, err := Commit(a, testSrs)
+	assert.NoError(err)
+	cb, err := Commit(b, testSrs)
+	assert.NoError(err)
+
+	// commitments are linear: C(a) + C(b) = C(a + b)
+	sum := make([]fr.Element, len(a))
+	copy(sum, a)
+	for i := range b {
+		sum[i].Add(&sum[i], &b[i])
+	}
+	expected, err := Commit(sum, testSrs)
+	assert.NoError(err)
+	var actual curve.PointAffine
+	actual.Add(&ca, &cb)
+	assert.True(expected.Equal(&actual))
+
+	_, err = Commit(randomVector(testSrsSize+1), testSrs)
+	assert.ErrorIs(err, ErrInvalidVectorSize)
+	_, err = Commit(nil, testSrs)
+	assert.ErrorIs(err, ErrInvalidVectorSize)
+}
+
+func TestOpenVerify(t *testing.T) {
+	assert := require.New(t)
+
+	for _, size := range []int{1, 2, 5, testSrsSize} {
+		p := randomVector(size)
+		digest, err := Commit(p, testSrs)
+		assert.NoError(err)
+
+		var point fr.Element
+		point.SetRandom()
+		proof, err := Open(p, point, digest, sha256.New(), testSrs, []byte("data"))
+		assert.NoError(err)
+		assert.Equal(len(proof.L), len(proof.R))
+
+		expected := eval(p, point)
+		assert.True(expected.Equal(&proof.ClaimedValue))
+		assert.NoError(Verify(&digest, &proof, point, sha256.New(), testSrs, []byte("data")), "size %d", size)
+
+		// wrong transcript or point; for constant polynomials, the proof doesn't depend on the challenges
+		// and the value is the same everywhere
+		if size > 1 {
+			assert.ErrorIs(Verify(&digest, &proof, point, sha256.New(), testSrs, []byte("other data")), ErrVerifyOpeningProof)
+
+			var otherPoint fr.Element
+			otherPoint.SetRandom()
+			assert.ErrorIs(Verify(&digest, &proof, otherPoint, sha256.New(), testSrs, []byte("data")), ErrVerifyOpeningProof)
+		}
+
+		// wrong claimed value
+		wrongProof := proof
+		wrongProof.ClaimedValue.Add(&wrongProof.ClaimedValue, &point)
+		assert.ErrorIs(Verify(&digest, &wrongProof, point, sha256.New(), testSrs, []byte("data")), ErrVerifyOpeningProof)
+
+		// wrong final element
+		wrongProof = proof
+		wrongProof.A.Add(&wrongProof.A, &point)
+		assert.ErrorIs(Verify(&digest, &wrongProof, point, sha256.New(), testSrs, []byte("data")), ErrVerifyOpeningProof)
+
+		// wrong cross term
+		if len(proof.L) > 0 {
+			wrongProof = proof
+			wrongProof.L = append([]curve.PointAffine{proof.R[0]}, proof.L[1:]...)
+			assert.ErrorIs(Verify(&digest, &wrongProof, point, sha256.New(), testSrs, []byte("data")), ErrVerifyOpeningProof)
+		}
+	}
+
+	// too many rounds for the SRS
+	var point fr.Element
+	proof := OpeningProof{
+		L: make([]curve.PointAffine, 5),
+		R: make([]curve.PointAffine, 5),
+	}
+	var digest Digest
+	assert.ErrorIs(Verify(&digest, &proof, point, sha256.New(), testSrs), ErrInvalidProofSize)
+}
+
+func TestInnerProduct(t *testing.T) {
+	assert := require.New(t)
+
+	a, b := randomVector(testSrsSize/2), randomVector(testSrsSize/2-3)
+	digest, err := Commit(a, testSrs)
+	assert.NoError(err)
+
+	proof, err := ProveInnerProduct(a, b, digest, sha256.New(), testSrs)
+	assert.NoError(err)
+	expected := innerProduct(a, pad(b, len(a)))
+	assert.True(expected.Equal(&proof.ClaimedValue))
+	assert.NoError(VerifyInnerProduct(&digest, b, &proof, sha256.New(), testSrs))
+
+	// another vector b
+	b[0].SetRandom()
+	assert.ErrorIs(VerifyInnerProduct(&digest, b, &proof, sha256.New(), testSrs), ErrVerifyOpeningProof)
+
+	// b larger than the proof
+	assert.ErrorIs(VerifyInnerProduct(&digest, randomVector(testSrsSize), &proof, sha256.New(), testSrs), ErrInvalidProofSize)
+}
+
+func TestBatchVerify(t *testing.T) {
+	assert := require.New(t)
+
+	sizes := []int{3, testSrsSize, 1, 8}
+	digests := make([]Digest, len(sizes))
+	proofs := make([]OpeningProof, len(sizes))
+	points := randomVector(len(sizes))
+	for i, size := range sizes {
+		p := randomVector(size)
+		var err error
+		digests[i], err = Commit(p, testSrs)
+		assert.NoError(err)
+		proofs[i], err = Open(p, points[i], digests[i], sha256.New(), testSrs)
+		assert.NoError(err)
+	}
+
+	assert.NoError(BatchVerify(digests, proofs, points, sha256.New(), testSrs))
+
+	// one of the proofs is wrong
+	proofs[2].ClaimedValue.SetRandom()
+	assert.ErrorIs(BatchVerify(digests, proofs, points, sha256.New(), testSrs), ErrVerifyOpeningProof)
+
+	assert.ErrorIs(BatchVerify(digests[1:], proofs, points, sha256.New(), testSrs), ErrInvalidNbDigests)
+}
+
+func BenchmarkOpen(b *testing.B) {
+	p := randomVector(testSrsSize)
+	digest, err := Commit(p, testSrs)
+	if err != nil {
+		b.Fatal(err)
+	}
+	var point fr.Element
+	point.SetRandom()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = Open(p, point, digest, sha256.New(), testSrs)
+	}
+}
+
+func BenchmarkVerify(b *testing.B) {
+	p := randomVector(testSrsSize)
+	digest, err := Commit(p, testSrs)
+	if err != nil {
+		b.Fatal(err)
+	}
+	var point fr.Element
+	point.SetRandom()
+	proof, err := Open(p, point, digest, sha256.New(), testSrs)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = Verify(&digest, &proof, point, sha256.New(), testSrs)
+	}
+}
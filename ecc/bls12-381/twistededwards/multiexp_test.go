@@ -0,0 +1,210 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package twistededwards
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	scalarfield "github.com/consensys/gnark-crypto/ecc/bls12-381/twistededwards/fr"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestMultiExp(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = 3
+	} else {
+		parameters.MinSuccessfulTests = nbFuzzShort * 2
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	// size of the multiExps
+	const nbSamples = 73
+
+	// multi exp points: multiples of the base point, and points of the whole curve, with a
+	// torsion component (the expected results are computed with scalarMulWindowed, which, unlike
+	// the GLV scalar multiplication, is valid on the whole curve)
+	samplePoints := make([]PointAffine, nbSamples)
+	params := GetEdwardsCurve()
+	var g PointExtended
+	g.FromAffine(&params.Base)
+	for i := 0; i < nbSamples/2; i++ {
+		samplePoints[i].FromExtended(&g)
+		g.MixedAdd(&g, &params.Base)
+	}
+	for i := nbSamples / 2; i < nbSamples; i++ {
+		var u fr.Element
+		u.SetUint64(uint64(i))
+		samplePoints[i] = MapToCurve(&u)
+	}
+	// sprinkle some identity points
+	samplePoints[3].setInfinity()
+	samplePoints[nbSamples-1].setInfinity()
+
+	properties.Property("[EXTENDED] MultiExp should match the sum of the scalar multiplications", prop.ForAll(
+		func(seed uint64) bool {
+			scalars := make([]scalarfield.Element, nbSamples)
+			for i := range scalars {
+				scalars[i].SetUint64(seed + uint64(i)).Square(&scalars[i]).Inverse(&scalars[i])
+			}
+			// some edge cases: zero, one and -1
+			scalars[1].SetZero()
+			scalars[2].SetOne()
+			scalars[5].SetOne().Neg(&scalars[5])
+
+			var expected, tmp PointExtended
+			expected.setInfinity()
+			var s big.Int
+			for i := range samplePoints {
+				tmp.FromAffine(&samplePoints[i])
+				tmp.scalarMulWindowed(&tmp, scalars[i].BigInt(&s))
+				expected.Add(&expected, &tmp)
+			}
+
+			for _, nbTasks := range []int{0, 1, 5, 16} {
+				var res PointExtended
+				if _, err := res.MultiExp(samplePoints, scalars, ecc.MultiExpConfig{NbTasks: nbTasks}); err != nil {
+					return false
+				}
+				if !res.Equal(&expected) {
+					return false
+				}
+			}
+			return true
+		},
+		gopter.Gen(func(genParams *gopter.GenParameters) *gopter.GenResult {
+			return gopter.NewGenResult(genParams.NextUint64()|1, gopter.NoShrinker)
+		}),
+	))
+
+	properties.Property("[EXTENDED] MultiExp with large scalars should match the sum of the scalar multiplications", prop.ForAll(
+		func(seed uint64) bool {
+			scalars := make([]scalarfield.Element, nbSamples)
+			var max scalarfield.Element
+			max.SetOne().Neg(&max)
+			for i := range scalars {
+				scalars[i].SetUint64(seed + uint64(i))
+				scalars[i].Sub(&max, &scalars[i])
+			}
+
+			var expected, tmp PointExtended
+			expected.setInfinity()
+			var s big.Int
+			for i := range samplePoints {
+				tmp.FromAffine(&samplePoints[i])
+				tmp.scalarMulWindowed(&tmp, scalars[i].BigInt(&s))
+				expected.Add(&expected, &tmp)
+			}
+
+			var res PointExtended
+			if _, err := res.MultiExp(samplePoints, scalars, ecc.MultiExpConfig{}); err != nil {
+				return false
+			}
+			return res.Equal(&expected)
+		},
+		gopter.Gen(func(genParams *gopter.GenParameters) *gopter.GenResult {
+			return gopter.NewGenResult(genParams.NextUint64()>>32, gopter.NoShrinker)
+		}),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	var res PointExtended
+	if _, err := res.MultiExp(samplePoints, make([]scalarfield.Element, nbSamples-1), ecc.MultiExpConfig{}); err == nil {
+		t.Error("MultiExp should fail with len(points) != len(scalars)")
+	}
+	if _, err := res.MultiExp(nil, nil, ecc.MultiExpConfig{}); err != nil || !res.IsZero() {
+		t.Error("empty MultiExp should be the identity")
+	}
+}
+
+func TestBatchFromExtended(t *testing.T) {
+	t.Parallel()
+	const nbSamples = 50
+
+	params := GetEdwardsCurve()
+	points := make([]PointExtended, nbSamples)
+	points[0].setInfinity()
+	points[1].FromAffine(&params.Base)
+	for i := 2; i < nbSamples; i++ {
+		points[i].Add(&points[i-1], &points[i-2])
+		points[i].Double(&points[i])
+	}
+
+	result := BatchFromExtended(points)
+	for i := range points {
+		var expected PointAffine
+		expected.FromExtended(&points[i])
+		if !result[i].Equal(&expected) {
+			t.Fatalf("mismatch on point %d", i)
+		}
+	}
+}
+
+func BenchmarkMultiExp(b *testing.B) {
+	const (
+		pow       = 16
+		nbSamples = 1 << pow
+	)
+
+	params := GetEdwardsCurve()
+	var g PointExtended
+	g.FromAffine(&params.Base)
+	samplePoints := make([]PointExtended, nbSamples)
+	scalars := make([]scalarfield.Element, nbSamples)
+	for i := range samplePoints {
+		samplePoints[i].Set(&g)
+		g.MixedAdd(&g, &params.Base)
+		scalars[i].SetRandom()
+	}
+	points := BatchFromExtended(samplePoints)
+
+	var res PointExtended
+	for i := 5; i <= pow; i++ {
+		using := 1 << i
+
+		b.Run(fmt.Sprintf("%d points", using), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				_, _ = res.MultiExp(points[:using], scalars[:using], ecc.MultiExpConfig{})
+			}
+		})
+	}
+}
+
+func BenchmarkBatchFromExtended(b *testing.B) {
+	const nbSamples = 1 << 12
+
+	params := GetEdwardsCurve()
+	points := make([]PointExtended, nbSamples)
+	points[0].FromAffine(&params.Base)
+	for i := 1; i < nbSamples; i++ {
+		points[i].MixedAdd(&points[i-1], &params.Base)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = BatchFromExtended(points)
+	}
+}
@@ -0,0 +1,243 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package zeromorph
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/polynomial"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/kzg"
+	"github.com/stretchr/testify/require"
+)
+
+const testNbVars = 6
+
+// Test SRS re-used across tests, for polynomials in testNbVars variables
+var testSrs *kzg.SRS
+
+func init() {
+	testSrs, _ = kzg.NewSRS(1<<testNbVars, big.NewInt(42))
+}
+
+func randomMultiLin(nbVars int) polynomial.MultiLin {
+	m := make(polynomial.MultiLin, 1<<nbVars)
+	for i := range m {
+		m[i].SetRandom()
+	}
+	return m
+}
+
+func randomPoint(nbVars int) []fr.Element {
+	p := make([]fr.Element, nbVars)
+	for i := range p {
+		p[i].SetRandom()
+	}
+	return p
+}
+
+func TestOpenVerify(t *testing.T) {
+	assert := require.New(t)
+
+	for _, nbVars := range []int{0, 1, 3, testNbVars} {
+		exactSrs, err := kzg.NewSRS(uint64(max(2, 1<<nbVars)), big.NewInt(42))
+		assert.NoError(err)
+
+		// the SRS may be larger than the polynomial
+		for _, srs := range []*kzg.SRS{exactSrs, testSrs} {
+			vk := NewVerifyingKey(srs)
+
+			f := randomMultiLin(nbVars)
+			digest, err := Commit(f, srs.Pk)
+			assert.NoError(err)
+
+			point := randomPoint(nbVars)
+			proof, err := Open(f, point, digest, sha256.New(), srs.Pk, []byte("data"))
+			assert.NoError(err)
+
+			expected := f.Evaluate(point, nil)
+			assert.True(expected.Equal(&proof.ClaimedValue))
+			assert.NoError(Verify(&digest, &proof, point, sha256.New(), vk, []byte("data")), "%d variables", nbVars)
+
+			// wrong transcript; for constant polynomials, the proof doesn't depend on the challenges
+			if nbVars > 0 {
+				assert.ErrorIs(Verify(&digest, &proof, point, sha256.New(), vk, []byte("other data")), ErrVerifyOpeningProof)
+			}
+
+			// wrong claimed value
+			wrongProof := proof
+			wrongProof.ClaimedValue.SetRandom()
+			assert.ErrorIs(Verify(&digest, &wrongProof, point, sha256.New(), vk, []byte("data")), ErrVerifyOpeningProof)
+
+			// wrong point
+			if nbVars > 0 {
+				wrongPoint := randomPoint(nbVars)
+				assert.ErrorIs(Verify(&digest, &proof, wrongPoint, sha256.New(), vk, []byte("data")), ErrVerifyOpeningProof)
+			}
+		}
+	}
+}
+
+// With an SRS larger than 2ⁿ, quotients q̂ₖ of degree ⩾ 2ᵏ can satisfy the Zeromorph identity
+// Uₙ(f) - v⋅Φₙ = ∑ₖ Aₖ⋅q̂ₖ for a wrong value v, where Aₖ = X^(2ᵏ)Φₙ₋ₖ₋₁(X^(2ᵏ⁺¹)) - uₖΦₙ₋ₖ(X^(2ᵏ)).
+// Their batched quotient must not fit in the SRS.
+func TestHighDegreeQuotients(t *testing.T) {
+	assert := require.New(t)
+
+	const nbVars = 2
+	const N = 1 << nbVars
+	vk := NewVerifyingKey(testSrs)
+
+	f := randomMultiLin(nbVars)
+	digest, err := Commit(f, testSrs.Pk)
+	assert.NoError(err)
+
+	// u₁ = 1/2, so that A₁ = X² - u₁(1 + X²) = (X-1)(X+1)/2
+	point := randomPoint(nbVars)
+	point[0].SetUint64(2).Inverse(&point[0])
+	u0, u1 := point[1], point[0]
+	var one, tmp fr.Element
+	one.SetOne()
+	A0 := make(polynomial.Polynomial, 4)
+	A0[0].Neg(&u0)
+	A0[1].Sub(&one, &u0)
+	A0[2].Neg(&u0)
+	A0[3].Sub(&one, &u0)
+	A1 := make(polynomial.Polynomial, 3)
+	A1[0].Neg(&u1)
+	A1[2].Sub(&one, &u1)
+
+	// honest quotients, as in Open
+	quotients := make([]polynomial.Polynomial, nbVars)
+	g := f.Clone()
+	for i := 0; i < nbVars; i++ {
+		k := nbVars - 1 - i
+		mid := len(g) / 2
+		quotients[k] = make(polynomial.Polynomial, mid)
+		for j := 0; j < mid; j++ {
+			quotients[k][j].Sub(&g[mid+j], &g[j])
+		}
+		g.Fold(point[i])
+	}
+	v := g[0]
+
+	// A₀Δ₀ + A₁Δ₁ = -Φ₂: Δ₀ interpolates -Φ₂/A₀ at the roots ±1 of A₁
+	phi := polynomial.Polynomial{one, one, one, one}
+	var minusOne fr.Element
+	minusOne.Neg(&one)
+	roots := []fr.Element{one, minusOne}
+	values := make([]fr.Element, 2)
+	for i := range roots {
+		a0 := A0.Eval(&roots[i])
+		values[i] = phi.Eval(&roots[i])
+		values[i].Neg(&values[i]).Div(&values[i], &a0)
+	}
+	delta0, err := polynomial.Interpolate(roots, values)
+	assert.NoError(err)
+	// Δ₁ = (-Φ₂ - A₀Δ₀) / A₁
+	var rhs polynomial.Polynomial
+	rhs.Mul(A0, delta0).Add(rhs, phi)
+	rhs.ScaleInPlace(&minusOne)
+	delta1, r, err := polynomial.DivRem(rhs, A1)
+	assert.NoError(err)
+	for i := range r {
+		assert.True(r[i].IsZero())
+	}
+
+	// q̂ₖ + Δₖ open f to v+1, with deg(q̂₀ + Δ₀) ⩾ 1 and deg(q̂₁ + Δ₁) ⩾ 2
+	var wrongValue fr.Element
+	wrongValue.Add(&v, &one)
+	forged := make([][]fr.Element, nbVars)
+	var forged0, forged1 polynomial.Polynomial
+	forged0.Add(quotients[0], delta0)
+	forged1.Add(quotients[1], delta1)
+	forged[0], forged[1] = forged0, forged1
+	assert.Greater(len(forged[0]), 1)
+	assert.Greater(len(forged[1]), 2)
+
+	// the identity holds
+	x := randomPoint(1)[0]
+	fx := polynomial.Polynomial(f)
+	lhs := fx.Eval(&x)
+	tmp = phi.Eval(&x)
+	tmp.Mul(&tmp, &wrongValue)
+	lhs.Sub(&lhs, &tmp)
+	var sum fr.Element
+	for k, A := range []polynomial.Polynomial{A0, A1} {
+		q := polynomial.Polynomial(forged[k])
+		a, b := A.Eval(&x), q.Eval(&x)
+		tmp.Mul(&a, &b)
+		sum.Add(&sum, &tmp)
+	}
+	assert.True(lhs.Equal(&sum), "forged quotients should satisfy the Zeromorph identity")
+
+	// shifted to the size of the SRS, the forged quotients can't be committed
+	_, err = open(f, forged, wrongValue, point, digest, sha256.New(), testSrs.Pk, len(testSrs.Pk.G1), nil)
+	assert.ErrorIs(err, kzg.ErrInvalidPolynomialSize)
+
+	// shifted to the size of the polynomial, they fool a verifier unaware of the size of the SRS
+	proof, err := open(f, forged, wrongValue, point, digest, sha256.New(), testSrs.Pk, N, nil)
+	assert.NoError(err)
+	assert.NoError(Verify(&digest, &proof, point, sha256.New(), VerifyingKey{VerifyingKey: testSrs.Vk, SRSSize: N}))
+	assert.ErrorIs(Verify(&digest, &proof, point, sha256.New(), vk), ErrVerifyOpeningProof)
+}
+
+func TestOpenErrors(t *testing.T) {
+	assert := require.New(t)
+
+	_, err := Commit(randomMultiLin(testNbVars+1), testSrs.Pk)
+	assert.ErrorIs(err, ErrInvalidPolynomialSize)
+
+	f := randomMultiLin(3)
+	digest, err := Commit(f, testSrs.Pk)
+	assert.NoError(err)
+	_, err = Open(f, randomPoint(2), digest, sha256.New(), testSrs.Pk)
+	assert.ErrorIs(err, ErrInvalidPointSize)
+
+	proof, err := Open(f, randomPoint(3), digest, sha256.New(), testSrs.Pk)
+	assert.NoError(err)
+	vk := NewVerifyingKey(testSrs)
+	assert.ErrorIs(Verify(&digest, &proof, randomPoint(4), sha256.New(), vk), ErrInvalidProofSize)
+
+	// the polynomial doesn't fit in the SRS of the verifier
+	vk.SRSSize = 4
+	assert.ErrorIs(Verify(&digest, &proof, randomPoint(3), sha256.New(), vk), ErrInvalidPolynomialSize)
+}
+
+func BenchmarkOpen(b *testing.B) {
+	f := randomMultiLin(testNbVars)
+	point := randomPoint(testNbVars)
+	digest, _ := Commit(f, testSrs.Pk)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Open(f, point, digest, sha256.New(), testSrs.Pk)
+	}
+}
+
+func BenchmarkVerify(b *testing.B) {
+	f := randomMultiLin(testNbVars)
+	point := randomPoint(testNbVars)
+	digest, _ := Commit(f, testSrs.Pk)
+	proof, _ := Open(f, point, digest, sha256.New(), testSrs.Pk)
+	vk := NewVerifyingKey(testSrs)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Verify(&digest, &proof, point, sha256.New(), vk)
+	}
+}
@@ -65,6 +65,40 @@ func TestECDSA(t *testing.T) {
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
+func TestPreparedPublicKey(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("[BLS24-315] the prepared public key should verify as the public key", prop.ForAll(
+		func() bool {
+			privKey, _ := GenerateKey(rand.Reader)
+			prepared := privKey.PublicKey.Prepare()
+
+			msg := []byte("testing ECDSA")
+			hFunc := sha256.New()
+			sig, _ := privKey.Sign(msg, hFunc)
+			flag, err := prepared.Verify(sig, msg, hFunc)
+			if err != nil || !flag {
+				return false
+			}
+
+			// wrong message
+			flag, err = prepared.Verify(sig, []byte("testing ECDSA!"), hFunc)
+			if err != nil || flag {
+				return false
+			}
+
+			// wrong public key
+			otherKey, _ := GenerateKey(rand.Reader)
+			flag, err = otherKey.PublicKey.Prepare().Verify(sig, msg, hFunc)
+			return err == nil && !flag
+		},
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
 func TestNonMalleability(t *testing.T) {
 
 	// buffer too big
@@ -164,3 +198,16 @@ func BenchmarkVerifyECDSA(b *testing.B) {
 		privKey.PublicKey.Verify(sig, msg, nil)
 	}
 }
+
+func BenchmarkVerifyPreparedECDSA(b *testing.B) {
+
+	privKey, _ := GenerateKey(rand.Reader)
+	prepared := privKey.PublicKey.Prepare()
+	msg := []byte("benchmarking ECDSA sign()")
+	sig, _ := privKey.Sign(msg, nil)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		prepared.Verify(sig, msg, nil)
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ecdsa
+
+import (
+	"hash"
+	"math/big"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315"
+)
+
+// windowSize is the size in bits of the windows of the precomputed tables
+const windowSize = 4
+
+// nbSplit is the number of sub-scalars of the GLV decomposition u = u₀ + u₁⋅λ
+const nbSplit = 2
+
+// table holds the multiples [1]P, ..., [2ʷ-1]P of a point P, w being the window size
+type table [1<<windowSize - 1]bls24315.G1Affine
+
+// PreparedPublicKey is a public key along with precomputed tables of its
+// multiples, which speed up the verification of signatures. The precomputation
+// costs about as much as a verification: it is meant for keys verifying many
+// signatures.
+type PreparedPublicKey struct {
+	PublicKey PublicKey
+	tables    [nbSplit]table // multiples of A and ϕ(A) = [λ]A
+}
+
+var (
+	baseOnce   sync.Once
+	baseTables [nbSplit]table // multiples of the generator g and ϕ(g) = [λ]g
+	lambda     big.Int        // primitive cube root of unity mod order
+	glvBasis   ecc.Lattice    // short vectors (a, b) such that a + b⋅λ = 0 mod order
+)
+
+func initBaseTables() {
+	// the curve has j-invariant 0, and P → [λ]P is an endomorphism of the prime
+	// order subgroup for any primitive cube root of unity λ. We don't need its
+	// efficient form ϕ: (x,y) → (ω⋅x,y), as ϕ(P) is computed once per table.
+	e := new(big.Int).Sub(order, one)
+	e.Div(e, big.NewInt(3))
+	for h := int64(2); lambda.Cmp(one) <= 0; h++ {
+		lambda.Exp(big.NewInt(h), e, order)
+	}
+	ecc.PrecomputeLattice(order, &lambda, &glvBasis)
+	_, _, g, _ := bls24315.Generators()
+	setTables(&baseTables, &g)
+}
+
+// setTables sets tables to the multiples of p and [λ]p
+func setTables(tables *[nbSplit]table, p *bls24315.G1Affine) {
+	n := len(tables[0])
+	points := make([]bls24315.G1Jac, nbSplit*n)
+	points[0].FromAffine(p)
+	points[n].ScalarMultiplication(&points[0], &lambda)
+	for j := 0; j < nbSplit; j++ {
+		multiples := points[j*n : (j+1)*n]
+		for i := 1; i < n; i++ {
+			multiples[i].Set(&multiples[i-1]).AddAssign(&multiples[0])
+		}
+	}
+	affine := bls24315.BatchJacobianToAffineG1(points)
+	for j := range tables {
+		copy(tables[j][:], affine[j*n:(j+1)*n])
+	}
+}
+
+// Prepare returns the public key along with the precomputed tables of its
+// multiples, to verify signatures with PreparedPublicKey.Verify.
+func (publicKey *PublicKey) Prepare() *PreparedPublicKey {
+	baseOnce.Do(initBaseTables)
+
+	res := new(PreparedPublicKey)
+	res.PublicKey.A.Set(&publicKey.A)
+	setTables(&res.tables, &res.PublicKey.A)
+	return res
+}
+
+// Verify validates the ECDSA signature, as PublicKey.Verify does, using the
+// precomputed tables of the public key and of the generator.
+//
+// The scalars u₁ = s⁻¹ ⋅ m and u₂ = s⁻¹ ⋅ r are split in halves with the GLV
+// decomposition, so that the joint scalar multiplication has half the doublings.
+func (publicKey *PreparedPublicKey) Verify(sigBin, message []byte, hFunc hash.Hash) (bool, error) {
+	baseOnce.Do(initBaseTables)
+
+	r, u1, u2, err := verifyScalars(sigBin, message, hFunc)
+	if err != nil {
+		return false, err
+	}
+
+	var U bls24315.G1Jac
+	k1 := ecc.SplitScalar(u1, &glvBasis)
+	k2 := ecc.SplitScalar(u2, &glvBasis)
+	mulTables(&U,
+		[]*table{&baseTables[0], &baseTables[1], &publicKey.tables[0], &publicKey.tables[1]},
+		[]*big.Int{&k1[0], &k1[1], &k2[0], &k2[1]},
+	)
+
+	return checkX(&U, r), nil
+}
+
+// mulTables sets res to ∑ᵢ [sᵢ]Pᵢ, where tables[i] holds the multiples of Pᵢ,
+// with a joint fixed-window double-and-add. The scalars may be negative.
+func mulTables(res *bls24315.G1Jac, tables []*table, scalars []*big.Int) *bls24315.G1Jac {
+	abs := make([]big.Int, len(scalars))
+	maxBits := 0
+	for i := range scalars {
+		abs[i].Abs(scalars[i])
+		if abs[i].BitLen() > maxBits {
+			maxBits = abs[i].BitLen()
+		}
+	}
+
+	var acc bls24315.G1Jac
+	var q bls24315.G1Affine
+	acc.FromAffine(&q) // infinity
+	for w := (maxBits+windowSize-1)/windowSize - 1; w >= 0; w-- {
+		for j := 0; j < windowSize; j++ {
+			acc.DoubleAssign()
+		}
+		for i := range abs {
+			var digit uint
+			for b := windowSize - 1; b >= 0; b-- {
+				digit = digit<<1 | abs[i].Bit(w*windowSize+b)
+			}
+			if digit == 0 {
+				continue
+			}
+			if scalars[i].Sign() == -1 {
+				q.Neg(&tables[i][digit-1])
+				acc.AddMixed(&q)
+			} else {
+				acc.AddMixed(&tables[i][digit-1])
+			}
+		}
+	}
+
+	return res.Set(&acc)
+}
@@ -0,0 +1,165 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fp
+
+import (
+	"math/bits"
+)
+
+// Accumulator holds a sum of products of Elements, ∑ xᵢ⋅yᵢ, without reducing them
+// modulo q; the products are accumulated as double-width integers (in Montgomery form, xᵢ⋅yᵢ⋅r²),
+// plus an extra word to absorb the carries.
+//
+// MulAcc costs roughly half a Mul, and a single Reduce is needed at the end; up to 2⁶⁴-1 products
+// can be accumulated before an overflow.
+//
+// The zero value is an empty accumulator, ready to use.
+type Accumulator struct {
+	t [11]uint64
+}
+
+// MulAcc adds x*y to the accumulator and returns acc
+func (acc *Accumulator) MulAcc(x, y *Element) *Accumulator {
+	// p = x * y, on 10 words
+	var p [10]uint64
+	var c uint64
+	c, p[0] = madd1(x[0], y[0], p[0])
+	c, p[1] = madd2(x[0], y[1], p[1], c)
+	c, p[2] = madd2(x[0], y[2], p[2], c)
+	c, p[3] = madd2(x[0], y[3], p[3], c)
+	c, p[4] = madd2(x[0], y[4], p[4], c)
+	p[5] = c
+	c, p[1] = madd1(x[1], y[0], p[1])
+	c, p[2] = madd2(x[1], y[1], p[2], c)
+	c, p[3] = madd2(x[1], y[2], p[3], c)
+	c, p[4] = madd2(x[1], y[3], p[4], c)
+	c, p[5] = madd2(x[1], y[4], p[5], c)
+	p[6] = c
+	c, p[2] = madd1(x[2], y[0], p[2])
+	c, p[3] = madd2(x[2], y[1], p[3], c)
+	c, p[4] = madd2(x[2], y[2], p[4], c)
+	c, p[5] = madd2(x[2], y[3], p[5], c)
+	c, p[6] = madd2(x[2], y[4], p[6], c)
+	p[7] = c
+	c, p[3] = madd1(x[3], y[0], p[3])
+	c, p[4] = madd2(x[3], y[1], p[4], c)
+	c, p[5] = madd2(x[3], y[2], p[5], c)
+	c, p[6] = madd2(x[3], y[3], p[6], c)
+	c, p[7] = madd2(x[3], y[4], p[7], c)
+	p[8] = c
+	c, p[4] = madd1(x[4], y[0], p[4])
+	c, p[5] = madd2(x[4], y[1], p[5], c)
+	c, p[6] = madd2(x[4], y[2], p[6], c)
+	c, p[7] = madd2(x[4], y[3], p[7], c)
+	c, p[8] = madd2(x[4], y[4], p[8], c)
+	p[9] = c
+
+	// acc += p
+	acc.t[0], c = bits.Add64(acc.t[0], p[0], 0)
+	acc.t[1], c = bits.Add64(acc.t[1], p[1], c)
+	acc.t[2], c = bits.Add64(acc.t[2], p[2], c)
+	acc.t[3], c = bits.Add64(acc.t[3], p[3], c)
+	acc.t[4], c = bits.Add64(acc.t[4], p[4], c)
+	acc.t[5], c = bits.Add64(acc.t[5], p[5], c)
+	acc.t[6], c = bits.Add64(acc.t[6], p[6], c)
+	acc.t[7], c = bits.Add64(acc.t[7], p[7], c)
+	acc.t[8], c = bits.Add64(acc.t[8], p[8], c)
+	acc.t[9], c = bits.Add64(acc.t[9], p[9], c)
+	acc.t[10] += c
+
+	return acc
+}
+
+// Add sets acc = x + y and returns acc
+func (acc *Accumulator) Add(x, y *Accumulator) *Accumulator {
+	var c uint64
+	acc.t[0], c = bits.Add64(x.t[0], y.t[0], 0)
+	acc.t[1], c = bits.Add64(x.t[1], y.t[1], c)
+	acc.t[2], c = bits.Add64(x.t[2], y.t[2], c)
+	acc.t[3], c = bits.Add64(x.t[3], y.t[3], c)
+	acc.t[4], c = bits.Add64(x.t[4], y.t[4], c)
+	acc.t[5], c = bits.Add64(x.t[5], y.t[5], c)
+	acc.t[6], c = bits.Add64(x.t[6], y.t[6], c)
+	acc.t[7], c = bits.Add64(x.t[7], y.t[7], c)
+	acc.t[8], c = bits.Add64(x.t[8], y.t[8], c)
+	acc.t[9], c = bits.Add64(x.t[9], y.t[9], c)
+	acc.t[10] = x.t[10] + y.t[10] + c
+	return acc
+}
+
+// Reset sets acc to 0 and returns acc
+func (acc *Accumulator) Reset() *Accumulator {
+	acc.t = [11]uint64{}
+	return acc
+}
+
+// Reduce returns the accumulated sum, reduced modulo q
+func (acc *Accumulator) Reduce() Element {
+	// the accumulator holds t = hi⋅r² + tHi⋅r + tLo, and the result (in Montgomery form)
+	// is t⋅r⁻¹ = hi⋅r + tHi + tLo⋅r⁻¹ (mod q).
+	// each term is computed with a textbook Montgomery multiplication, which
+	// unlike Element.Mul accepts any left operand < r.
+	var tLo, tHi, hi [5]uint64
+	copy(tLo[:], acc.t[:5])
+	copy(tHi[:], acc.t[5:10])
+	hi[0] = acc.t[10]
+
+	var one, rOne, z, t Element
+	one[0] = 1
+	rOne.SetOne()
+
+	montReduceWide(&z, &tLo, &one)
+	montReduceWide(&t, &tHi, &rOne)
+	z.Add(&z, &t)
+	montReduceWide(&t, &hi, &rSquare)
+	z.Add(&z, &t)
+
+	return z
+}
+
+// montReduceWide sets z = x⋅y⋅r⁻¹ mod q, for any x < r and y < q.
+func montReduceWide(z *Element, x *[5]uint64, y *Element) {
+	// textbook CIOS; at the end of each step t < q + y, on 5 words and a carry bit
+	var t [7]uint64
+	var c, b uint64
+	for i := 0; i < 5; i++ {
+		// t += x[i] * y
+		c = 0
+		for j := 0; j < 5; j++ {
+			c, t[j] = madd2(x[i], y[j], t[j], c)
+		}
+		t[5], t[6] = bits.Add64(t[5], c, 0)
+
+		// t = (t + m * q) / 2⁶⁴
+		m := t[0] * qInvNeg
+		c = madd0(m, qElement[0], t[0])
+		for j := 1; j < 5; j++ {
+			c, t[j-1] = madd2(m, qElement[j], t[j], c)
+		}
+		t[4], b = bits.Add64(t[5], c, 0)
+		t[5] = t[6] + b
+	}
+
+	copy(z[:], t[:5])
+	if t[5] != 0 || !z.smallerThanModulus() {
+		z[0], b = bits.Sub64(z[0], q0, 0)
+		z[1], b = bits.Sub64(z[1], q1, b)
+		z[2], b = bits.Sub64(z[2], q2, b)
+		z[3], b = bits.Sub64(z[3], q3, b)
+		z[4], _ = bits.Sub64(z[4], q4, b)
+	}
+}
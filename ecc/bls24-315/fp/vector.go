@@ -245,6 +245,20 @@ func scalarMulVecGeneric(res, a Vector, b *Element) {
 	}
 }
 
+// InnerProduct returns the inner product ∑ vectorᵢ⋅otherᵢ.
+// The products are accumulated without reduction, see Accumulator.
+// It panics if the vectors don't have the same length.
+func (vector Vector) InnerProduct(other Vector) Element {
+	if len(vector) != len(other) {
+		panic("vector.InnerProduct: vectors don't have the same length")
+	}
+	var acc Accumulator
+	for i := 0; i < len(vector); i++ {
+		acc.MulAcc(&vector[i], &other[i])
+	}
+	return acc.Reduce()
+}
+
 // TODO @gbotrel make a public package out of that.
 // execute executes the work function in parallel.
 // this is copy paste from internal/parallel/parallel.go
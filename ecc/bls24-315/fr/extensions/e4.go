@@ -0,0 +1,241 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package extensions
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+)
+
+// E4 is a degree two finite field extension of E2; E4 = E2[v]/(v²-u)
+type E4 struct {
+	B0, B1 E2
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *E4) Equal(x *E4) bool {
+	return z.B0.Equal(&x.B0) && z.B1.Equal(&x.B1)
+}
+
+// SetZero sets an E4 elmt to zero
+func (z *E4) SetZero() *E4 {
+	z.B0.SetZero()
+	z.B1.SetZero()
+	return z
+}
+
+// SetOne sets z to 1 in Montgomery form and returns z
+func (z *E4) SetOne() *E4 {
+	z.B0.SetOne()
+	z.B1.SetZero()
+	return z
+}
+
+// Set copies x into z and returns z
+func (z *E4) Set(x *E4) *E4 {
+	z.B0 = x.B0
+	z.B1 = x.B1
+	return z
+}
+
+// SetElement sets z to the embedding of x ∈ fr in E4 and returns z
+func (z *E4) SetElement(x *fr.Element) *E4 {
+	z.B0.SetElement(x)
+	z.B1.SetZero()
+	return z
+}
+
+// SetRandom sets z to a random value
+func (z *E4) SetRandom() (*E4, error) {
+	if _, err := z.B0.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.B1.SetRandom(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// IsZero returns true if z is zero, false otherwise
+func (z *E4) IsZero() bool {
+	return z.B0.IsZero() && z.B1.IsZero()
+}
+
+// IsOne returns true if z is one, false otherwise
+func (z *E4) IsOne() bool {
+	return z.B0.IsOne() && z.B1.IsZero()
+}
+
+// Add sets z=x+y in E4 and returns z
+func (z *E4) Add(x, y *E4) *E4 {
+	z.B0.Add(&x.B0, &y.B0)
+	z.B1.Add(&x.B1, &y.B1)
+	return z
+}
+
+// Sub sets z to x-y and returns z
+func (z *E4) Sub(x, y *E4) *E4 {
+	z.B0.Sub(&x.B0, &y.B0)
+	z.B1.Sub(&x.B1, &y.B1)
+	return z
+}
+
+// Double sets z=2*x and returns z
+func (z *E4) Double(x *E4) *E4 {
+	z.B0.Double(&x.B0)
+	z.B1.Double(&x.B1)
+	return z
+}
+
+// Neg negates an E4 element
+func (z *E4) Neg(x *E4) *E4 {
+	z.B0.Neg(&x.B0)
+	z.B1.Neg(&x.B1)
+	return z
+}
+
+// String puts E4 in string form
+func (z *E4) String() string {
+	return z.B0.String() + "+(" + z.B1.String() + ")*v"
+}
+
+// MulByElement multiplies an element in E4 by an element in fr
+func (z *E4) MulByElement(x *E4, y *fr.Element) *E4 {
+	var yCopy fr.Element
+	yCopy.Set(y)
+	z.B0.MulByElement(&x.B0, &yCopy)
+	z.B1.MulByElement(&x.B1, &yCopy)
+	return z
+}
+
+// MulByE2 multiplies an element in E4 by an element in E2
+func (z *E4) MulByE2(x *E4, y *E2) *E4 {
+	var yCopy E2
+	yCopy.Set(y)
+	z.B0.Mul(&x.B0, &yCopy)
+	z.B1.Mul(&x.B1, &yCopy)
+	return z
+}
+
+// Mul sets z=x*y in E4 and returns z
+func (z *E4) Mul(x, y *E4) *E4 {
+	// Karatsuba
+	var a, b, c E2
+	a.Add(&x.B0, &x.B1)
+	b.Add(&y.B0, &y.B1)
+	a.Mul(&a, &b)
+	b.Mul(&x.B0, &y.B0)
+	c.Mul(&x.B1, &y.B1)
+	z.B1.Sub(&a, &b).Sub(&z.B1, &c)
+	z.B0.MulByNonResidue(&c).Add(&z.B0, &b)
+	return z
+}
+
+// Square sets z=x*x in E4 and returns z
+func (z *E4) Square(x *E4) *E4 {
+	// (b0 + b1⋅v)² = b0² + u⋅b1² + 2⋅b0⋅b1⋅v
+	var a, b E2
+	a.Square(&x.B0)
+	b.Square(&x.B1)
+	b.MulByNonResidue(&b)
+	z.B1.Mul(&x.B0, &x.B1).Double(&z.B1)
+	z.B0.Add(&a, &b)
+	return z
+}
+
+// Conjugate sets z to x conjugated over E2 and returns z
+func (z *E4) Conjugate(x *E4) *E4 {
+	z.B0 = x.B0
+	z.B1.Neg(&x.B1)
+	return z
+}
+
+// Inverse sets z to the inverse of x in E4 and returns z
+//
+// if x == 0, sets and returns z = x
+func (z *E4) Inverse(x *E4) *E4 {
+	// 1/(b0 + b1⋅v) = (b0 - b1⋅v)/(b0² - u⋅b1²)
+	var t0, t1 E2
+	t0.Square(&x.B0)
+	t1.Square(&x.B1)
+	t1.MulByNonResidue(&t1)
+	t0.Sub(&t0, &t1)
+	t0.Inverse(&t0)
+	z.B0.Mul(&x.B0, &t0)
+	z.B1.Mul(&x.B1, &t0).Neg(&z.B1)
+	return z
+}
+
+// Exp sets z=xᵏ (mod r⁴) and returns it
+func (z *E4) Exp(x E4, k *big.Int) *E4 {
+	if k.Sign() == -1 {
+		// negative k, we invert
+		x.Inverse(&x)
+		k = new(big.Int).Neg(k)
+	}
+
+	z.SetOne()
+	b := k.Bytes()
+	for i := 0; i < len(b); i++ {
+		w := b[i]
+		for j := 0; j < 8; j++ {
+			z.Square(z)
+			if (w & (0b10000000 >> j)) != 0 {
+				z.Mul(z, &x)
+			}
+		}
+	}
+
+	return z
+}
+
+// BatchInvertE4 returns a new slice with every element in a inverted.
+// It uses Montgomery batch inversion trick.
+//
+// if a[i] == 0, returns result[i] = a[i]
+func BatchInvertE4(a []E4) []E4 {
+	res := make([]E4, len(a))
+	if len(a) == 0 {
+		return res
+	}
+
+	zeroes := make([]bool, len(a))
+	var accumulator E4
+	accumulator.SetOne()
+
+	for i := 0; i < len(a); i++ {
+		if a[i].IsZero() {
+			zeroes[i] = true
+			continue
+		}
+		res[i].Set(&accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	accumulator.Inverse(&accumulator)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if zeroes[i] {
+			continue
+		}
+		res[i].Mul(&res[i], &accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	return res
+}
@@ -0,0 +1,256 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package extensions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNonResidue(t *testing.T) {
+	assert := require.New(t)
+
+	// nonResidue is not a square in fr
+	var x fr.Element
+	x.SetUint64(nonResidue)
+	assert.Equal(-1, x.Legendre())
+
+	// u is not a square in E2: u^((r²-1)/2) = -1
+	e := fr.Modulus()
+	e.Mul(e, e).Sub(e, big.NewInt(1)).Rsh(e, 1)
+	var u, minusOne E2
+	u.A1.SetOne()
+	u.Exp(u, e)
+	minusOne.SetOne().Neg(&minusOne)
+	assert.True(u.Equal(&minusOne))
+}
+
+func TestE2Arithmetic(t *testing.T) {
+	assert := require.New(t)
+
+	for i := 0; i < 10; i++ {
+		var a, b, c, d, e E2
+		a.SetRandom()
+		b.SetRandom()
+		c.SetRandom()
+
+		// distributivity
+		d.Add(&b, &c).Mul(&d, &a)
+		e.Mul(&a, &c)
+		c.Mul(&a, &b).Add(&c, &e)
+		assert.True(d.Equal(&c))
+
+		// square
+		d.Square(&a)
+		e.Mul(&a, &a)
+		assert.True(d.Equal(&e))
+
+		// inverse
+		d.Inverse(&a).Mul(&d, &a)
+		assert.True(d.IsOne())
+
+		// exponentiation: a^(-3) * a^3 = 1
+		d.Exp(a, big.NewInt(3))
+		e.Exp(a, big.NewInt(-3))
+		d.Mul(&d, &e)
+		assert.True(d.IsOne())
+
+		// multiplication by an element of fr
+		var x fr.Element
+		x.SetRandom()
+		d.MulByElement(&a, &x)
+		e.SetElement(&x).Mul(&e, &a)
+		assert.True(d.Equal(&e))
+	}
+
+	a := make([]E2, 5)
+	for i := range a {
+		a[i].SetRandom()
+	}
+	a[2].SetZero()
+	inv := BatchInvertE2(a)
+	for i := range a {
+		var d E2
+		d.Inverse(&a[i])
+		assert.True(d.Equal(&inv[i]))
+	}
+}
+
+func TestPolynomialE2(t *testing.T) {
+	assert := require.New(t)
+
+	p1, p2 := make(PolynomialE2, 7), make(PolynomialE2, 4)
+	for i := range p1 {
+		p1[i].SetRandom()
+	}
+	for i := range p2 {
+		p2[i].SetRandom()
+	}
+
+	var x, e1, e2 E2
+	x.SetRandom()
+	e1, e2 = p1.Eval(&x), p2.Eval(&x)
+
+	var p PolynomialE2
+	p.Add(p1, p2)
+	e := p.Eval(&x)
+	e.Sub(&e, &e1).Sub(&e, &e2)
+	assert.True(e.IsZero())
+
+	p.Sub(p2, p1)
+	e = p.Eval(&x)
+	e.Add(&e, &e1).Sub(&e, &e2)
+	assert.True(e.IsZero())
+
+	// evaluation at a point of fr
+	var y fr.Element
+	y.SetRandom()
+	x.SetElement(&y)
+	e1, e2 = p1.Eval(&x), p1.EvalBase(&y)
+	assert.True(e1.Equal(&e2))
+
+	// fold: p(x) = p₀(x²) + x⋅p₁(x²), so fold(x)(x²) = p(x)
+	f := p1.Fold(&x)
+	x.Square(&x)
+	e1 = f.Eval(&x)
+	assert.True(e1.Equal(&e2))
+
+	// embedding of fr[X]
+	base := make([]fr.Element, 5)
+	for i := range base {
+		base[i].SetRandom()
+	}
+	q := NewPolynomialE2(base)
+	var eBase fr.Element
+	for i := len(base) - 1; i >= 0; i-- {
+		eBase.Mul(&eBase, &y).Add(&eBase, &base[i])
+	}
+	e1 = q.EvalBase(&y)
+	e2.SetElement(&eBase)
+	assert.True(e1.Equal(&e2))
+	assert.True(q.Equal(append(q.Clone(), E2{})))
+}
+
+func TestE4Arithmetic(t *testing.T) {
+	assert := require.New(t)
+
+	for i := 0; i < 10; i++ {
+		var a, b, c, d, e E4
+		a.SetRandom()
+		b.SetRandom()
+		c.SetRandom()
+
+		// distributivity
+		d.Add(&b, &c).Mul(&d, &a)
+		e.Mul(&a, &c)
+		c.Mul(&a, &b).Add(&c, &e)
+		assert.True(d.Equal(&c))
+
+		// square
+		d.Square(&a)
+		e.Mul(&a, &a)
+		assert.True(d.Equal(&e))
+
+		// inverse
+		d.Inverse(&a).Mul(&d, &a)
+		assert.True(d.IsOne())
+
+		// exponentiation: a^(-3) * a^3 = 1
+		d.Exp(a, big.NewInt(3))
+		e.Exp(a, big.NewInt(-3))
+		d.Mul(&d, &e)
+		assert.True(d.IsOne())
+
+		// multiplication by an element of fr
+		var x fr.Element
+		x.SetRandom()
+		d.MulByElement(&a, &x)
+		e.SetElement(&x).Mul(&e, &a)
+		assert.True(d.Equal(&e))
+	}
+
+	a := make([]E4, 5)
+	for i := range a {
+		a[i].SetRandom()
+	}
+	a[2].SetZero()
+	inv := BatchInvertE4(a)
+	for i := range a {
+		var d E4
+		d.Inverse(&a[i])
+		assert.True(d.Equal(&inv[i]))
+	}
+}
+
+func TestPolynomialE4(t *testing.T) {
+	assert := require.New(t)
+
+	p1, p2 := make(PolynomialE4, 7), make(PolynomialE4, 4)
+	for i := range p1 {
+		p1[i].SetRandom()
+	}
+	for i := range p2 {
+		p2[i].SetRandom()
+	}
+
+	var x, e1, e2 E4
+	x.SetRandom()
+	e1, e2 = p1.Eval(&x), p2.Eval(&x)
+
+	var p PolynomialE4
+	p.Add(p1, p2)
+	e := p.Eval(&x)
+	e.Sub(&e, &e1).Sub(&e, &e2)
+	assert.True(e.IsZero())
+
+	p.Sub(p2, p1)
+	e = p.Eval(&x)
+	e.Add(&e, &e1).Sub(&e, &e2)
+	assert.True(e.IsZero())
+
+	// evaluation at a point of fr
+	var y fr.Element
+	y.SetRandom()
+	x.SetElement(&y)
+	e1, e2 = p1.Eval(&x), p1.EvalBase(&y)
+	assert.True(e1.Equal(&e2))
+
+	// fold: p(x) = p₀(x²) + x⋅p₁(x²), so fold(x)(x²) = p(x)
+	f := p1.Fold(&x)
+	x.Square(&x)
+	e1 = f.Eval(&x)
+	assert.True(e1.Equal(&e2))
+
+	// embedding of fr[X]
+	base := make([]fr.Element, 5)
+	for i := range base {
+		base[i].SetRandom()
+	}
+	q := NewPolynomialE4(base)
+	var eBase fr.Element
+	for i := len(base) - 1; i >= 0; i-- {
+		eBase.Mul(&eBase, &y).Add(&eBase, &base[i])
+	}
+	e1 = q.EvalBase(&y)
+	e2.SetElement(&eBase)
+	assert.True(e1.Equal(&e2))
+	assert.True(q.Equal(append(q.Clone(), E4{})))
+}
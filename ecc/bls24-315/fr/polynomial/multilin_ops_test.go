@@ -0,0 +1,181 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package polynomial
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/stretchr/testify/require"
+)
+
+func randomMultiLin(nbVars int) MultiLin {
+	m := make(MultiLin, 1<<nbVars)
+	for i := range m {
+		m[i].SetRandom()
+	}
+	return m
+}
+
+func randomPoint(nbVars int) []fr.Element {
+	p := make([]fr.Element, nbVars)
+	for i := range p {
+		p[i].SetRandom()
+	}
+	return p
+}
+
+func TestMultiLinMonomialForm(t *testing.T) {
+	assert := require.New(t)
+
+	// f = c₀ + c₁ X₂ + c₂ X₁ + c₃ X₁ X₂
+	m := MultiLin{fr.Element{}, fr.Element{}, fr.Element{}, fr.Element{}}
+	m[0].SetUint64(1)
+	m[1].SetUint64(3)
+	m[2].SetUint64(4)
+	m[3].SetUint64(10)
+	m.ToMonomialForm()
+	expected := []uint64{1, 2, 3, 4}
+	for i := range expected {
+		var e fr.Element
+		e.SetUint64(expected[i])
+		assert.True(m[i].Equal(&e), "coefficient %d", i)
+	}
+
+	for _, nbVars := range []int{0, 1, 5, 11} {
+		m := randomMultiLin(nbVars)
+		point := randomPoint(nbVars)
+		expected := m.Evaluate(point, nil)
+
+		coeffs := m.Clone()
+		coeffs.ToMonomialForm()
+		e := coeffs.EvaluateMonomialForm(point)
+		assert.True(e.Equal(&expected), "%d variables", nbVars)
+
+		coeffs.ToEvaluationForm()
+		assert.Equal(m, coeffs)
+	}
+}
+
+func TestMultiLinPartialEval(t *testing.T) {
+	assert := require.New(t)
+
+	const nbVars = 6
+	m := randomMultiLin(nbVars)
+	point := randomPoint(nbVars)
+	expected := m.Evaluate(point, nil)
+
+	for _, variables := range [][]int{{}, {0}, {5}, {3, 1}, {4, 0, 2}, {5, 4, 3, 2, 1, 0}} {
+		values := make([]fr.Element, len(variables))
+		isSet := make([]bool, nbVars)
+		for k, v := range variables {
+			values[k] = point[v]
+			isSet[v] = true
+		}
+		partial := m.PartialEval(variables, values)
+		assert.Equal(nbVars-len(variables), partial.NumVars())
+
+		var remaining []fr.Element
+		for i := range point {
+			if !isSet[i] {
+				remaining = append(remaining, point[i])
+			}
+		}
+		e := partial.Evaluate(remaining, nil)
+		assert.True(e.Equal(&expected), "variables %v", variables)
+	}
+
+	assert.Panics(func() {
+		m.PartialEval([]int{1, 1}, make([]fr.Element, 2))
+	})
+}
+
+func TestMultiLinEqParallel(t *testing.T) {
+	assert := require.New(t)
+
+	// large enough to be run in parallel
+	const nbVars = 12
+	q := randomPoint(nbVars)
+	m, mParallel := make(MultiLin, 1<<nbVars), make(MultiLin, 1<<nbVars)
+	m[0].SetRandom()
+	mParallel[0] = m[0]
+
+	m.Eq(q)
+	mParallel.EqParallel(q)
+	assert.Equal(m, mParallel)
+}
+
+func TestMultiLinTensorProduct(t *testing.T) {
+	assert := require.New(t)
+
+	left, right := randomMultiLin(3), randomMultiLin(4)
+	point := randomPoint(7)
+
+	expected := left.Evaluate(point[:3], nil)
+	e := right.Evaluate(point[3:], nil)
+	expected.Mul(&expected, &e)
+
+	p := TensorProduct(left, right)
+	e = p.Evaluate(point, nil)
+	assert.True(e.Equal(&expected))
+
+	// the eq table is the tensor product of the eq tables of the coordinates
+	eq := make(MultiLin, 1<<7)
+	eq[0].SetOne()
+	eq.Eq(point)
+	l, r := make(MultiLin, 1<<3), make(MultiLin, 1<<4)
+	l[0].SetOne()
+	r[0].SetOne()
+	l.Eq(point[:3])
+	r.Eq(point[3:])
+	assert.Equal(eq, TensorProduct(l, r))
+}
+
+func TestMultiLinAddMulMismatch(t *testing.T) {
+	assert := require.New(t)
+
+	small, large := randomMultiLin(3), randomMultiLin(11)
+	for _, swap := range []bool{false, true} {
+		left, right := small, large
+		if swap {
+			left, right = right, left
+		}
+
+		var sum, product MultiLin
+		sum.Add(left, right)
+		product.Mul(left, right)
+		assert.Equal(len(large), len(sum))
+		assert.Equal(len(large), len(product))
+
+		// on the hypercube
+		for i := range large {
+			var e fr.Element
+			e.Add(&small[i>>8], &large[i])
+			assert.True(e.Equal(&sum[i]))
+			e.Mul(&small[i>>8], &large[i])
+			assert.True(e.Equal(&product[i]))
+		}
+
+		// the sum is multilinear
+		point := randomPoint(11)
+		expected := small.Evaluate(point[:3], nil)
+		e := large.Evaluate(point, nil)
+		expected.Add(&expected, &e)
+		e = sum.Evaluate(point, nil)
+		assert.True(e.Equal(&expected))
+	}
+}
@@ -0,0 +1,383 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package pst
+
+import (
+	"errors"
+	"hash"
+	"math/big"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr/polynomial"
+	"github.com/consensys/gnark-crypto/fiat-shamir"
+)
+
+var (
+	ErrInvalidNbDigests      = errors.New("number of digests is not the same as the number of polynomials")
+	ErrZeroNbDigests         = errors.New("number of digests is zero")
+	ErrInvalidPolynomialSize = errors.New("invalid polynomial size (not a power of 2, or larger than SRS)")
+	ErrInvalidPointSize      = errors.New("the number of coordinates of the point is not the number of variables of the polynomial")
+	ErrInvalidProofSize      = errors.New("the number of quotients in the proof is not the number of variables of the polynomial")
+	ErrVerifyOpeningProof    = errors.New("can't verify opening proof")
+	ErrInvalidTrapdoorSize   = errors.New("the number of trapdoor values must be the number of variables")
+)
+
+// Digest commitment of a multilinear polynomial.
+type Digest = bls24315.G1Affine
+
+// ProvingKey used to create or open commitments
+type ProvingKey struct {
+	// G1[k] is the Lagrange basis of the hypercube in the variables Xₖ₊₁, ..., Xₙ:
+	// G1[k][∑ᵢ 2ⁱ⁻¹ bₙ₋ᵢ] = [Eq(τₖ₊₁, ..., τₙ, bₖ₊₁, ..., bₙ)]G₁, for k = 0, ..., n
+	G1 [][]bls24315.G1Affine
+}
+
+// VerifyingKey used to verify opening proofs
+type VerifyingKey struct {
+	G1  bls24315.G1Affine
+	G2  bls24315.G2Affine
+	Tau []bls24315.G2Affine // [τ₁]G₂, ..., [τₙ]G₂
+}
+
+// SRS must be computed through MPC and comprises the ProvingKey and the VerifyingKey
+type SRS struct {
+	Pk ProvingKey
+	Vk VerifyingKey
+}
+
+// NbVars returns the maximal number of variables of the polynomials that can be committed with the SRS
+func (srs *SRS) NbVars() int {
+	return len(srs.Vk.Tau)
+}
+
+// OpeningProof PST proof for opening at a single point.
+type OpeningProof struct {
+	// Quotients [qᵢ(τ)]G₁ where f - f(z) = ∑ᵢ (Xᵢ - zᵢ)⋅qᵢ(Xᵢ₊₁, ..., Xₙ)
+	Quotients []bls24315.G1Affine
+
+	// ClaimedValue purported value
+	ClaimedValue fr.Element
+}
+
+// BatchOpeningProof opening proof for many polynomials at the same point
+type BatchOpeningProof struct {
+	// Quotients of the polynomial ∑ᵢγⁱfᵢ
+	Quotients []bls24315.G1Affine
+
+	// ClaimedValues purported values
+	ClaimedValues []fr.Element
+}
+
+// NewSRS returns a new SRS for polynomials in up to nbVars variables, using tau as randomness source.
+//
+// In production, a SRS generated through MPC should be used.
+func NewSRS(nbVars int, tau []*big.Int) (*SRS, error) {
+	if len(tau) != nbVars {
+		return nil, ErrInvalidTrapdoorSize
+	}
+
+	var srs SRS
+	_, _, gen1Aff, gen2Aff := bls24315.Generators()
+	srs.Vk.G1 = gen1Aff
+	srs.Vk.G2 = gen2Aff
+
+	tauFr := make([]fr.Element, nbVars)
+	srs.Vk.Tau = make([]bls24315.G2Affine, nbVars)
+	for i := range tau {
+		tauFr[i].SetBigInt(tau[i])
+		srs.Vk.Tau[i].ScalarMultiplication(&gen2Aff, tau[i])
+	}
+
+	// the Lagrange bases for the suffixes of τ, in a single batch scalar multiplication
+	scalars := make([]fr.Element, 0, 1<<(nbVars+1))
+	for k := 0; k <= nbVars; k++ {
+		eq := make(polynomial.MultiLin, 1<<(nbVars-k))
+		eq[0].SetOne()
+		eq.EqParallel(tauFr[k:])
+		scalars = append(scalars, eq...)
+	}
+	g1s := bls24315.BatchScalarMultiplicationG1(&gen1Aff, scalars)
+
+	srs.Pk.G1 = make([][]bls24315.G1Affine, nbVars+1)
+	for k := range srs.Pk.G1 {
+		srs.Pk.G1[k], g1s = g1s[:1<<(nbVars-k)], g1s[1<<(nbVars-k):]
+	}
+
+	return &srs, nil
+}
+
+// offset returns the number of variables of the SRS that are not used by a polynomial of the given size:
+// a polynomial in k variables is committed as a polynomial in the last k variables of the SRS
+func offset(size, nbSRSVars int) (int, error) {
+	if size == 0 || size&(size-1) != 0 || size > 1<<nbSRSVars {
+		return 0, ErrInvalidPolynomialSize
+	}
+	return nbSRSVars - bits.TrailingZeros(uint(size)), nil
+}
+
+// Commit commits to a multilinear polynomial, given by its evaluations on the hypercube,
+// using a multi exponentiation with the SRS.
+func Commit(p polynomial.MultiLin, pk ProvingKey, nbTasks ...int) (Digest, error) {
+	o, err := offset(len(p), len(pk.G1)-1)
+	if err != nil {
+		return Digest{}, err
+	}
+
+	var res bls24315.G1Affine
+
+	config := ecc.MultiExpConfig{}
+	if len(nbTasks) > 0 {
+		config.NbTasks = nbTasks[0]
+	}
+	if _, err := res.MultiExp(pk.G1[o], p, config); err != nil {
+		return Digest{}, err
+	}
+
+	return res, nil
+}
+
+// Open computes an opening proof of the multilinear polynomial p at the given point.
+// p is not modified.
+func Open(p polynomial.MultiLin, point []fr.Element, pk ProvingKey) (OpeningProof, error) {
+	o, err := offset(len(p), len(pk.G1)-1)
+	if err != nil {
+		return OpeningProof{}, err
+	}
+	nbVars := p.NumVars()
+	if len(point) != nbVars {
+		return OpeningProof{}, ErrInvalidPointSize
+	}
+
+	res := OpeningProof{
+		Quotients: make([]bls24315.G1Affine, nbVars),
+	}
+
+	// fᵢ = fᵢ₋₁(zᵢ, Xᵢ₊₁, ..., Xₙ); writing fᵢ₋₁ = (1-Xᵢ)L + XᵢH we have
+	// fᵢ₋₁ - fᵢ = (Xᵢ - zᵢ)(H - L), so qᵢ = H - L.
+	f := p.Clone()
+	q := make([]fr.Element, len(p)/2)
+	for i := 0; i < nbVars; i++ {
+		mid := len(f) / 2
+		q = q[:mid]
+		for j := 0; j < mid; j++ {
+			q[j].Sub(&f[mid+j], &f[j])
+		}
+		if res.Quotients[i], err = Commit(q, ProvingKey{G1: pk.G1[o+i+1:]}); err != nil {
+			return OpeningProof{}, err
+		}
+		f.Fold(point[i])
+	}
+	res.ClaimedValue = f[0]
+
+	return res, nil
+}
+
+// Verify verifies a PST opening proof at a single point; the number of variables of the committed
+// polynomial is the number of coordinates of point.
+func Verify(commitment *Digest, proof *OpeningProof, point []fr.Element, vk VerifyingKey) error {
+	nbVars := len(point)
+	if len(proof.Quotients) != nbVars {
+		return ErrInvalidProofSize
+	}
+	if nbVars > len(vk.Tau) {
+		return ErrInvalidPointSize
+	}
+	o := len(vk.Tau) - nbVars
+
+	// f(τ) - f(z) = ∑ᵢ (τᵢ - zᵢ)qᵢ(τ), that is
+	// e([f(τ) - f(z) + ∑ᵢzᵢqᵢ(τ)]G₁, G₂)⋅∏ᵢe([-qᵢ(τ)]G₁, [τᵢ]G₂) == 1
+	points := make([]bls24315.G1Affine, nbVars+1)
+	scalars := make([]fr.Element, nbVars+1)
+	copy(points, proof.Quotients)
+	copy(scalars, point)
+	points[nbVars] = vk.G1
+	scalars[nbVars].Neg(&proof.ClaimedValue)
+
+	var left bls24315.G1Affine
+	if _, err := left.MultiExp(points, scalars, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+	left.Add(&left, commitment)
+
+	P := make([]bls24315.G1Affine, nbVars+1)
+	Q := make([]bls24315.G2Affine, nbVars+1)
+	P[0], Q[0] = left, vk.G2
+	for i := 0; i < nbVars; i++ {
+		P[i+1].Neg(&proof.Quotients[i])
+		Q[i+1] = vk.Tau[o+i]
+	}
+
+	check, err := bls24315.PairingCheck(P, Q)
+	if err != nil {
+		return err
+	}
+	if !check {
+		return ErrVerifyOpeningProof
+	}
+	return nil
+}
+
+// BatchOpenSinglePoint creates a batch opening proof at point of a list of multilinear polynomials.
+// It's an interactive protocol, made non-interactive using Fiat Shamir.
+//
+// * point is the point at which the polynomials are opened.
+// * digests is the list of committed polynomials to open, need to derive the challenge using Fiat Shamir.
+// * polynomials is the list of polynomials to open, they must have the same number of variables.
+// * dataTranscript extra data that might be needed to derive the challenge used for folding
+func BatchOpenSinglePoint(polynomials []polynomial.MultiLin, digests []Digest, point []fr.Element, hf hash.Hash, pk ProvingKey, dataTranscript ...[]byte) (BatchOpeningProof, error) {
+	nbDigests := len(digests)
+	if nbDigests != len(polynomials) {
+		return BatchOpeningProof{}, ErrInvalidNbDigests
+	}
+	if nbDigests == 0 {
+		return BatchOpeningProof{}, ErrZeroNbDigests
+	}
+	for _, p := range polynomials {
+		if len(p) != len(polynomials[0]) {
+			return BatchOpeningProof{}, ErrInvalidPolynomialSize
+		}
+	}
+	if len(polynomials[0]) != 1<<len(point) {
+		return BatchOpeningProof{}, ErrInvalidPointSize
+	}
+
+	var res BatchOpeningProof
+
+	// compute the purported values
+	res.ClaimedValues = make([]fr.Element, nbDigests)
+	for i := range polynomials {
+		res.ClaimedValues[i] = polynomials[i].Evaluate(point, nil)
+	}
+
+	// derive the challenge γ, binded to the point and the commitments
+	gamma, err := deriveGamma(point, digests, res.ClaimedValues, hf, dataTranscript...)
+	if err != nil {
+		return BatchOpeningProof{}, err
+	}
+
+	// ∑ᵢγⁱfᵢ
+	folded := make(polynomial.MultiLin, len(polynomials[0]))
+	for i := nbDigests - 1; i >= 0; i-- {
+		for j := range folded {
+			folded[j].Mul(&folded[j], &gamma).Add(&folded[j], &polynomials[i][j])
+		}
+	}
+
+	proof, err := Open(folded, point, pk)
+	if err != nil {
+		return BatchOpeningProof{}, err
+	}
+	res.Quotients = proof.Quotients
+
+	return res, nil
+}
+
+// FoldProof fold the digests and the proofs in batchOpeningProof using Fiat Shamir
+// to obtain an opening proof at a single point.
+//
+// * digests list of digests on which batchOpeningProof is based
+// * batchOpeningProof opening proof of digests
+// * transcript extra data needed to derive the challenge used for folding.
+// * returns the folded version of batchOpeningProof, Digest, the folded version of digests
+func FoldProof(digests []Digest, batchOpeningProof *BatchOpeningProof, point []fr.Element, hf hash.Hash, dataTranscript ...[]byte) (OpeningProof, Digest, error) {
+	nbDigests := len(digests)
+	if nbDigests != len(batchOpeningProof.ClaimedValues) {
+		return OpeningProof{}, Digest{}, ErrInvalidNbDigests
+	}
+	if nbDigests == 0 {
+		return OpeningProof{}, Digest{}, ErrZeroNbDigests
+	}
+
+	gamma, err := deriveGamma(point, digests, batchOpeningProof.ClaimedValues, hf, dataTranscript...)
+	if err != nil {
+		return OpeningProof{}, Digest{}, err
+	}
+
+	// gammai = [1,γ,γ²,..,γⁿ⁻¹]
+	gammai := make([]fr.Element, nbDigests)
+	gammai[0].SetOne()
+	for i := 1; i < nbDigests; i++ {
+		gammai[i].Mul(&gammai[i-1], &gamma)
+	}
+
+	var res OpeningProof
+	var foldedDigest Digest
+	if _, err := foldedDigest.MultiExp(digests, gammai, ecc.MultiExpConfig{}); err != nil {
+		return OpeningProof{}, Digest{}, err
+	}
+	var tmp fr.Element
+	for i := range gammai {
+		tmp.Mul(&gammai[i], &batchOpeningProof.ClaimedValues[i])
+		res.ClaimedValue.Add(&res.ClaimedValue, &tmp)
+	}
+	res.Quotients = batchOpeningProof.Quotients
+
+	return res, foldedDigest, nil
+}
+
+// BatchVerifySinglePoint verifies a batched opening proof at a single point of a list of multilinear polynomials.
+//
+// * digests list of digests on which opening proof is done
+// * batchOpeningProof proof of correct opening on the digests
+// * dataTranscript extra data that might be needed to derive the challenge used for the folding
+func BatchVerifySinglePoint(digests []Digest, batchOpeningProof *BatchOpeningProof, point []fr.Element, hf hash.Hash, vk VerifyingKey, dataTranscript ...[]byte) error {
+	foldedProof, foldedDigest, err := FoldProof(digests, batchOpeningProof, point, hf, dataTranscript...)
+	if err != nil {
+		return err
+	}
+	return Verify(&foldedDigest, &foldedProof, point, vk)
+}
+
+// deriveGamma derives a challenge using Fiat Shamir to fold proofs.
+func deriveGamma(point []fr.Element, digests []Digest, claimedValues []fr.Element, hf hash.Hash, dataTranscript ...[]byte) (fr.Element, error) {
+
+	// derive the challenge gamma, binded to the point and the commitments
+	fs := fiatshamir.NewTranscript(hf, "gamma")
+	for i := range point {
+		if err := fs.Bind("gamma", point[i].Marshal()); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	for i := range digests {
+		if err := fs.Bind("gamma", digests[i].Marshal()); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	for i := range claimedValues {
+		if err := fs.Bind("gamma", claimedValues[i].Marshal()); err != nil {
+			return fr.Element{}, err
+		}
+	}
+
+	for i := 0; i < len(dataTranscript); i++ {
+		if err := fs.Bind("gamma", dataTranscript[i]); err != nil {
+			return fr.Element{}, err
+		}
+	}
+
+	gammaByte, err := fs.ComputeChallenge("gamma")
+	if err != nil {
+		return fr.Element{}, err
+	}
+	var gamma fr.Element
+	gamma.SetBytes(gammaByte)
+
+	return gamma, nil
+}
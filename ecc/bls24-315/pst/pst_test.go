@@ -0,0 +1,172 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package pst
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-315"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr/polynomial"
+	"github.com/stretchr/testify/require"
+)
+
+// Test SRS re-used across tests of the PST scheme
+var testSrs *SRS
+var testTau []fr.Element
+
+const testNbVars = 6
+
+func init() {
+	testTau = make([]fr.Element, testNbVars)
+	bTau := make([]*big.Int, testNbVars)
+	for i := range bTau {
+		testTau[i].SetUint64(uint64(42 + i))
+		bTau[i] = new(big.Int).SetUint64(uint64(42 + i))
+	}
+	testSrs, _ = NewSRS(testNbVars, bTau)
+}
+
+func randomMultiLin(nbVars int) polynomial.MultiLin {
+	m := make(polynomial.MultiLin, 1<<nbVars)
+	for i := range m {
+		m[i].SetRandom()
+	}
+	return m
+}
+
+func randomPoint(nbVars int) []fr.Element {
+	p := make([]fr.Element, nbVars)
+	for i := range p {
+		p[i].SetRandom()
+	}
+	return p
+}
+
+func TestCommit(t *testing.T) {
+	assert := require.New(t)
+
+	// [f(τ)]G₁, for a polynomial in the last nbVars variables
+	for _, nbVars := range []int{0, 3, testNbVars} {
+		f := randomMultiLin(nbVars)
+		digest, err := Commit(f, testSrs.Pk)
+		assert.NoError(err)
+
+		v := f.Evaluate(testTau[testNbVars-nbVars:], nil)
+		var expected bls24315.G1Affine
+		var bv big.Int
+		expected.ScalarMultiplication(&testSrs.Vk.G1, v.BigInt(&bv))
+		assert.True(expected.Equal(&digest), "%d variables", nbVars)
+	}
+
+	_, err := Commit(randomMultiLin(testNbVars+1), testSrs.Pk)
+	assert.ErrorIs(err, ErrInvalidPolynomialSize)
+	_, err = Commit(make(polynomial.MultiLin, 3), testSrs.Pk)
+	assert.ErrorIs(err, ErrInvalidPolynomialSize)
+}
+
+func TestOpenVerify(t *testing.T) {
+	assert := require.New(t)
+
+	for _, nbVars := range []int{0, 1, 4, testNbVars} {
+		f := randomMultiLin(nbVars)
+		digest, err := Commit(f, testSrs.Pk)
+		assert.NoError(err)
+
+		point := randomPoint(nbVars)
+		proof, err := Open(f, point, testSrs.Pk)
+		assert.NoError(err)
+
+		expected := f.Evaluate(point, nil)
+		assert.True(expected.Equal(&proof.ClaimedValue))
+		assert.NoError(Verify(&digest, &proof, point, testSrs.Vk), "%d variables", nbVars)
+
+		// wrong claimed value
+		wrongProof := proof
+		wrongProof.ClaimedValue.Double(&wrongProof.ClaimedValue)
+		if !wrongProof.ClaimedValue.Equal(&proof.ClaimedValue) {
+			assert.ErrorIs(Verify(&digest, &wrongProof, point, testSrs.Vk), ErrVerifyOpeningProof)
+		}
+
+		// wrong point
+		if nbVars > 0 {
+			wrongPoint := randomPoint(nbVars)
+			assert.ErrorIs(Verify(&digest, &proof, wrongPoint, testSrs.Vk), ErrVerifyOpeningProof)
+		}
+	}
+
+	_, err := Open(randomMultiLin(3), randomPoint(2), testSrs.Pk)
+	assert.ErrorIs(err, ErrInvalidPointSize)
+}
+
+func TestBatchOpenVerify(t *testing.T) {
+	assert := require.New(t)
+
+	const nbPolynomials = 5
+	const nbVars = 5
+	polynomials := make([]polynomial.MultiLin, nbPolynomials)
+	digests := make([]Digest, nbPolynomials)
+	for i := range polynomials {
+		polynomials[i] = randomMultiLin(nbVars)
+		var err error
+		digests[i], err = Commit(polynomials[i], testSrs.Pk)
+		assert.NoError(err)
+	}
+	point := randomPoint(nbVars)
+
+	hf := sha256.New()
+	proof, err := BatchOpenSinglePoint(polynomials, digests, point, hf, testSrs.Pk, []byte("data"))
+	assert.NoError(err)
+	for i := range polynomials {
+		e := polynomials[i].Evaluate(point, nil)
+		assert.True(e.Equal(&proof.ClaimedValues[i]))
+	}
+
+	hf.Reset()
+	assert.NoError(BatchVerifySinglePoint(digests, &proof, point, hf, testSrs.Vk, []byte("data")))
+
+	// wrong transcript
+	hf.Reset()
+	assert.Error(BatchVerifySinglePoint(digests, &proof, point, hf, testSrs.Vk, []byte("other data")))
+
+	// wrong claimed value
+	hf.Reset()
+	proof.ClaimedValues[1].SetRandom()
+	assert.Error(BatchVerifySinglePoint(digests, &proof, point, hf, testSrs.Vk, []byte("data")))
+}
+
+func BenchmarkOpen(b *testing.B) {
+	f := randomMultiLin(testNbVars)
+	point := randomPoint(testNbVars)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Open(f, point, testSrs.Pk)
+	}
+}
+
+func BenchmarkVerify(b *testing.B) {
+	f := randomMultiLin(testNbVars)
+	point := randomPoint(testNbVars)
+	digest, _ := Commit(f, testSrs.Pk)
+	proof, _ := Open(f, point, testSrs.Pk)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Verify(&digest, &proof, point, testSrs.Vk)
+	}
+}
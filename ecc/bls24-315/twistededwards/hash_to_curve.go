@@ -0,0 +1,174 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package twistededwards
+
+import (
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+)
+
+// Suite identifiers of the hash-to-curve constructions, following
+// https://www.rfc-editor.org/rfc/rfc9380.html#name-suite-id-naming-conventions:
+// expand_message_xmd with SHA-256, the Elligator 2 map and a cofactor clearing by 8.
+// They are meant to be a suffix of the domain separation tags of the applications.
+const (
+	HashToCurveSuiteID   = "BLS24315TE_XMD:SHA-256_ELL2_RO_"
+	EncodeToCurveSuiteID = "BLS24315TE_XMD:SHA-256_ELL2_NU_"
+)
+
+// elligatorParams constants of the Elligator 2 map on the Montgomery form K⋅t² = s³ + J⋅s² + s
+// of the curve, with J = 2⋅(a + d) / (a - d) and K = 4 / (a - d)
+type elligatorParams struct {
+	k      fr.Element
+	c1, c2 fr.Element // J/K and 1/K²
+	z      fr.Element // non-square, the first one in 1, -1, 2, -2, ... (RFC 9380, appendix H.3)
+}
+
+var (
+	elligatorOnce sync.Once
+	elligator     elligatorParams
+)
+
+func initElligatorParams() {
+	params := GetEdwardsCurve()
+	var j, aMinusD, t fr.Element
+	aMinusD.Sub(&params.A, &params.D)
+	j.Add(&params.A, &params.D).Double(&j).Div(&j, &aMinusD)
+	elligator.k.SetUint64(4).Div(&elligator.k, &aMinusD)
+	elligator.c1.Div(&j, &elligator.k)
+	elligator.c2.Square(&elligator.k).Inverse(&elligator.c2)
+
+	for ctr := uint64(1); ; ctr++ {
+		t.SetUint64(ctr)
+		if t.Legendre() == -1 {
+			elligator.z = t
+			return
+		}
+		if t.Neg(&t).Legendre() == -1 {
+			elligator.z = t
+			return
+		}
+	}
+}
+
+// EncodeToCurve hashes a message to a point of the prime order subgroup using the Elligator 2 map.
+// It is faster than HashToCurve, but the result is not uniformly distributed. Unsuitable as a random oracle.
+// dst stands for "domain separation tag", a string unique to the construction using the hash function
+// https://www.rfc-editor.org/rfc/rfc9380.html#name-encoding-byte-strings-to-el
+func EncodeToCurve(msg, dst []byte) (PointAffine, error) {
+	u, err := fr.Hash(msg, dst, 1)
+	if err != nil {
+		return PointAffine{}, err
+	}
+
+	q := MapToCurve(&u[0])
+	var p PointExtended
+	p.FromAffine(&q)
+	clearCofactor(&p)
+
+	var res PointAffine
+	res.FromExtended(&p)
+	return res, nil
+}
+
+// HashToCurve hashes a message to a point of the prime order subgroup using the Elligator 2 map.
+// Slower than EncodeToCurve, but usable as a random oracle.
+// dst stands for "domain separation tag", a string unique to the construction using the hash function
+// https://www.rfc-editor.org/rfc/rfc9380.html#name-encoding-byte-strings-to-el
+func HashToCurve(msg, dst []byte) (PointAffine, error) {
+	u, err := fr.Hash(msg, dst, 2)
+	if err != nil {
+		return PointAffine{}, err
+	}
+
+	q0 := MapToCurve(&u[0])
+	q1 := MapToCurve(&u[1])
+	var p0, p1 PointExtended
+	p0.FromAffine(&q0)
+	p1.FromAffine(&q1)
+	p0.Add(&p0, &p1)
+	clearCofactor(&p0)
+
+	var res PointAffine
+	res.FromExtended(&p0)
+	return res, nil
+}
+
+// MapToCurve maps u to a point of the curve, not necessarily in the prime order subgroup, with the
+// Elligator 2 map on the Montgomery form of the curve followed by the rational map to the twisted
+// Edwards form.
+// https://www.rfc-editor.org/rfc/rfc9380.html#name-elligator-2-method-2
+func MapToCurve(u *fr.Element) PointAffine {
+	elligatorOnce.Do(initElligatorParams)
+	e := &elligator
+
+	// x₁ = -(J/K) / (1 + Z⋅u²), or -(J/K) if the denominator is zero
+	var one, x1, x2, gx, tv fr.Element
+	one.SetOne()
+	tv.Square(u).Mul(&tv, &e.z).Add(&tv, &one)
+	if tv.IsZero() {
+		x1.Neg(&e.c1)
+	} else {
+		x1.Div(&e.c1, &tv).Neg(&x1)
+	}
+
+	// g(x) = x³ + (J/K)⋅x² + x/K²: if g(x₁) is a square, x = x₁ and sgn0(y) = 1,
+	// otherwise x = x₂ = -x₁ - J/K and sgn0(y) = 0
+	x, sign := x1, uint64(1)
+	elligatorG(&gx, &x1, e)
+	var y fr.Element
+	if y.Sqrt(&gx) == nil {
+		x2.Add(&x1, &e.c1).Neg(&x2)
+		x, sign = x2, 0
+		elligatorG(&gx, &x2, e)
+		y.Sqrt(&gx)
+	}
+	if y.Bits()[0]&1 != sign {
+		y.Neg(&y)
+	}
+
+	// (s, t) = (K⋅x, K⋅y) on the Montgomery curve, mapped to (s/t, (s - 1)/(s + 1)), or to the
+	// identity if a denominator is zero
+	// https://www.rfc-editor.org/rfc/rfc9380.html#name-rational-maps-from-montgome
+	var s, t, sPlusOne, den fr.Element
+	s.Mul(&x, &e.k)
+	t.Mul(&y, &e.k)
+	sPlusOne.Add(&s, &one)
+	den.Mul(&sPlusOne, &t)
+	if den.IsZero() {
+		return NewPointAffine(fr.Element{}, one)
+	}
+	den.Inverse(&den)
+
+	var res PointAffine
+	res.X.Mul(&den, &sPlusOne).Mul(&res.X, &s)
+	res.Y.Mul(&den, &t).Mul(&res.Y, s.Sub(&s, &one))
+	return res
+}
+
+// elligatorG sets res to x³ + (J/K)⋅x² + x/K²
+func elligatorG(res, x *fr.Element, e *elligatorParams) {
+	var t fr.Element
+	t.Add(x, &e.c1).Mul(&t, x).Add(&t, &e.c2)
+	res.Mul(&t, x)
+}
+
+// clearCofactor sets p to 8⋅p, in the prime order subgroup
+func clearCofactor(p *PointExtended) {
+	p.Double(p).Double(p).Double(p)
+}
@@ -0,0 +1,27 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package zeromorph provides the Zeromorph commitment scheme for multilinear polynomials
+// (Kohrita and Towa, https://eprint.iacr.org/2023/917), on top of the univariate KZG of the kzg package.
+//
+// A multilinear polynomial f in n variables, given by its evaluations on the hypercube (polynomial.MultiLin),
+// is committed as the univariate polynomial Uₙ(f) = ∑ᵢ f[i]Xⁱ; an opening at u ∈ 𝔽ⁿ is proven
+// with n+2 elements of G₁ and verified with 2 pairings, using any KZG SRS.
+//
+// The degree checks of the quotients are enforced by the size N_max of the SRS: the quotients are
+// batched as ∑ₖ yᵏX^(N_max-2ᵏ)q̂ₖ, which can't be committed unless deg(q̂ₖ) < 2ᵏ for all k. The SRS
+// may be larger than 2ⁿ, the verifier gets N_max in the VerifyingKey (see NewVerifyingKey).
+package zeromorph
@@ -0,0 +1,241 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ecdsa
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"math/big"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+	"golang.org/x/crypto/cryptobyte"
+	cbasn1 "golang.org/x/crypto/cryptobyte/asn1"
+)
+
+func TestDERSignature(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("[BLS24-317] DER signatures should verify, and match the encoding of crypto/ecdsa", prop.ForAll(
+		func() bool {
+			privKey, _ := GenerateKey(rand.Reader)
+			msg := []byte("testing ECDSA")
+			sigDER, err := privKey.SignASN1(msg, sha256.New())
+			if err != nil {
+				return false
+			}
+			flag, err := privKey.PublicKey.VerifyASN1(sigDER, msg, sha256.New())
+			if err != nil || !flag {
+				return false
+			}
+
+			// crypto/ecdsa encodes the signatures with cryptobyte
+			var sig Signature
+			if err = sig.UnmarshalASN1(sigDER); err != nil {
+				return false
+			}
+			var b cryptobyte.Builder
+			b.AddASN1(cbasn1.SEQUENCE, func(b *cryptobyte.Builder) {
+				b.AddASN1BigInt(new(big.Int).SetBytes(sig.R[:]))
+				b.AddASN1BigInt(new(big.Int).SetBytes(sig.S[:]))
+			})
+			expected, err := b.Bytes()
+			return err == nil && bytes.Equal(sigDER, expected)
+		},
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	t.Run("trailing_data", func(t *testing.T) {
+		privKey, _ := GenerateKey(rand.Reader)
+		sigDER, _ := privKey.SignASN1([]byte("testing ECDSA"), nil)
+		var sig Signature
+		if err := sig.UnmarshalASN1(append(sigDER, 0)); err != errTrailingData {
+			t.Fatal("should raise trailing data error")
+		}
+	})
+
+	t.Run("S_overflow", func(t *testing.T) {
+		sigDER, _ := asn1.Marshal(ecdsaSignature{R: big.NewInt(1), S: order})
+		var sig Signature
+		if err := sig.UnmarshalASN1(sigDER); err != errSBiggerThanRMod {
+			t.Fatal("should raise error s >= r_mod")
+		}
+	})
+
+	t.Run("R_negative", func(t *testing.T) {
+		sigDER, _ := asn1.Marshal(ecdsaSignature{R: big.NewInt(-1), S: big.NewInt(1)})
+		var sig Signature
+		if err := sig.UnmarshalASN1(sigDER); err != errZero {
+			t.Fatal("should raise error r <= 0")
+		}
+	})
+}
+
+func TestDERKeys(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("[BLS24-317] SEC 1 and PKCS #8 private keys: Parse(Marshal()) should stay the same", prop.ForAll(
+		func() bool {
+			privKey, _ := GenerateKey(rand.Reader)
+
+			der, err := MarshalECPrivateKey(privKey)
+			if err != nil {
+				return false
+			}
+			sec1, err := ParseECPrivateKey(der)
+			if err != nil {
+				return false
+			}
+
+			der, err = MarshalPKCS8PrivateKey(privKey)
+			if err != nil {
+				return false
+			}
+			p8, err := ParsePKCS8PrivateKey(der)
+			if err != nil {
+				return false
+			}
+
+			data, err := MarshalPEMPrivateKey(privKey)
+			if err != nil {
+				return false
+			}
+			fromPEM, err := ParsePEMPrivateKey(data)
+			if err != nil {
+				return false
+			}
+
+			return bytes.Equal(sec1.Bytes(), privKey.Bytes()) &&
+				bytes.Equal(p8.Bytes(), privKey.Bytes()) &&
+				bytes.Equal(fromPEM.Bytes(), privKey.Bytes())
+		},
+	))
+
+	properties.Property("[BLS24-317] SubjectPublicKeyInfo: Parse(Marshal()) should stay the same", prop.ForAll(
+		func() bool {
+			privKey, _ := GenerateKey(rand.Reader)
+
+			der, err := MarshalPKIXPublicKey(&privKey.PublicKey)
+			if err != nil {
+				return false
+			}
+			pub, err := ParsePKIXPublicKey(der)
+			if err != nil {
+				return false
+			}
+
+			data, err := MarshalPEMPublicKey(&privKey.PublicKey)
+			if err != nil {
+				return false
+			}
+			fromPEM, err := ParsePEMPublicKey(data)
+			if err != nil {
+				return false
+			}
+
+			return pub.Equal(&privKey.PublicKey) && fromPEM.Equal(&privKey.PublicKey)
+		},
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	privKey, _ := GenerateKey(rand.Reader)
+	otherKey, _ := GenerateKey(rand.Reader)
+
+	t.Run("wrong_public_key", func(t *testing.T) {
+		wrongKey := *privKey
+		wrongKey.PublicKey = otherKey.PublicKey
+		der, _ := MarshalECPrivateKey(&wrongKey)
+		if _, err := ParseECPrivateKey(der); err != errWrongPublicKey {
+			t.Fatal("should raise wrong public key error")
+		}
+	})
+
+	t.Run("invalid_point", func(t *testing.T) {
+		der, _ := MarshalPKIXPublicKey(&privKey.PublicKey)
+		// the last byte of y
+		der[len(der)-1] ^= 1
+		if _, err := ParsePKIXPublicKey(der); err != errInvalidPoint {
+			t.Fatal("should raise invalid point error")
+		}
+	})
+
+	t.Run("unknown_curve", func(t *testing.T) {
+		domainOnce.Do(initDomain)
+		params := domain
+		params.Order = new(big.Int).Add(order, big.NewInt(2))
+		paramsDER, _ := asn1.Marshal(params)
+		der, _ := asn1.Marshal(publicKeyInfo{
+			Algorithm: pkixAlgorithm(paramsDER),
+			PublicKey: asn1.BitString{Bytes: marshalPoint(&privKey.PublicKey.A), BitLength: 8 * (1 + 2*sizeFp)},
+		})
+		if _, err := ParsePKIXPublicKey(der); err != errUnknownCurve {
+			t.Fatal("should raise unknown curve error")
+		}
+
+		// the explicit parameters of the curve are accepted
+		params.Order = order
+		params.Cofactor = big.NewInt(1)
+		paramsDER, _ = asn1.Marshal(params)
+		der, _ = asn1.Marshal(publicKeyInfo{
+			Algorithm: pkixAlgorithm(paramsDER),
+			PublicKey: asn1.BitString{Bytes: marshalPoint(&privKey.PublicKey.A), BitLength: 8 * (1 + 2*sizeFp)},
+		})
+		if _, err := ParsePKIXPublicKey(der); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("no_pem_block", func(t *testing.T) {
+		data := pem.EncodeToMemory(&pem.Block{Type: "EC PARAMETERS", Bytes: []byte{0}})
+		if _, err := ParsePEMPrivateKey(data); err != errNoPEMBlock {
+			t.Fatal("should raise no PEM block error")
+		}
+		if _, err := ParsePEMPublicKey(data); err != errNoPEMBlock {
+			t.Fatal("should raise no PEM block error")
+		}
+	})
+}
+
+func pkixAlgorithm(paramsDER []byte) pkix.AlgorithmIdentifier {
+	return pkix.AlgorithmIdentifier{
+		Algorithm:  oidPublicKeyECDSA,
+		Parameters: asn1.RawValue{FullBytes: paramsDER},
+	}
+}
@@ -25,4 +25,7 @@
 // - Wikipedia: https://en.wikipedia.org/wiki/Elliptic_Curve_Digital_Signature_Algorithm
 // - FIPS 186-4: https://nvlpubs.nist.gov/nistpubs/FIPS/NIST.FIPS.186-4.pdf
 // - SEC 1, v-2: https://www.secg.org/sec1-v2.pdf
+// - RFC 5915 (SEC 1 private keys): https://www.rfc-editor.org/rfc/rfc5915
+// - RFC 5480 (SubjectPublicKeyInfo): https://www.rfc-editor.org/rfc/rfc5480
+// - RFC 5208 (PKCS #8): https://www.rfc-editor.org/rfc/rfc5208
 package ecdsa
@@ -0,0 +1,138 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"math/bits"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr/extensions"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFFTE2(t *testing.T) {
+	assert := require.New(t)
+
+	const n = 64
+	domain := NewDomain(n)
+	rev := func(i int) int {
+		return int(bits.Reverse64(uint64(i)) >> (64 - 6))
+	}
+
+	pol := make(extensions.PolynomialE2, n)
+	for i := range pol {
+		pol[i].SetRandom()
+	}
+	backup := pol.Clone()
+
+	// evaluations, in bit-reversed order
+	domain.FFTE2(pol, DIF)
+	var x fr.Element
+	x.SetOne()
+	for i := 0; i < n; i++ {
+		e := backup.EvalBase(&x)
+		assert.True(e.Equal(&pol[rev(i)]), "evaluation %d", i)
+		x.Mul(&x, &domain.Generator)
+	}
+
+	// inverse FFT
+	domain.FFTInverseE2(pol, DIT)
+	assert.True(backup.Equal(pol))
+
+	// evaluations on the coset
+	domain.FFTE2(pol, DIF, OnCoset(), WithNbTasks(2))
+	x.Set(&domain.FrMultiplicativeGen)
+	for i := 0; i < n; i++ {
+		e := backup.EvalBase(&x)
+		assert.True(e.Equal(&pol[rev(i)]), "coset evaluation %d", i)
+		x.Mul(&x, &domain.Generator)
+	}
+
+	domain.FFTInverseE2(pol, DIT, OnCoset())
+	assert.True(backup.Equal(pol))
+}
+
+func BenchmarkFFTE2(b *testing.B) {
+	const n = 1 << 16
+	domain := NewDomain(n)
+	pol := make([]extensions.E2, n)
+	for i := range pol {
+		pol[i].SetRandom()
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		domain.FFTE2(pol, DIF)
+	}
+}
+
+func TestFFTE4(t *testing.T) {
+	assert := require.New(t)
+
+	const n = 64
+	domain := NewDomain(n)
+	rev := func(i int) int {
+		return int(bits.Reverse64(uint64(i)) >> (64 - 6))
+	}
+
+	pol := make(extensions.PolynomialE4, n)
+	for i := range pol {
+		pol[i].SetRandom()
+	}
+	backup := pol.Clone()
+
+	// evaluations, in bit-reversed order
+	domain.FFTE4(pol, DIF)
+	var x fr.Element
+	x.SetOne()
+	for i := 0; i < n; i++ {
+		e := backup.EvalBase(&x)
+		assert.True(e.Equal(&pol[rev(i)]), "evaluation %d", i)
+		x.Mul(&x, &domain.Generator)
+	}
+
+	// inverse FFT
+	domain.FFTInverseE4(pol, DIT)
+	assert.True(backup.Equal(pol))
+
+	// evaluations on the coset
+	domain.FFTE4(pol, DIF, OnCoset(), WithNbTasks(2))
+	x.Set(&domain.FrMultiplicativeGen)
+	for i := 0; i < n; i++ {
+		e := backup.EvalBase(&x)
+		assert.True(e.Equal(&pol[rev(i)]), "coset evaluation %d", i)
+		x.Mul(&x, &domain.Generator)
+	}
+
+	domain.FFTInverseE4(pol, DIT, OnCoset())
+	assert.True(backup.Equal(pol))
+}
+
+func BenchmarkFFTE4(b *testing.B) {
+	const n = 1 << 16
+	domain := NewDomain(n)
+	pol := make([]extensions.E4, n)
+	for i := range pol {
+		pol[i].SetRandom()
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		domain.FFTE4(pol, DIF)
+	}
+}
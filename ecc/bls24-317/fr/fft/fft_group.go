@@ -0,0 +1,384 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"math/big"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+
+	curve "github.com/consensys/gnark-crypto/ecc/bls24-317"
+)
+
+// FFTG1 computes the discrete Fourier transform of a, a vector of points of G1, and stores the result in a:
+// the output is [∑ⱼ ωⁱʲ]a[j] for i < n (or [∑ⱼ (g⋅ωⁱ)ʲ]a[j] on the coset g⋅⟨ω⟩).
+// The decimation and the options have the same meaning as in FFT; the domain cardinality must be a power of 2.
+//
+// This is used, for instance, to convert a SRS in G1 to the Lagrange basis.
+func (domain *Domain) FFTG1(a []curve.G1Jac, decimation Decimation, opts ...Option) {
+	opt := fftOptions(opts...)
+	domain.checkGroupFFT(len(a))
+
+	if opt.coset {
+		domain.scaleG1(a, domain.FrMultiplicativeGen, nil, decimation == DIT, opt.nbTasks)
+	}
+
+	twiddles := groupTwiddles(domain.Generator, len(a)>>1, opt.nbTasks)
+	maxSplits := groupMaxSplits(opt.nbTasks)
+	switch decimation {
+	case DIF:
+		difFFTG1(a, twiddles, 0, maxSplits, nil, opt.nbTasks)
+	case DIT:
+		ditFFTG1(a, twiddles, 0, maxSplits, nil, opt.nbTasks)
+	default:
+		panic("not implemented")
+	}
+}
+
+// FFTInverseG1 computes the inverse discrete Fourier transform of a, a vector of points of G1,
+// and stores the result in a. See FFTG1 and FFTInverse.
+func (domain *Domain) FFTInverseG1(a []curve.G1Jac, decimation Decimation, opts ...Option) {
+	opt := fftOptions(opts...)
+	domain.checkGroupFFT(len(a))
+
+	twiddles := groupTwiddles(domain.GeneratorInv, len(a)>>1, opt.nbTasks)
+	maxSplits := groupMaxSplits(opt.nbTasks)
+	switch decimation {
+	case DIF:
+		difFFTG1(a, twiddles, 0, maxSplits, nil, opt.nbTasks)
+	case DIT:
+		ditFFTG1(a, twiddles, 0, maxSplits, nil, opt.nbTasks)
+	default:
+		panic("not implemented")
+	}
+
+	// scale by CardinalityInv, and by the inverse coset table; the output is in bit-reversed order for DIF
+	c := domain.FrMultiplicativeGenInv
+	if !opt.coset {
+		c.SetOne()
+	}
+	domain.scaleG1(a, c, &domain.CardinalityInv, decimation == DIF, opt.nbTasks)
+}
+
+// scaleG1 sets a[i] ← [cst⋅cⁱ]a[i] (cst = 1 if nil), or a[i] ← [cst⋅c^bitReverse(i)]a[i] if bitReversed is set
+func (domain *Domain) scaleG1(a []curve.G1Jac, c fr.Element, cst *fr.Element, bitReversed bool, nbTasks int) {
+	table := make([]fr.Element, len(a))
+	BuildExpTable(c, table)
+	if cst != nil {
+		parallel.Execute(len(table), func(start, end int) {
+			for i := start; i < end; i++ {
+				table[i].Mul(&table[i], cst)
+			}
+		}, nbTasks)
+	}
+	if bitReversed {
+		BitReverse(table)
+	}
+	parallel.Execute(len(a), func(start, end int) {
+		var s big.Int
+		for i := start; i < end; i++ {
+			table[i].BigInt(&s)
+			a[i].ScalarMultiplication(&a[i], &s)
+		}
+	}, nbTasks)
+}
+
+func butterflyG1(a *curve.G1Jac, b *curve.G1Jac) {
+	t := *a
+	a.AddAssign(b)
+	t.SubAssign(b)
+	b.Set(&t)
+}
+
+func difFFTG1(a []curve.G1Jac, twiddles []big.Int, stage, maxSplits int, chDone chan struct{}, nbTasks int) {
+	if chDone != nil {
+		defer close(chDone)
+	}
+
+	n := len(a)
+	if n == 1 {
+		return
+	}
+	m := n >> 1
+
+	// stage determines the stride in the twiddles: at stage s we use ω^(2ˢ⋅i)
+	stride := 1 << stage
+	butterflies := func(start, end int) {
+		for i := start; i < end; i++ {
+			butterflyG1(&a[i], &a[i+m])
+			if i != 0 {
+				a[i+m].ScalarMultiplication(&a[i+m], &twiddles[i*stride])
+			}
+		}
+	}
+	if m > butterflyThreshold && stage < maxSplits {
+		parallel.Execute(m, butterflies, nbTasks/(1<<stage)) // 1 << stage == estimated used CPUs
+	} else {
+		butterflies(0, m)
+	}
+
+	if m == 1 {
+		return
+	}
+
+	nextStage := stage + 1
+	if stage < maxSplits {
+		chDone := make(chan struct{}, 1)
+		go difFFTG1(a[m:n], twiddles, nextStage, maxSplits, chDone, nbTasks)
+		difFFTG1(a[0:m], twiddles, nextStage, maxSplits, nil, nbTasks)
+		<-chDone
+	} else {
+		difFFTG1(a[0:m], twiddles, nextStage, maxSplits, nil, nbTasks)
+		difFFTG1(a[m:n], twiddles, nextStage, maxSplits, nil, nbTasks)
+	}
+}
+
+func ditFFTG1(a []curve.G1Jac, twiddles []big.Int, stage, maxSplits int, chDone chan struct{}, nbTasks int) {
+	if chDone != nil {
+		defer close(chDone)
+	}
+
+	n := len(a)
+	if n == 1 {
+		return
+	}
+	m := n >> 1
+
+	nextStage := stage + 1
+	if stage < maxSplits {
+		chDone := make(chan struct{}, 1)
+		go ditFFTG1(a[m:n], twiddles, nextStage, maxSplits, chDone, nbTasks)
+		ditFFTG1(a[0:m], twiddles, nextStage, maxSplits, nil, nbTasks)
+		<-chDone
+	} else {
+		ditFFTG1(a[0:m], twiddles, nextStage, maxSplits, nil, nbTasks)
+		ditFFTG1(a[m:n], twiddles, nextStage, maxSplits, nil, nbTasks)
+	}
+
+	stride := 1 << stage
+	butterflies := func(start, end int) {
+		for i := start; i < end; i++ {
+			if i != 0 {
+				a[i+m].ScalarMultiplication(&a[i+m], &twiddles[i*stride])
+			}
+			butterflyG1(&a[i], &a[i+m])
+		}
+	}
+	if m > butterflyThreshold && stage < maxSplits {
+		parallel.Execute(m, butterflies, nbTasks/(1<<stage))
+	} else {
+		butterflies(0, m)
+	}
+}
+
+// FFTG2 computes the discrete Fourier transform of a, a vector of points of G2, and stores the result in a:
+// the output is [∑ⱼ ωⁱʲ]a[j] for i < n (or [∑ⱼ (g⋅ωⁱ)ʲ]a[j] on the coset g⋅⟨ω⟩).
+// The decimation and the options have the same meaning as in FFT; the domain cardinality must be a power of 2.
+//
+// This is used, for instance, to convert a SRS in G2 to the Lagrange basis.
+func (domain *Domain) FFTG2(a []curve.G2Jac, decimation Decimation, opts ...Option) {
+	opt := fftOptions(opts...)
+	domain.checkGroupFFT(len(a))
+
+	if opt.coset {
+		domain.scaleG2(a, domain.FrMultiplicativeGen, nil, decimation == DIT, opt.nbTasks)
+	}
+
+	twiddles := groupTwiddles(domain.Generator, len(a)>>1, opt.nbTasks)
+	maxSplits := groupMaxSplits(opt.nbTasks)
+	switch decimation {
+	case DIF:
+		difFFTG2(a, twiddles, 0, maxSplits, nil, opt.nbTasks)
+	case DIT:
+		ditFFTG2(a, twiddles, 0, maxSplits, nil, opt.nbTasks)
+	default:
+		panic("not implemented")
+	}
+}
+
+// FFTInverseG2 computes the inverse discrete Fourier transform of a, a vector of points of G2,
+// and stores the result in a. See FFTG2 and FFTInverse.
+func (domain *Domain) FFTInverseG2(a []curve.G2Jac, decimation Decimation, opts ...Option) {
+	opt := fftOptions(opts...)
+	domain.checkGroupFFT(len(a))
+
+	twiddles := groupTwiddles(domain.GeneratorInv, len(a)>>1, opt.nbTasks)
+	maxSplits := groupMaxSplits(opt.nbTasks)
+	switch decimation {
+	case DIF:
+		difFFTG2(a, twiddles, 0, maxSplits, nil, opt.nbTasks)
+	case DIT:
+		ditFFTG2(a, twiddles, 0, maxSplits, nil, opt.nbTasks)
+	default:
+		panic("not implemented")
+	}
+
+	// scale by CardinalityInv, and by the inverse coset table; the output is in bit-reversed order for DIF
+	c := domain.FrMultiplicativeGenInv
+	if !opt.coset {
+		c.SetOne()
+	}
+	domain.scaleG2(a, c, &domain.CardinalityInv, decimation == DIF, opt.nbTasks)
+}
+
+// scaleG2 sets a[i] ← [cst⋅cⁱ]a[i] (cst = 1 if nil), or a[i] ← [cst⋅c^bitReverse(i)]a[i] if bitReversed is set
+func (domain *Domain) scaleG2(a []curve.G2Jac, c fr.Element, cst *fr.Element, bitReversed bool, nbTasks int) {
+	table := make([]fr.Element, len(a))
+	BuildExpTable(c, table)
+	if cst != nil {
+		parallel.Execute(len(table), func(start, end int) {
+			for i := start; i < end; i++ {
+				table[i].Mul(&table[i], cst)
+			}
+		}, nbTasks)
+	}
+	if bitReversed {
+		BitReverse(table)
+	}
+	parallel.Execute(len(a), func(start, end int) {
+		var s big.Int
+		for i := start; i < end; i++ {
+			table[i].BigInt(&s)
+			a[i].ScalarMultiplication(&a[i], &s)
+		}
+	}, nbTasks)
+}
+
+func butterflyG2(a *curve.G2Jac, b *curve.G2Jac) {
+	t := *a
+	a.AddAssign(b)
+	t.SubAssign(b)
+	b.Set(&t)
+}
+
+func difFFTG2(a []curve.G2Jac, twiddles []big.Int, stage, maxSplits int, chDone chan struct{}, nbTasks int) {
+	if chDone != nil {
+		defer close(chDone)
+	}
+
+	n := len(a)
+	if n == 1 {
+		return
+	}
+	m := n >> 1
+
+	// stage determines the stride in the twiddles: at stage s we use ω^(2ˢ⋅i)
+	stride := 1 << stage
+	butterflies := func(start, end int) {
+		for i := start; i < end; i++ {
+			butterflyG2(&a[i], &a[i+m])
+			if i != 0 {
+				a[i+m].ScalarMultiplication(&a[i+m], &twiddles[i*stride])
+			}
+		}
+	}
+	if m > butterflyThreshold && stage < maxSplits {
+		parallel.Execute(m, butterflies, nbTasks/(1<<stage)) // 1 << stage == estimated used CPUs
+	} else {
+		butterflies(0, m)
+	}
+
+	if m == 1 {
+		return
+	}
+
+	nextStage := stage + 1
+	if stage < maxSplits {
+		chDone := make(chan struct{}, 1)
+		go difFFTG2(a[m:n], twiddles, nextStage, maxSplits, chDone, nbTasks)
+		difFFTG2(a[0:m], twiddles, nextStage, maxSplits, nil, nbTasks)
+		<-chDone
+	} else {
+		difFFTG2(a[0:m], twiddles, nextStage, maxSplits, nil, nbTasks)
+		difFFTG2(a[m:n], twiddles, nextStage, maxSplits, nil, nbTasks)
+	}
+}
+
+func ditFFTG2(a []curve.G2Jac, twiddles []big.Int, stage, maxSplits int, chDone chan struct{}, nbTasks int) {
+	if chDone != nil {
+		defer close(chDone)
+	}
+
+	n := len(a)
+	if n == 1 {
+		return
+	}
+	m := n >> 1
+
+	nextStage := stage + 1
+	if stage < maxSplits {
+		chDone := make(chan struct{}, 1)
+		go ditFFTG2(a[m:n], twiddles, nextStage, maxSplits, chDone, nbTasks)
+		ditFFTG2(a[0:m], twiddles, nextStage, maxSplits, nil, nbTasks)
+		<-chDone
+	} else {
+		ditFFTG2(a[0:m], twiddles, nextStage, maxSplits, nil, nbTasks)
+		ditFFTG2(a[m:n], twiddles, nextStage, maxSplits, nil, nbTasks)
+	}
+
+	stride := 1 << stage
+	butterflies := func(start, end int) {
+		for i := start; i < end; i++ {
+			if i != 0 {
+				a[i+m].ScalarMultiplication(&a[i+m], &twiddles[i*stride])
+			}
+			butterflyG2(&a[i], &a[i+m])
+		}
+	}
+	if m > butterflyThreshold && stage < maxSplits {
+		parallel.Execute(m, butterflies, nbTasks/(1<<stage))
+	} else {
+		butterflies(0, m)
+	}
+}
+
+// checkGroupFFT panics if the domain can't be used for a FFT over a group of size n
+func (domain *Domain) checkGroupFFT(n int) {
+	if domain.isMixedRadix() {
+		panic("fft: group FFTs are not supported on mixed radix domains")
+	}
+	if uint64(n) != domain.Cardinality {
+		panic("fft: len(a) must be equal to the cardinality of the domain")
+	}
+}
+
+// groupTwiddles returns [1, w, w², ..., wⁿ⁻¹] as big.Int, to be used as scalars
+func groupTwiddles(w fr.Element, n, nbTasks int) []big.Int {
+	res := make([]big.Int, n)
+	parallel.Execute(n, func(start, end int) {
+		var wi fr.Element
+		wi.Exp(w, big.NewInt(int64(start)))
+		for i := start; i < end; i++ {
+			wi.BigInt(&res[i])
+			wi.Mul(&wi, &w)
+		}
+	}, nbTasks)
+	return res
+}
+
+// groupMaxSplits returns the stage where we should stop spawning go routines in the recursive calls
+// (ie when we have as many go routines running as we have available CPUs)
+func groupMaxSplits(nbTasks int) int {
+	if nbTasks == 1 {
+		return -1
+	}
+	return bits.TrailingZeros64(ecc.NextPowerOfTwo(uint64(nbTasks)))
+}
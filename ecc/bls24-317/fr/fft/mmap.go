@@ -0,0 +1,82 @@
+//go:build unix
+// +build unix
+
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"errors"
+	"os"
+	"syscall"
+	"unsafe"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+)
+
+// MappedVector is a vector of fr.Element backed by a memory-mapped file, to run FFTs
+// (see FFTFourStep) on vectors larger than the available memory.
+//
+// The file holds the in-memory representation of the elements (Montgomery form, native endianness);
+// it is meant as a scratch space, not as a serialization format.
+type MappedVector struct {
+	// Data is the content of the file, modifications are written back to it
+	Data []fr.Element
+
+	file *os.File
+	mmap []byte
+}
+
+// NewMappedVector maps the file at path, created if needed, as a vector of n elements.
+// The file is resized to n⋅fr.Bytes bytes.
+func NewMappedVector(path string, n int) (*MappedVector, error) {
+	if n <= 0 {
+		return nil, errors.New("fft: the size of a mapped vector must be positive")
+	}
+	size := n * int(unsafe.Sizeof(fr.Element{}))
+
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	if err = f.Truncate(int64(size)); err != nil {
+		f.Close()
+		return nil, err
+	}
+	mmap, err := syscall.Mmap(int(f.Fd()), 0, size, syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_SHARED)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	return &MappedVector{
+		Data: unsafe.Slice((*fr.Element)(unsafe.Pointer(&mmap[0])), n),
+		file: f,
+		mmap: mmap,
+	}, nil
+}
+
+// Close unmaps the vector and closes the file; Data must not be used afterwards.
+func (v *MappedVector) Close() error {
+	v.Data = nil
+	errMunmap := syscall.Munmap(v.mmap)
+	errClose := v.file.Close()
+	if errMunmap != nil {
+		return errMunmap
+	}
+	return errClose
+}
@@ -0,0 +1,42 @@
+//go:build !unix
+// +build !unix
+
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"errors"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+)
+
+// MappedVector is a vector of fr.Element backed by a memory-mapped file;
+// memory-mapped files are only supported on unix platforms.
+type MappedVector struct {
+	Data []fr.Element
+}
+
+// NewMappedVector returns an error: memory-mapped files are only supported on unix platforms.
+func NewMappedVector(path string, n int) (*MappedVector, error) {
+	return nil, errors.New("fft: memory-mapped vectors are not supported on this platform")
+}
+
+// Close is a no-op.
+func (v *MappedVector) Close() error {
+	return nil
+}
@@ -19,6 +19,7 @@ package bls24317
 import (
 	"github.com/consensys/gnark-crypto/ecc/bls24-317/fp"
 	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
 	"github.com/leanovate/gopter/prop"
 	"math/rand"
 	"testing"
@@ -163,6 +164,19 @@ func TestMapToG1(t *testing.T) {
 		GenFp(),
 	))
 
+	properties.Property("[G1] encoding and hashing to curve should output points in the subgroup", prop.ForAll(
+		func(msg string) bool {
+			dst := []byte("BLS24-317_XMD:SHA-256_SSWU_TEST_")
+			p, err := EncodeToG1([]byte(msg), dst)
+			if err != nil || !p.IsInSubGroup() {
+				return false
+			}
+			q, err := HashToG1([]byte(msg), dst)
+			return err == nil && q.IsInSubGroup()
+		},
+		gen.AnyString(),
+	))
+
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
@@ -0,0 +1,80 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ipa
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	curve "github.com/consensys/gnark-crypto/ecc/bls24-317"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// dst domain separation tag used to derive the generators of the SRS
+const dst = "GNARK-CRYPTO-IPA-GENERATORS"
+
+var errGeneratorDerivation = errors.New("could not derive a generator from the seed")
+
+// deriveGenerator returns the i-th generator derived from seed, hashing to the curve
+func deriveGenerator(seed []byte, i uint64) (curve.G1Affine, error) {
+	p, err := curve.HashToG1(generatorMessage(seed, i), []byte(dst))
+	if err != nil {
+		return curve.G1Affine{}, err
+	}
+	if p.IsInfinity() {
+		return curve.G1Affine{}, errGeneratorDerivation
+	}
+	return p, nil
+}
+
+// multiExp computes ∑ᵢ scalars[i]⋅points[i]
+func multiExp(points []curve.G1Affine, scalars []fr.Element) (curve.G1Affine, error) {
+	var res curve.G1Affine
+	if _, err := res.MultiExp(points, scalars, ecc.MultiExpConfig{}); err != nil {
+		return curve.G1Affine{}, err
+	}
+	return res, nil
+}
+
+// foldBasis returns the points left[i] + x⋅right[i], where left and right are the two halves of points
+func foldBasis(points []curve.G1Affine, x fr.Element) []curve.G1Affine {
+	m := len(points) / 2
+	res := make([]curve.G1Jac, m)
+	var s big.Int
+	x.BigInt(&s)
+	parallel.Execute(m, func(start, end int) {
+		for i := start; i < end; i++ {
+			res[i].FromAffine(&points[m+i])
+			res[i].ScalarMultiplication(&res[i], &s)
+			res[i].AddMixed(&points[i])
+		}
+	})
+	return curve.BatchJacobianToAffineG1(res)
+}
+
+// isIdentity returns true if p is the neutral element of the group
+func isIdentity(p *curve.G1Affine) bool {
+	return p.IsInfinity()
+}
+
+// marshal returns the encoding of p bound to the transcripts
+func marshal(p *curve.G1Affine) []byte {
+	b := p.RawBytes()
+	return b[:]
+}
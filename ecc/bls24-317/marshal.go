@@ -17,7 +17,10 @@
 package bls24317
 
 import (
+	"bytes"
+	"encoding/base64"
 	"encoding/binary"
+	"encoding/hex"
 	"errors"
 	"io"
 	"reflect"
@@ -54,6 +57,9 @@ var (
 	ErrInvalidEncoding         = errors.New("invalid point encoding")
 )
 
+// cborByteString is the major type of CBOR byte strings (RFC 8949, section 3.1)
+const cborByteString = 2 << 5
+
 // Encoder writes bls24-317 object values to an output stream
 type Encoder struct {
 	w   io.Writer
@@ -767,6 +773,91 @@ func (p *G1Affine) Unmarshal(buf []byte) error {
 	return err
 }
 
+// MarshalHex returns the hex encoding of the compressed representation of p (see Bytes()).
+//
+// G1Affine doesn't implement encoding.TextMarshaler, so that its encoding/json
+// representation stays the {"X":…,"Y":…} object; types embedding points in JSON APIs
+// may opt in to the hex encoding by implementing MarshalText with MarshalHex.
+func (p *G1Affine) MarshalHex() ([]byte, error) {
+	b := p.Bytes()
+	res := make([]byte, hex.EncodedLen(len(b)))
+	hex.Encode(res, b[:])
+	return res, nil
+}
+
+// UnmarshalHex sets p from its encoding by MarshalHex. It returns an error if text is not
+// exactly that encoding, for instance if it holds upper case digits or the uncompressed
+// representation of p.
+func (p *G1Affine) UnmarshalHex(text []byte) error {
+	if len(text) != hex.EncodedLen(SizeOfG1AffineCompressed) {
+		return ErrInvalidEncoding
+	}
+	var buf [SizeOfG1AffineCompressed]byte
+	if _, err := hex.Decode(buf[:], text); err != nil {
+		return err
+	}
+	return p.setCanonicalBytes(&buf, func(b []byte) bool { return hex.EncodeToString(b) == string(text) })
+}
+
+// MarshalBase64 returns the standard base64 encoding (RFC 4648, section 4) of the compressed
+// representation of p (see Bytes()). See MarshalHex for its use in JSON APIs.
+func (p *G1Affine) MarshalBase64() ([]byte, error) {
+	b := p.Bytes()
+	res := make([]byte, base64.StdEncoding.EncodedLen(len(b)))
+	base64.StdEncoding.Encode(res, b[:])
+	return res, nil
+}
+
+// UnmarshalBase64 sets p from its encoding by MarshalBase64. It returns an error if text is not
+// exactly that encoding.
+func (p *G1Affine) UnmarshalBase64(text []byte) error {
+	if len(text) != base64.StdEncoding.EncodedLen(SizeOfG1AffineCompressed) {
+		return ErrInvalidEncoding
+	}
+	var buf [SizeOfG1AffineCompressed]byte
+	if n, err := base64.StdEncoding.Decode(buf[:], text); err != nil {
+		return err
+	} else if n != len(buf) {
+		return ErrInvalidEncoding
+	}
+	return p.setCanonicalBytes(&buf, func(b []byte) bool { return base64.StdEncoding.EncodeToString(b) == string(text) })
+}
+
+// MarshalCBOR returns the CBOR (RFC 8949) encoding of p: a byte string
+// holding the compressed representation of p (see Bytes()).
+func (p *G1Affine) MarshalCBOR() ([]byte, error) {
+	b := p.Bytes()
+	res := make([]byte, 0, 2+SizeOfG1AffineCompressed)
+	res = append(res, cborByteString|24, SizeOfG1AffineCompressed)
+	return append(res, b[:]...), nil
+}
+
+// UnmarshalCBOR decodes a CBOR byte string holding the compressed representation
+// of a point (see MarshalCBOR), and returns an error for any other encoding.
+func (p *G1Affine) UnmarshalCBOR(data []byte) error {
+	const headerSize = 2
+	if len(data) != headerSize+SizeOfG1AffineCompressed || data[0] != cborByteString|24 || data[1] != SizeOfG1AffineCompressed {
+		return ErrInvalidEncoding
+	}
+	buf := (*[SizeOfG1AffineCompressed]byte)(data[headerSize:])
+	return p.setCanonicalBytes(buf, func(b []byte) bool { return bytes.Equal(b, buf[:]) })
+}
+
+// setCanonicalBytes sets p from buf, the compressed representation of a point, if
+// canonical(p.Bytes()) holds, and returns ErrInvalidEncoding otherwise. It leaves p unchanged
+// on error.
+func (p *G1Affine) setCanonicalBytes(buf *[SizeOfG1AffineCompressed]byte, canonical func([]byte) bool) error {
+	var q G1Affine
+	if _, err := q.SetBytes(buf[:]); err != nil {
+		return err
+	}
+	if b := q.Bytes(); !canonical(b[:]) {
+		return ErrInvalidEncoding
+	}
+	*p = q
+	return nil
+}
+
 // Bytes returns binary representation of p
 // will store X coordinate in regular form and a parity bit
 // we follow the BLS12-381 style encoding as specified in ZCash and now IETF
@@ -1028,6 +1119,91 @@ func (p *G2Affine) Unmarshal(buf []byte) error {
 	return err
 }
 
+// MarshalHex returns the hex encoding of the compressed representation of p (see Bytes()).
+//
+// G2Affine doesn't implement encoding.TextMarshaler, so that its encoding/json
+// representation stays the {"X":…,"Y":…} object; types embedding points in JSON APIs
+// may opt in to the hex encoding by implementing MarshalText with MarshalHex.
+func (p *G2Affine) MarshalHex() ([]byte, error) {
+	b := p.Bytes()
+	res := make([]byte, hex.EncodedLen(len(b)))
+	hex.Encode(res, b[:])
+	return res, nil
+}
+
+// UnmarshalHex sets p from its encoding by MarshalHex. It returns an error if text is not
+// exactly that encoding, for instance if it holds upper case digits or the uncompressed
+// representation of p.
+func (p *G2Affine) UnmarshalHex(text []byte) error {
+	if len(text) != hex.EncodedLen(SizeOfG2AffineCompressed) {
+		return ErrInvalidEncoding
+	}
+	var buf [SizeOfG2AffineCompressed]byte
+	if _, err := hex.Decode(buf[:], text); err != nil {
+		return err
+	}
+	return p.setCanonicalBytes(&buf, func(b []byte) bool { return hex.EncodeToString(b) == string(text) })
+}
+
+// MarshalBase64 returns the standard base64 encoding (RFC 4648, section 4) of the compressed
+// representation of p (see Bytes()). See MarshalHex for its use in JSON APIs.
+func (p *G2Affine) MarshalBase64() ([]byte, error) {
+	b := p.Bytes()
+	res := make([]byte, base64.StdEncoding.EncodedLen(len(b)))
+	base64.StdEncoding.Encode(res, b[:])
+	return res, nil
+}
+
+// UnmarshalBase64 sets p from its encoding by MarshalBase64. It returns an error if text is not
+// exactly that encoding.
+func (p *G2Affine) UnmarshalBase64(text []byte) error {
+	if len(text) != base64.StdEncoding.EncodedLen(SizeOfG2AffineCompressed) {
+		return ErrInvalidEncoding
+	}
+	var buf [SizeOfG2AffineCompressed]byte
+	if n, err := base64.StdEncoding.Decode(buf[:], text); err != nil {
+		return err
+	} else if n != len(buf) {
+		return ErrInvalidEncoding
+	}
+	return p.setCanonicalBytes(&buf, func(b []byte) bool { return base64.StdEncoding.EncodeToString(b) == string(text) })
+}
+
+// MarshalCBOR returns the CBOR (RFC 8949) encoding of p: a byte string
+// holding the compressed representation of p (see Bytes()).
+func (p *G2Affine) MarshalCBOR() ([]byte, error) {
+	b := p.Bytes()
+	res := make([]byte, 0, 2+SizeOfG2AffineCompressed)
+	res = append(res, cborByteString|24, SizeOfG2AffineCompressed)
+	return append(res, b[:]...), nil
+}
+
+// UnmarshalCBOR decodes a CBOR byte string holding the compressed representation
+// of a point (see MarshalCBOR), and returns an error for any other encoding.
+func (p *G2Affine) UnmarshalCBOR(data []byte) error {
+	const headerSize = 2
+	if len(data) != headerSize+SizeOfG2AffineCompressed || data[0] != cborByteString|24 || data[1] != SizeOfG2AffineCompressed {
+		return ErrInvalidEncoding
+	}
+	buf := (*[SizeOfG2AffineCompressed]byte)(data[headerSize:])
+	return p.setCanonicalBytes(buf, func(b []byte) bool { return bytes.Equal(b, buf[:]) })
+}
+
+// setCanonicalBytes sets p from buf, the compressed representation of a point, if
+// canonical(p.Bytes()) holds, and returns ErrInvalidEncoding otherwise. It leaves p unchanged
+// on error.
+func (p *G2Affine) setCanonicalBytes(buf *[SizeOfG2AffineCompressed]byte, canonical func([]byte) bool) error {
+	var q G2Affine
+	if _, err := q.SetBytes(buf[:]); err != nil {
+		return err
+	}
+	if b := q.Bytes(); !canonical(b[:]) {
+		return ErrInvalidEncoding
+	}
+	*p = q
+	return nil
+}
+
 // Bytes returns binary representation of p
 // will store X coordinate in regular form and a parity bit
 // we follow the BLS12-381 style encoding as specified in ZCash and now IETF
@@ -0,0 +1,109 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ipa
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fp "github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+	curve "github.com/consensys/gnark-crypto/ecc/bls24-317/twistededwards"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/twistededwards/fr"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// dst domain separation tag used to derive the generators of the SRS
+const dst = "GNARK-CRYPTO-IPA-GENERATORS"
+
+var errGeneratorDerivation = errors.New("could not derive a generator from the seed")
+
+// deriveGenerator returns the i-th generator derived from seed: y is hashed to the base field
+// (try-and-increment), x is recovered from the curve equation and the cofactor is cleared.
+func deriveGenerator(seed []byte, i uint64) (curve.PointAffine, error) {
+	params := curve.GetEdwardsCurve()
+	var cofactor big.Int
+	params.Cofactor.BigInt(&cofactor)
+
+	msg := append(generatorMessage(seed, i), 0)
+	for counter := 0; counter < 256; counter++ {
+		msg[len(msg)-1] = byte(counter)
+		y, err := fp.Hash(msg, []byte(dst), 1)
+		if err != nil {
+			return curve.PointAffine{}, err
+		}
+
+		// x² = (1 - y²) / (a - d⋅y²)
+		var one, num, den, x fp.Element
+		one.SetOne()
+		num.Square(&y[0])
+		den.Mul(&num, &params.D)
+		den.Sub(&params.A, &den)
+		num.Sub(&one, &num)
+		if den.IsZero() {
+			continue
+		}
+		num.Div(&num, &den)
+		if x.Sqrt(&num) == nil {
+			continue
+		}
+
+		p := curve.NewPointAffine(x, y[0])
+		p.ScalarMultiplication(&p, &cofactor)
+		if !p.IsZero() {
+			return p, nil
+		}
+	}
+	return curve.PointAffine{}, errGeneratorDerivation
+}
+
+// multiExp computes ∑ᵢ scalars[i]⋅points[i]
+func multiExp(points []curve.PointAffine, scalars []fr.Element) (curve.PointAffine, error) {
+	var sum curve.PointExtended
+	if _, err := sum.MultiExp(points, scalars, ecc.MultiExpConfig{}); err != nil {
+		return curve.PointAffine{}, err
+	}
+	var res curve.PointAffine
+	res.FromExtended(&sum)
+	return res, nil
+}
+
+// foldBasis returns the points left[i] + x⋅right[i], where left and right are the two halves of points
+func foldBasis(points []curve.PointAffine, x fr.Element) []curve.PointAffine {
+	m := len(points) / 2
+	res := make([]curve.PointExtended, m)
+	var s big.Int
+	x.BigInt(&s)
+	parallel.Execute(m, func(start, end int) {
+		for i := start; i < end; i++ {
+			res[i].FromAffine(&points[m+i])
+			res[i].ScalarMultiplication(&res[i], &s)
+			res[i].MixedAdd(&res[i], &points[i])
+		}
+	})
+	return curve.BatchFromExtended(res)
+}
+
+// isIdentity returns true if p is the neutral element of the group
+func isIdentity(p *curve.PointAffine) bool {
+	return p.IsZero()
+}
+
+// marshal returns the encoding of p bound to the transcripts
+func marshal(p *curve.PointAffine) []byte {
+	return p.Marshal()
+}
@@ -0,0 +1,334 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package zeromorph
+
+import (
+	"errors"
+	"hash"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr/polynomial"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/kzg"
+	"github.com/consensys/gnark-crypto/fiat-shamir"
+)
+
+var (
+	ErrInvalidPolynomialSize = errors.New("invalid polynomial size (not a power of 2, or larger than SRS)")
+	ErrInvalidPointSize      = errors.New("the number of coordinates of the point is not the number of variables of the polynomial")
+	ErrInvalidProofSize      = errors.New("the number of quotients in the proof is not the number of variables of the polynomial")
+	ErrVerifyOpeningProof    = errors.New("can't verify opening proof")
+)
+
+// Digest commitment of a multilinear polynomial, which is the KZG commitment of Uₙ(f)
+type Digest = kzg.Digest
+
+// VerifyingKey Zeromorph verifying key: the KZG verifying key, and the number of G₁ points of the
+// proving key, N_max. The degree checks of the quotients rely on the prover not being able to
+// commit to polynomials of degree N_max or more.
+type VerifyingKey struct {
+	kzg.VerifyingKey
+	SRSSize uint64 // N_max = len(pk.G1)
+}
+
+// NewVerifyingKey returns the Zeromorph verifying key of a KZG SRS
+func NewVerifyingKey(srs *kzg.SRS) VerifyingKey {
+	return VerifyingKey{VerifyingKey: srs.Vk, SRSSize: uint64(len(srs.Pk.G1))}
+}
+
+// OpeningProof Zeromorph proof for opening at a single point.
+type OpeningProof struct {
+	// Quotients [q̂ₖ]G₁, where q̂ₖ = Uₖ(qₖ) and f - f(u) = ∑ₖ (xₖ - uₖ)⋅qₖ(x₀, ..., xₖ₋₁)
+	Quotients []bls24317.G1Affine
+
+	// BatchedQuotient [q̂]G₁, where q̂ = ∑ₖ yᵏX^(N_max-2ᵏ)q̂ₖ and N_max is the size of the SRS; it
+	// can only be committed if deg(q̂ₖ) < 2ᵏ for all k
+	BatchedQuotient bls24317.G1Affine
+
+	// H KZG opening proof at x of ζₓ + z⋅Zₓ, which vanishes at x
+	H bls24317.G1Affine
+
+	// ClaimedValue purported value
+	ClaimedValue fr.Element
+}
+
+// Commit commits to a multilinear polynomial p, given by its evaluations on the hypercube, as the
+// univariate polynomial ∑ᵢ p[i]Xⁱ.
+func Commit(p polynomial.MultiLin, pk kzg.ProvingKey, nbTasks ...int) (Digest, error) {
+	if !validSize(len(p), len(pk.G1)) {
+		return Digest{}, ErrInvalidPolynomialSize
+	}
+	return kzg.Commit(p, pk, nbTasks...)
+}
+
+// Open computes an opening proof of the multilinear polynomial p, committed in digest, at the given point.
+// It's an interactive protocol, made non-interactive using Fiat Shamir; dataTranscript is extra data
+// bound to the challenges. p is not modified.
+//
+// The variables of point are the ones of polynomial.MultiLin: point[0] corresponds to the most
+// significant bit of the indices of p.
+//
+// The batched quotient has degree len(pk.G1)-1, so the cost of Open grows with the size of the SRS,
+// not only with the size of p.
+func Open(p polynomial.MultiLin, point []fr.Element, digest Digest, hf hash.Hash, pk kzg.ProvingKey, dataTranscript ...[]byte) (OpeningProof, error) {
+	if !validSize(len(p), len(pk.G1)) {
+		return OpeningProof{}, ErrInvalidPolynomialSize
+	}
+	n := p.NumVars()
+	if len(point) != n {
+		return OpeningProof{}, ErrInvalidPointSize
+	}
+
+	// quotients, from the most significant variable down: writing f = (1-xₖ)L + xₖH,
+	// qₖ = H - L and f ← L + uₖ(H - L)
+	quotients := make([][]fr.Element, n)
+	f := p.Clone()
+	for i := 0; i < n; i++ {
+		k := n - 1 - i
+		mid := len(f) / 2
+		quotients[k] = make([]fr.Element, mid)
+		for j := 0; j < mid; j++ {
+			quotients[k][j].Sub(&f[mid+j], &f[j])
+		}
+		f.Fold(point[i])
+	}
+
+	return open(p, quotients, f[0], point, digest, hf, pk, len(pk.G1), dataTranscript)
+}
+
+// open computes the opening proof of p at point given the claimed value v and the univariate
+// quotients q̂ₖ; the batched quotient is q̂ = ∑ₖ yᵏX^(shift-2ᵏ)q̂ₖ, and must fit in the SRS.
+func open(p polynomial.MultiLin, quotients [][]fr.Element, v fr.Element, point []fr.Element, digest Digest, hf hash.Hash, pk kzg.ProvingKey, shift int, dataTranscript [][]byte) (OpeningProof, error) {
+	n, N := len(quotients), len(p)
+
+	res := OpeningProof{
+		Quotients:    make([]bls24317.G1Affine, n),
+		ClaimedValue: v,
+	}
+	var err error
+	for k := range quotients {
+		if res.Quotients[k], err = kzg.Commit(quotients[k], pk); err != nil {
+			return OpeningProof{}, err
+		}
+	}
+
+	fs := newTranscript(hf, &digest, point, &res.ClaimedValue, res.Quotients, dataTranscript)
+	y, err := deriveChallenge(fs, "y")
+	if err != nil {
+		return OpeningProof{}, err
+	}
+
+	// q̂ = ∑ₖ yᵏX^(shift-2ᵏ)q̂ₖ
+	size := N
+	for k := range quotients {
+		size = max(size, shift-(1<<k)+len(quotients[k]))
+	}
+	batched := make([]fr.Element, size)
+	var yk, t fr.Element
+	yk.SetOne()
+	for k := range quotients {
+		shifted := batched[shift-(1<<k):]
+		for j := range quotients[k] {
+			t.Mul(&quotients[k][j], &yk)
+			shifted[j].Add(&shifted[j], &t)
+		}
+		yk.Mul(&yk, &y)
+	}
+	if res.BatchedQuotient, err = kzg.Commit(batched, pk); err != nil {
+		return OpeningProof{}, err
+	}
+
+	if err = fs.Bind("x", res.BatchedQuotient.Marshal()); err != nil {
+		return OpeningProof{}, err
+	}
+	x, err := deriveChallenge(fs, "x")
+	if err != nil {
+		return OpeningProof{}, err
+	}
+	z, err := deriveChallenge(fs, "z")
+	if err != nil {
+		return OpeningProof{}, err
+	}
+
+	// ζₓ + z⋅Zₓ = q̂ + z⋅Uₙ(f) - z⋅v⋅Φₙ(x) - ∑ₖ (yᵏx^(shift-2ᵏ) + z⋅cₖ)q̂ₖ
+	c0, c := scalars(n, shift, &res.ClaimedValue, point, &x, &y, &z)
+	for i := range p {
+		t.Mul(&p[i], &z)
+		batched[i].Add(&batched[i], &t)
+	}
+	batched[0].Sub(&batched[0], &c0)
+	for k := range quotients {
+		for j := range quotients[k] {
+			t.Mul(&quotients[k][j], &c[k])
+			batched[j].Sub(&batched[j], &t)
+		}
+	}
+
+	if len(batched) == 1 {
+		// constant polynomial, kzg.Open needs at least 2 coefficients
+		batched = append(batched, fr.Element{})
+	}
+	proof, err := kzg.Open(batched, x, pk)
+	if err != nil {
+		return OpeningProof{}, err
+	}
+	if !proof.ClaimedValue.IsZero() {
+		return OpeningProof{}, errors.New("zeromorph: the polynomial doesn't vanish at the challenge")
+	}
+	res.H = proof.H
+
+	return res, nil
+}
+
+// Verify verifies a Zeromorph opening proof at a single point; the number of variables of the committed
+// polynomial is the number of coordinates of point.
+func Verify(digest *Digest, proof *OpeningProof, point []fr.Element, hf hash.Hash, vk VerifyingKey, dataTranscript ...[]byte) error {
+	n := len(point)
+	if len(proof.Quotients) != n {
+		return ErrInvalidProofSize
+	}
+	if n >= 64 {
+		return ErrInvalidPointSize
+	}
+	if uint64(1)<<n > vk.SRSSize {
+		return ErrInvalidPolynomialSize
+	}
+
+	fs := newTranscript(hf, digest, point, &proof.ClaimedValue, proof.Quotients, dataTranscript)
+	y, err := deriveChallenge(fs, "y")
+	if err != nil {
+		return err
+	}
+	if err = fs.Bind("x", proof.BatchedQuotient.Marshal()); err != nil {
+		return err
+	}
+	x, err := deriveChallenge(fs, "x")
+	if err != nil {
+		return err
+	}
+	z, err := deriveChallenge(fs, "z")
+	if err != nil {
+		return err
+	}
+
+	// [ζₓ + z⋅Zₓ]G₁ = [q̂]G₁ + z⋅[Uₙ(f)]G₁ - z⋅v⋅Φₙ(x)G₁ - ∑ₖ (yᵏx^(N_max-2ᵏ) + z⋅cₖ)[q̂ₖ]G₁
+	c0, c := scalars(n, int(vk.SRSSize), &proof.ClaimedValue, point, &x, &y, &z)
+	points := make([]bls24317.G1Affine, 0, n+3)
+	coeffs := make([]fr.Element, 0, n+3)
+	points = append(points, proof.BatchedQuotient, *digest, vk.G1)
+	coeffs = append(coeffs, fr.One(), z, c0)
+	coeffs[2].Neg(&coeffs[2])
+	for k := range c {
+		points = append(points, proof.Quotients[k])
+		coeffs = append(coeffs, c[k])
+		coeffs[len(coeffs)-1].Neg(&c[k])
+	}
+	var commitment bls24317.G1Affine
+	if _, err := commitment.MultiExp(points, coeffs, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+
+	// ζₓ + z⋅Zₓ vanishes at x
+	if err := kzg.Verify(&commitment, &kzg.OpeningProof{H: proof.H}, x, vk.VerifyingKey); err != nil {
+		return ErrVerifyOpeningProof
+	}
+	return nil
+}
+
+// scalars returns z⋅v⋅Φₙ(x) and the coefficients yᵏx^(shift-2ᵏ) + z⋅cₖ of the q̂ₖ in ζₓ + z⋅Zₓ, where
+// cₖ = x^(2ᵏ)Φₙ₋ₖ₋₁(x^(2ᵏ⁺¹)) - uₖΦₙ₋ₖ(x^(2ᵏ)) and Φₘ(X) = ∑_{i<2ᵐ} Xⁱ = ∏_{j<m} (1 + X^(2ʲ))
+func scalars(n, shift int, v *fr.Element, point []fr.Element, x, y, z *fr.Element) (fr.Element, []fr.Element) {
+	// x2k[k] = x^(2ᵏ), for k ≤ n
+	x2k := make([]fr.Element, n+1)
+	x2k[0] = *x
+	for k := 1; k <= n; k++ {
+		x2k[k].Square(&x2k[k-1])
+	}
+	// phi(k, m) = Φₘ(x^(2ᵏ)) = ∏_{j<m} (1 + x^(2ᵏ⁺ʲ))
+	one := fr.One()
+	phi := func(k, m int) fr.Element {
+		res := fr.One()
+		var t fr.Element
+		for j := 0; j < m; j++ {
+			t.Add(&one, &x2k[k+j])
+			res.Mul(&res, &t)
+		}
+		return res
+	}
+
+	var c0 fr.Element
+	c0 = phi(0, n)
+	c0.Mul(&c0, v).Mul(&c0, z)
+
+	c := make([]fr.Element, n)
+	var yk, t fr.Element
+	yk.SetOne()
+	for k := range c {
+		// uₖ is the coordinate of the variable of weight 2ᵏ
+		uk := &point[n-1-k]
+		c[k] = phi(k+1, n-k-1)
+		c[k].Mul(&c[k], &x2k[k])
+		t = phi(k, n-k)
+		t.Mul(&t, uk)
+		c[k].Sub(&c[k], &t).Mul(&c[k], z)
+
+		// yᵏx^(shift-2ᵏ)
+		t.Exp(*x, big.NewInt(int64(shift-(1<<k))))
+		t.Mul(&t, &yk)
+		c[k].Add(&c[k], &t)
+		yk.Mul(&yk, y)
+	}
+	return c0, c
+}
+
+// validSize returns true if size is a power of 2 that fits in the SRS
+func validSize(size, srsSize int) bool {
+	return size != 0 && size&(size-1) == 0 && size <= srsSize
+}
+
+// newTranscript returns a Fiat Shamir transcript for the challenges y, x and z, with the first challenge
+// bound to the statement and the quotients.
+func newTranscript(hf hash.Hash, digest *Digest, point []fr.Element, claimedValue *fr.Element, quotients []bls24317.G1Affine, dataTranscript [][]byte) *fiatshamir.Transcript {
+	fs := fiatshamir.NewTranscript(hf, "y", "x", "z")
+	toBind := [][]byte{digest.Marshal()}
+	for i := range point {
+		toBind = append(toBind, point[i].Marshal())
+	}
+	toBind = append(toBind, claimedValue.Marshal())
+	for i := range quotients {
+		toBind = append(toBind, quotients[i].Marshal())
+	}
+	toBind = append(toBind, dataTranscript...)
+	for _, b := range toBind {
+		// can't fail, the challenge "y" exists and isn't computed yet
+		_ = fs.Bind("y", b)
+	}
+	return fs
+}
+
+// deriveChallenge computes the challenge of the given name as a field element
+func deriveChallenge(fs *fiatshamir.Transcript, name string) (fr.Element, error) {
+	b, err := fs.ComputeChallenge(name)
+	if err != nil {
+		return fr.Element{}, err
+	}
+	var res fr.Element
+	res.SetBytes(b)
+	return res, nil
+}
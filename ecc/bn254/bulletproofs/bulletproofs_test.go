@@ -0,0 +1,182 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bulletproofs
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	curve "github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testNbBits      = 32
+	testMaxNbValues = 4
+)
+
+// Test generators re-used across tests
+var testGens *Generators
+
+func init() {
+	testGens, _ = NewGenerators(testNbBits, testMaxNbValues, []byte("test"))
+}
+
+// randomValues returns values in [0, 2ⁿ), including the bounds, their blindings and their commitments
+func randomValues(nbValues int, gens *Generators) (values, blindings []fr.Element, commitments []curve.G1Affine) {
+	values = make([]fr.Element, nbValues)
+	blindings = make([]fr.Element, nbValues)
+	commitments = make([]curve.G1Affine, nbValues)
+	maxValue := ^uint64(0) >> (64 - gens.NbBits)
+	for i := range values {
+		switch i {
+		case 0:
+			values[i].SetUint64(maxValue)
+		case 1:
+			values[i].SetZero()
+		default:
+			values[i].SetUint64((uint64(i) * 12345) & maxValue)
+		}
+		blindings[i].SetRandom()
+		commitments[i] = gens.Commit(values[i], blindings[i])
+	}
+	return
+}
+
+func TestNewGenerators(t *testing.T) {
+	assert := require.New(t)
+
+	gens, err := NewGenerators(testNbBits, testMaxNbValues, []byte("test"))
+	assert.NoError(err)
+	assert.Equal(testGens, gens, "the generators must be deterministic")
+	assert.Equal(testNbBits*testMaxNbValues, len(gens.GVec))
+	assert.Equal(testNbBits*testMaxNbValues, len(gens.HVec))
+	assert.False(gens.G.Equal(&gens.H))
+	assert.False(gens.G.Equal(&gens.GVec[0]))
+	assert.False(gens.H.Equal(&gens.U))
+
+	_, err = NewGenerators(65, 1, []byte("test"))
+	assert.ErrorIs(err, ErrInvalidNbBits)
+	_, err = NewGenerators(12, 1, []byte("test"))
+	assert.ErrorIs(err, ErrInvalidNbBits)
+	_, err = NewGenerators(8, 3, []byte("test"))
+	assert.ErrorIs(err, ErrInvalidNbValues)
+}
+
+func TestProveVerify(t *testing.T) {
+	assert := require.New(t)
+
+	for nbValues := 1; nbValues <= testMaxNbValues; nbValues++ {
+		values, blindings, commitments := randomValues(nbValues, testGens)
+		proof, err := Prove(values, blindings, testGens, sha256.New(), []byte("data"))
+		assert.NoError(err)
+		assert.NoError(Verify(commitments, &proof, testGens, sha256.New(), []byte("data")), "%d values", nbValues)
+
+		// wrong transcript
+		assert.ErrorIs(Verify(commitments, &proof, testGens, sha256.New(), []byte("other data")), ErrVerifyRangeProof)
+
+		// wrong commitment
+		wrongCommitments := make([]curve.G1Affine, nbValues)
+		copy(wrongCommitments, commitments)
+		wrongCommitments[nbValues-1].Add(&wrongCommitments[nbValues-1], &testGens.G)
+		assert.ErrorIs(Verify(wrongCommitments, &proof, testGens, sha256.New(), []byte("data")), ErrVerifyRangeProof)
+
+		// wrong proof
+		wrongProof := proof
+		wrongProof.THat.SetRandom()
+		assert.ErrorIs(Verify(commitments, &wrongProof, testGens, sha256.New(), []byte("data")), ErrVerifyRangeProof)
+		wrongProof = proof
+		wrongProof.InnerProduct.A.SetRandom()
+		assert.ErrorIs(Verify(commitments, &wrongProof, testGens, sha256.New(), []byte("data")), ErrVerifyRangeProof)
+	}
+
+	// smaller values with the same generators
+	gens := *testGens
+	gens.NbBits = 8
+	values, blindings, commitments := randomValues(3, &gens)
+	proof, err := Prove(values, blindings, &gens, sha256.New())
+	assert.NoError(err)
+	assert.NoError(Verify(commitments, &proof, &gens, sha256.New()))
+	assert.ErrorIs(Verify(commitments, &proof, testGens, sha256.New()), ErrInvalidProofSize)
+}
+
+func TestProveErrors(t *testing.T) {
+	assert := require.New(t)
+
+	values, blindings, _ := randomValues(2, testGens)
+
+	// 2ⁿ is out of range
+	values[1].SetUint64(1 << testNbBits)
+	_, err := Prove(values, blindings, testGens, sha256.New())
+	assert.ErrorIs(err, ErrValueOutOfRange)
+
+	// so is -1
+	values[1].SetOne().Neg(&values[1])
+	_, err = Prove(values, blindings, testGens, sha256.New())
+	assert.ErrorIs(err, ErrValueOutOfRange)
+
+	_, err = Prove(values, blindings[:1], testGens, sha256.New())
+	assert.ErrorIs(err, ErrInvalidNbValues)
+
+	values, blindings, _ = randomValues(testMaxNbValues+1, testGens)
+	_, err = Prove(values, blindings, testGens, sha256.New())
+	assert.ErrorIs(err, ErrInvalidNbValues)
+}
+
+func TestBatchVerify(t *testing.T) {
+	assert := require.New(t)
+
+	nbValues := []int{1, 4, 3}
+	commitments := make([][]curve.G1Affine, len(nbValues))
+	proofs := make([]Proof, len(nbValues))
+	for i := range nbValues {
+		var values, blindings []fr.Element
+		values, blindings, commitments[i] = randomValues(nbValues[i], testGens)
+		var err error
+		proofs[i], err = Prove(values, blindings, testGens, sha256.New())
+		assert.NoError(err)
+	}
+
+	assert.NoError(BatchVerify(commitments, proofs, testGens, sha256.New()))
+
+	// one of the proofs is wrong
+	proofs[1].TauX.SetRandom()
+	assert.ErrorIs(BatchVerify(commitments, proofs, testGens, sha256.New()), ErrVerifyRangeProof)
+
+	assert.ErrorIs(BatchVerify(commitments[1:], proofs, testGens, sha256.New()), ErrInvalidNbCommitments)
+}
+
+func BenchmarkProve(b *testing.B) {
+	values, blindings, _ := randomValues(testMaxNbValues, testGens)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = Prove(values, blindings, testGens, sha256.New())
+	}
+}
+
+func BenchmarkVerify(b *testing.B) {
+	values, blindings, commitments := randomValues(testMaxNbValues, testGens)
+	proof, err := Prove(values, blindings, testGens, sha256.New())
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = Verify(commitments, &proof, testGens, sha256.New())
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bulletproofs
+
+import (
+	"hash"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	curve "github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+)
+
+// Verify verifies an aggregated range proof that the values committed in commitments are in
+// [0, 2ⁿ). If the number of commitments isn't a power of 2, it is padded with the neutral element.
+func Verify(commitments []curve.G1Affine, proof *Proof, gens *Generators, hf hash.Hash, dataTranscript ...[]byte) error {
+	var v verification
+	var weight fr.Element
+	weight.SetOne()
+	if err := v.add(commitments, proof, gens, hf, dataTranscript, &weight); err != nil {
+		return err
+	}
+	return v.check(gens)
+}
+
+// BatchVerify verifies several aggregated range proofs, proofs[i] being a proof for the values
+// committed in commitments[i], with a single multi-scalar multiplication.
+func BatchVerify(commitments [][]curve.G1Affine, proofs []Proof, gens *Generators, hf hash.Hash, dataTranscript ...[]byte) error {
+	if len(commitments) != len(proofs) {
+		return ErrInvalidNbCommitments
+	}
+
+	var v verification
+	var weight fr.Element
+	for i := range proofs {
+		// random coefficients, the first one being 1
+		if i == 0 {
+			weight.SetOne()
+		} else if _, err := weight.SetRandom(); err != nil {
+			return err
+		}
+		if err := v.add(commitments[i], &proofs[i], gens, hf, dataTranscript, &weight); err != nil {
+			return err
+		}
+	}
+	return v.check(gens)
+}
+
+// verification accumulates the verification equations of one or several proofs. For a single
+// proof, with the challenges y, z, x, w, the challenges xⱼ of the inner-product argument and
+// a random c, the equations
+//
+//	t̂⋅G + τₓ⋅H = ∑ⱼ z²⁺ʲ⋅Vⱼ + δ(y, z)⋅G + x⋅T₁ + x²⋅T₂
+//	A + x⋅S - z⋅∑ᵢ Gᵢ + ∑ᵢ (z + dᵢ⋅y⁻ⁱ)⋅Hᵢ - μ⋅H + ∑ⱼ (xⱼ²⋅Lⱼ + xⱼ⁻²⋅Rⱼ) = a⋅∑ᵢ sᵢ⋅Gᵢ + b⋅∑ᵢ sᵢ⁻¹y⁻ⁱ⋅Hᵢ + w⋅(ab - t̂)⋅U
+//
+// are checked at once, the first one being multiplied by c.
+type verification struct {
+	g, h, u    fr.Element   // coefficients of G, H and U
+	gVec, hVec []fr.Element // coefficients of GVec and HVec
+	points     []curve.G1Affine
+	scalars    []fr.Element
+}
+
+// add adds the verification equations of a proof, multiplied by weight
+func (v *verification) add(commitments []curve.G1Affine, proof *Proof, gens *Generators, hf hash.Hash, dataTranscript [][]byte, weight *fr.Element) error {
+	if len(commitments) == 0 {
+		return ErrInvalidNbValues
+	}
+	nbBits := gens.NbBits
+	m := int(ecc.NextPowerOfTwo(uint64(len(commitments))))
+	n := nbBits * m
+	if n > len(gens.GVec) {
+		return ErrInvalidNbValues
+	}
+	nbRounds := bits.TrailingZeros(uint(n))
+	ip := &proof.InnerProduct
+	if len(ip.L) != nbRounds || len(ip.R) != nbRounds {
+		return ErrInvalidProofSize
+	}
+	padded := make([]curve.G1Affine, m)
+	copy(padded, commitments)
+
+	// challenges
+	fs := newTranscript(hf, nbBits, padded, nbRounds, dataTranscript)
+	y, err := bindAndDeriveChallenge(fs, "y", marshal(&proof.A), marshal(&proof.S))
+	if err != nil {
+		return err
+	}
+	z, err := bindAndDeriveChallenge(fs, "z")
+	if err != nil {
+		return err
+	}
+	x, err := bindAndDeriveChallenge(fs, "x", marshal(&proof.T1), marshal(&proof.T2))
+	if err != nil {
+		return err
+	}
+	w, err := bindAndDeriveChallenge(fs, "w", proof.TauX.Marshal(), proof.Mu.Marshal(), proof.THat.Marshal())
+	if err != nil {
+		return err
+	}
+	xs := make([]fr.Element, nbRounds)
+	for j := range xs {
+		if xs[j], err = bindAndDeriveChallenge(fs, roundChallengeName(j), marshal(&ip.L[j]), marshal(&ip.R[j])); err != nil {
+			return err
+		}
+	}
+	var c fr.Element
+	if _, err = c.SetRandom(); err != nil {
+		return err
+	}
+	var cw fr.Element
+	cw.Mul(&c, weight)
+
+	// δ(y, z) = (z - z²)⋅∑ᵢ yⁱ - ∑ⱼ z³⁺ʲ⋅∑ₖ 2ᵏ
+	var delta, sumY, yPow, zSquare, zPow, sumTwoPow, t fr.Element
+	yPow.SetOne()
+	for i := 0; i < n; i++ {
+		sumY.Add(&sumY, &yPow)
+		yPow.Mul(&yPow, &y)
+	}
+	zSquare.Square(&z)
+	delta.Sub(&z, &zSquare).Mul(&delta, &sumY)
+	sumTwoPow.SetUint64(^uint64(0) >> (64 - nbBits))
+	zPow.Mul(&zSquare, &z)
+	for j := 0; j < m; j++ {
+		t.Mul(&zPow, &sumTwoPow)
+		delta.Sub(&delta, &t)
+		zPow.Mul(&zPow, &z)
+	}
+
+	// coefficients of G, H and U
+	t.Sub(&proof.THat, &delta).Mul(&t, &cw)
+	v.g.Add(&v.g, &t)
+	t.Mul(&proof.TauX, &c).Sub(&t, &proof.Mu).Mul(&t, weight)
+	v.h.Add(&v.h, &t)
+	t.Mul(&ip.A, &ip.B).Sub(&proof.THat, &t).Mul(&t, &w).Mul(&t, weight)
+	v.u.Add(&v.u, &t)
+
+	// coefficients of Gᵢ: -z - a⋅sᵢ, and of Hᵢ: z + (dᵢ - b⋅sᵢ⁻¹)⋅y⁻ⁱ
+	if len(v.gVec) < n {
+		v.gVec = append(v.gVec, make([]fr.Element, n-len(v.gVec))...)
+		v.hVec = append(v.hVec, make([]fr.Element, n-len(v.hVec))...)
+	}
+	s := foldingCoefficients(xs)
+	d := rangeCoefficients(z, nbBits, m)
+	var yInv, yInvPow, gi, hi fr.Element
+	yInv.Inverse(&y)
+	yInvPow.SetOne()
+	for i := 0; i < n; i++ {
+		gi.Mul(&ip.A, &s[i]).Add(&gi, &z).Neg(&gi).Mul(&gi, weight)
+		v.gVec[i].Add(&v.gVec[i], &gi)
+
+		hi.Mul(&ip.B, &s[n-1-i])
+		hi.Sub(&d[i], &hi).Mul(&hi, &yInvPow).Add(&hi, &z).Mul(&hi, weight)
+		v.hVec[i].Add(&v.hVec[i], &hi)
+
+		yInvPow.Mul(&yInvPow, &yInv)
+	}
+
+	// A, S, T₁, T₂, the Vⱼ, the Lⱼ and the Rⱼ
+	var wx, cx, cxx fr.Element
+	wx.Mul(&x, weight)
+	cx.Mul(&cw, &x).Neg(&cx)
+	cxx.Mul(&cx, &x)
+	v.points = append(v.points, proof.A, proof.S, proof.T1, proof.T2)
+	v.scalars = append(v.scalars, *weight, wx, cx, cxx)
+
+	zPow.Set(&zSquare)
+	for j := range padded {
+		t.Mul(&zPow, &cw).Neg(&t)
+		v.points = append(v.points, padded[j])
+		v.scalars = append(v.scalars, t)
+		zPow.Mul(&zPow, &z)
+	}
+
+	xInv := fr.BatchInvert(xs)
+	var l, r fr.Element
+	for j := range xs {
+		l.Square(&xs[j]).Mul(&l, weight)
+		r.Square(&xInv[j]).Mul(&r, weight)
+		v.points = append(v.points, ip.L[j], ip.R[j])
+		v.scalars = append(v.scalars, l, r)
+	}
+
+	return nil
+}
+
+// check checks that the accumulated equations hold
+func (v *verification) check(gens *Generators) error {
+	n := len(v.gVec)
+	points := concatPoints([]curve.G1Affine{gens.G, gens.H, gens.U}, gens.GVec[:n], gens.HVec[:n], v.points)
+	scalars := concatScalars([]fr.Element{v.g, v.h, v.u}, v.gVec, v.hVec, v.scalars)
+
+	res, err := multiExp(points, scalars)
+	if err != nil {
+		return err
+	}
+	if !res.IsInfinity() {
+		return ErrVerifyRangeProof
+	}
+	return nil
+}
@@ -31,7 +31,7 @@ import (
 	"github.com/consensys/gnark-crypto/ecc"
 )
 
-// Domain with a power of 2 cardinality
+// Domain with a power of 2 cardinality, or a 2ᵃ⋅3ᵇ⋅5ᶜ cardinality (see NewDomainMixedRadix)
 // compute a field element of order 2x and store it in FinerGenerator
 // all other values can be derived from x, GeneratorSqrt
 type Domain struct {
@@ -62,6 +62,13 @@ type Domain struct {
 
 	// cosetTable[i][j] = domain.Generator(i-th)SqrtInv ^ j
 	cosetTableInv []fr.Element
+
+	// for mixed-radix domains, the power of 2 domain on which the radix-2 stages of the FFT are computed
+	radix2Domain *Domain
+
+	// for the four-step FFT, the domains of the columns (size R) and of the rows (size C)
+	fourStepOnce         sync.Once
+	fourStepR, fourStepC *Domain
 }
 
 // GeneratorFullMultiplicativeGroup returns a generator of 𝔽ᵣˣ
@@ -149,6 +156,11 @@ func (d *Domain) CosetTableInv() ([]fr.Element, error) {
 
 func (d *Domain) preComputeTwiddles() {
 
+	if d.isMixedRadix() {
+		d.preComputeTwiddlesMixedRadix()
+		return
+	}
+
 	// nb fft stages
 	nbStages := uint64(bits.TrailingZeros64(d.Cardinality))
 
@@ -0,0 +1,125 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"github.com/consensys/gnark-crypto/internal/parallel"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr/extensions"
+)
+
+// FFTE2 computes the discrete Fourier transform of a, a vector of E2 elements,
+// with the twiddles of the domain (in fr), and stores the result in a.
+//
+// The transform is fr-linear, so it is computed by running domain.FFT on each of the 2
+// coordinates of a; the decimation and the options have the same meaning as in FFT.
+func (domain *Domain) FFTE2(a []extensions.E2, decimation Decimation, opts ...Option) {
+	coordinates := splitE2(a, opts...)
+	for i := range coordinates {
+		domain.FFT(coordinates[i], decimation, opts...)
+	}
+	mergeE2(a, coordinates, opts...)
+}
+
+// FFTInverseE2 computes the inverse discrete Fourier transform of a, a vector of E2 elements,
+// and stores the result in a. See FFTE2 and FFTInverse.
+func (domain *Domain) FFTInverseE2(a []extensions.E2, decimation Decimation, opts ...Option) {
+	coordinates := splitE2(a, opts...)
+	for i := range coordinates {
+		domain.FFTInverse(coordinates[i], decimation, opts...)
+	}
+	mergeE2(a, coordinates, opts...)
+}
+
+// splitE2 returns the 2 vectors of coordinates of a over fr
+func splitE2(a []extensions.E2, opts ...Option) [2][]fr.Element {
+	var res [2][]fr.Element
+	for i := range res {
+		res[i] = make([]fr.Element, len(a))
+	}
+	parallel.Execute(len(a), func(start, end int) {
+		for i := start; i < end; i++ {
+			res[0][i] = a[i].A0
+			res[1][i] = a[i].A1
+		}
+	}, fftOptions(opts...).nbTasks)
+	return res
+}
+
+// mergeE2 sets the coordinates of a over fr
+func mergeE2(a []extensions.E2, coordinates [2][]fr.Element, opts ...Option) {
+	parallel.Execute(len(a), func(start, end int) {
+		for i := start; i < end; i++ {
+			a[i].A0 = coordinates[0][i]
+			a[i].A1 = coordinates[1][i]
+		}
+	}, fftOptions(opts...).nbTasks)
+}
+
+// FFTE4 computes the discrete Fourier transform of a, a vector of E4 elements,
+// with the twiddles of the domain (in fr), and stores the result in a.
+//
+// The transform is fr-linear, so it is computed by running domain.FFT on each of the 4
+// coordinates of a; the decimation and the options have the same meaning as in FFT.
+func (domain *Domain) FFTE4(a []extensions.E4, decimation Decimation, opts ...Option) {
+	coordinates := splitE4(a, opts...)
+	for i := range coordinates {
+		domain.FFT(coordinates[i], decimation, opts...)
+	}
+	mergeE4(a, coordinates, opts...)
+}
+
+// FFTInverseE4 computes the inverse discrete Fourier transform of a, a vector of E4 elements,
+// and stores the result in a. See FFTE4 and FFTInverse.
+func (domain *Domain) FFTInverseE4(a []extensions.E4, decimation Decimation, opts ...Option) {
+	coordinates := splitE4(a, opts...)
+	for i := range coordinates {
+		domain.FFTInverse(coordinates[i], decimation, opts...)
+	}
+	mergeE4(a, coordinates, opts...)
+}
+
+// splitE4 returns the 4 vectors of coordinates of a over fr
+func splitE4(a []extensions.E4, opts ...Option) [4][]fr.Element {
+	var res [4][]fr.Element
+	for i := range res {
+		res[i] = make([]fr.Element, len(a))
+	}
+	parallel.Execute(len(a), func(start, end int) {
+		for i := start; i < end; i++ {
+			res[0][i] = a[i].B0.A0
+			res[1][i] = a[i].B0.A1
+			res[2][i] = a[i].B1.A0
+			res[3][i] = a[i].B1.A1
+		}
+	}, fftOptions(opts...).nbTasks)
+	return res
+}
+
+// mergeE4 sets the coordinates of a over fr
+func mergeE4(a []extensions.E4, coordinates [4][]fr.Element, opts ...Option) {
+	parallel.Execute(len(a), func(start, end int) {
+		for i := start; i < end; i++ {
+			a[i].B0.A0 = coordinates[0][i]
+			a[i].B0.A1 = coordinates[1][i]
+			a[i].B1.A0 = coordinates[2][i]
+			a[i].B1.A1 = coordinates[3][i]
+		}
+	}, fftOptions(opts...).nbTasks)
+}
@@ -0,0 +1,207 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"math/big"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/internal/parallel"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+)
+
+const (
+	// fourStepBlockSize is the number of elements the four-step FFT processes at once when
+	// transforming columns; it should fit in the CPU caches.
+	fourStepBlockSize = 1 << 15
+
+	// fourStepMinBlockWidth is the minimum number of columns transformed at once, so that
+	// the rows are read and written by chunks of a few KiB even when R is large.
+	fourStepMinBlockWidth = 1 << 7
+)
+
+// FFTFourStep computes the discrete Fourier transform of a and stores the result in a, in bit-reversed
+// order; it is equivalent to domain.FFT(a, DIF, opts...).
+//
+// a is seen as a R×C matrix in row-major order, with R⋅C = n; the transform is computed with
+// Bailey's four-step algorithm: R-points FFTs on the columns (processed by blocks of contiguous
+// columns, to be cache friendly), a multiplication by twiddle factors, and C-points FFTs on the rows.
+// The columns are transformed by blocks of max(2¹⁵/R, 128) contiguous columns, so a is accessed by
+// chunks of at least 128 contiguous elements and can be backed by a memory-mapped file (see
+// NewMappedVector) for transforms larger than RAM; each task then buffers R⋅max(2¹⁵/R, 128) elements.
+//
+// The domains of sizes R and C are computed on the first call, and kept in domain.
+func (domain *Domain) FFTFourStep(a []fr.Element, opts ...Option) {
+	opt := fftOptions(opts...)
+	n := len(a)
+	if domain.isMixedRadix() || n < 4 {
+		domain.FFT(a, DIF, opts...)
+		return
+	}
+	if uint64(n) != domain.Cardinality {
+		panic("fft: len(a) must be equal to the cardinality of the domain")
+	}
+
+	logR, domainR, domainC := domain.fourStepDomains()
+	R, C := 1<<logR, n>>logR
+
+	// scale by the coset table
+	if opt.coset {
+		fourStepScale(a, domain.FrMultiplicativeGen, opt.nbTasks)
+	}
+
+	// 1. FFTs on the columns: column j₂ becomes Y[k₁, j₂] = ∑ⱼ₁ a[j₁, j₂]⋅ω^(C⋅j₁⋅k₁), in bit-reversed order
+	// 2. Y[k₁, j₂] *= ω^(j₂⋅k₁)
+	fourStepColumns(a, R, C, opt.nbTasks, func(column []fr.Element, j2 int, pow []fr.Element) {
+		domainR.FFT(column, DIF, WithNbTasks(1))
+		var w fr.Element
+		w.Exp(domain.Generator, big.NewInt(int64(j2)))
+		pow[0].SetOne()
+		for k := 1; k < len(pow); k++ {
+			pow[k].Mul(&pow[k-1], &w)
+		}
+		for i := range column {
+			k1 := bits.Reverse64(uint64(i)) >> (64 - logR)
+			column[i].Mul(&column[i], &pow[k1])
+		}
+	})
+
+	// 3. FFTs on the rows: X[k₁ + R⋅k₂] = ∑ⱼ₂ Y[k₁, j₂]⋅ω^(R⋅j₂⋅k₂), in bit-reversed order
+	// the bit reverse of k₁ + R⋅k₂ is bitReverse(k₁)⋅C + bitReverse(k₂), so the output is in the same order
+	// as the one of domain.FFT(a, DIF)
+	parallel.Execute(R, func(start, end int) {
+		for i := start; i < end; i++ {
+			domainC.FFT(a[i*C:(i+1)*C], DIF, WithNbTasks(1))
+		}
+	}, opt.nbTasks)
+}
+
+// FFTInverseFourStep computes the inverse discrete Fourier transform of a and stores the result in a;
+// the input must be in bit-reversed order, the output is in natural order. It is equivalent to
+// domain.FFTInverse(a, DIT, opts...).
+//
+// This is the four-step algorithm of FFTFourStep, run backwards.
+func (domain *Domain) FFTInverseFourStep(a []fr.Element, opts ...Option) {
+	opt := fftOptions(opts...)
+	n := len(a)
+	if domain.isMixedRadix() || n < 4 {
+		domain.FFTInverse(a, DIT, opts...)
+		return
+	}
+	if uint64(n) != domain.Cardinality {
+		panic("fft: len(a) must be equal to the cardinality of the domain")
+	}
+
+	logR, domainR, domainC := domain.fourStepDomains()
+	R, C := 1<<logR, n>>logR
+
+	// inverse FFTs on the rows, then Y[k₁, j₂] *= ω^(-j₂⋅k₁)
+	parallel.Execute(R, func(start, end int) {
+		var w, t fr.Element
+		for i := start; i < end; i++ {
+			row := a[i*C : (i+1)*C]
+			domainC.FFTInverse(row, DIT, WithNbTasks(1))
+
+			k1 := bits.Reverse64(uint64(i)) >> (64 - logR)
+			w.Exp(domain.GeneratorInv, new(big.Int).SetUint64(k1))
+			t.SetOne()
+			for j2 := range row {
+				row[j2].Mul(&row[j2], &t)
+				t.Mul(&t, &w)
+			}
+		}
+	}, opt.nbTasks)
+
+	// inverse FFTs on the columns
+	fourStepColumns(a, R, C, opt.nbTasks, func(column []fr.Element, _ int, _ []fr.Element) {
+		domainR.FFTInverse(column, DIT, WithNbTasks(1))
+	})
+
+	if opt.coset {
+		fourStepScale(a, domain.FrMultiplicativeGenInv, opt.nbTasks)
+	}
+}
+
+// fourStepDomains returns log₂(R), and the domains of sizes R and C of the four-step FFT, with
+// R⋅C = domain.Cardinality. They are computed on the first call.
+func (domain *Domain) fourStepDomains() (logR uint64, domainR, domainC *Domain) {
+	logR = uint64(bits.TrailingZeros64(domain.Cardinality)) / 2
+	domain.fourStepOnce.Do(func() {
+		domain.fourStepR = NewDomain(1 << logR)
+		domain.fourStepC = NewDomain(domain.Cardinality >> logR)
+	})
+	return logR, domain.fourStepR, domain.fourStepC
+}
+
+// fourStepColumns applies f to each column of the R×C matrix a. The columns are copied by blocks of
+// contiguous columns in a buffer, where they are contiguous, and written back once f is applied.
+// f also gets the index of the column and a scratch slice of size R.
+func fourStepColumns(a []fr.Element, R, C, nbTasks int, f func(column []fr.Element, j int, scratch []fr.Element)) {
+	blockSize := fourStepBlockSize / R
+	if blockSize < fourStepMinBlockWidth {
+		blockSize = fourStepMinBlockWidth
+	}
+	if blockSize > C {
+		blockSize = C
+	}
+	nbBlocks := (C + blockSize - 1) / blockSize
+
+	parallel.Execute(nbBlocks, func(start, end int) {
+		buf := make([]fr.Element, R*blockSize)
+		scratch := make([]fr.Element, R)
+		for b := start; b < end; b++ {
+			c0 := b * blockSize
+			c1 := c0 + blockSize
+			if c1 > C {
+				c1 = C
+			}
+			width := c1 - c0
+
+			// buf[j*R + i] = a[i][c0 + j]
+			for i := 0; i < R; i++ {
+				row := a[i*C+c0 : i*C+c1]
+				for j := range row {
+					buf[j*R+i] = row[j]
+				}
+			}
+
+			for j := 0; j < width; j++ {
+				f(buf[j*R:(j+1)*R], c0+j, scratch)
+			}
+
+			for i := 0; i < R; i++ {
+				row := a[i*C+c0 : i*C+c1]
+				for j := range row {
+					row[j] = buf[j*R+i]
+				}
+			}
+		}
+	}, nbTasks)
+}
+
+// fourStepScale sets a[i] *= gⁱ
+func fourStepScale(a []fr.Element, g fr.Element, nbTasks int) {
+	parallel.Execute(len(a), func(start, end int) {
+		var at fr.Element
+		at.Exp(g, big.NewInt(int64(start)))
+		for i := start; i < end; i++ {
+			a[i].Mul(&a[i], &at)
+			at.Mul(&at, &g)
+		}
+	}, nbTasks)
+}
@@ -0,0 +1,120 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFFTFourStep(t *testing.T) {
+	assert := require.New(t)
+
+	for logN := 1; logN <= 12; logN++ {
+		n := 1 << logN
+		for _, domain := range []*Domain{NewDomain(uint64(n)), NewDomain(uint64(n), WithoutPrecompute())} {
+			for _, opts := range [][]Option{nil, {OnCoset()}, {WithNbTasks(1)}} {
+				pol := make([]fr.Element, n)
+				for i := range pol {
+					pol[i].SetRandom()
+				}
+				backup := make([]fr.Element, n)
+				copy(backup, pol)
+
+				expected := make([]fr.Element, n)
+				copy(expected, pol)
+				domain.FFT(expected, DIF, opts...)
+
+				domain.FFTFourStep(pol, opts...)
+				assert.Equal(expected, pol, "FFTFourStep of size %d", n)
+
+				domain.FFTInverseFourStep(pol, opts...)
+				assert.Equal(backup, pol, "FFTInverseFourStep of size %d", n)
+			}
+		}
+	}
+
+	// the domains of the columns and of the rows are computed once
+	domain := NewDomain(1 << 8)
+	_, domainR, domainC := domain.fourStepDomains()
+	_, domainR2, domainC2 := domain.fourStepDomains()
+	assert.True(domainR == domainR2 && domainC == domainC2, "four-step domains should be cached")
+
+	if testing.Short() {
+		return
+	}
+
+	// 2¹⁵/R < fourStepMinBlockWidth < C
+	const n = 1 << 18
+	domain = NewDomain(n)
+	pol := make([]fr.Element, n)
+	for i := range pol {
+		pol[i].SetRandom()
+	}
+	expected := make([]fr.Element, n)
+	copy(expected, pol)
+	domain.FFT(expected, DIF)
+	domain.FFTFourStep(pol)
+	assert.Equal(expected, pol, "FFTFourStep of size %d", n)
+}
+
+func TestFFTFourStepMappedVector(t *testing.T) {
+	assert := require.New(t)
+
+	const n = 1 << 10
+	v, err := NewMappedVector(filepath.Join(t.TempDir(), "fft.bin"), n)
+	if err != nil {
+		t.Skip(err)
+	}
+
+	expected := make([]fr.Element, n)
+	for i := range v.Data {
+		v.Data[i].SetRandom()
+	}
+	copy(expected, v.Data)
+
+	domain := NewDomain(n)
+	domain.FFT(expected, DIF)
+	domain.FFTFourStep(v.Data)
+	assert.Equal(expected, v.Data)
+
+	assert.NoError(v.Close())
+}
+
+func BenchmarkFFTFourStep(b *testing.B) {
+	const n = 1 << 18
+	domain := NewDomain(n)
+	pol := make([]fr.Element, n)
+	for i := range pol {
+		pol[i].SetRandom()
+	}
+
+	b.Run("four-step", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			domain.FFTFourStep(pol)
+		}
+	})
+	b.Run("recursive", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			domain.FFT(pol, DIF)
+		}
+	})
+}
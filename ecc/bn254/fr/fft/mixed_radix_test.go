@@ -0,0 +1,174 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMixedRadixCardinality(t *testing.T) {
+	assert := require.New(t)
+
+	rMinusOne := fr.Modulus()
+	rMinusOne.Sub(rMinusOne, big.NewInt(1))
+
+	// supported returns true if n = 2ᵃ⋅3ᵇ⋅5ᶜ divides r-1
+	supported := func(n uint64) bool {
+		var rem big.Int
+		rem.Mod(rMinusOne, new(big.Int).SetUint64(n))
+		if rem.Sign() != 0 {
+			return false
+		}
+		for _, p := range mixedRadices {
+			for n%p == 0 {
+				n /= p
+			}
+		}
+		return n == 1
+	}
+
+	for m := uint64(1); m <= 300; m++ {
+		n, ok := mixedRadixCardinality(m)
+		assert.True(ok)
+		assert.True(supported(n), "cardinality %d for m=%d", n, m)
+
+		// n is the smallest supported size
+		for k := m; k < n; k++ {
+			assert.False(supported(k), "cardinality %d for m=%d, but %d is supported", n, m, k)
+		}
+	}
+
+	_, err := NewDomainMixedRadix(1<<62 + 1)
+	assert.ErrorIs(err, ErrNoRootOfUnity)
+}
+
+func TestFFTMixedRadix(t *testing.T) {
+	assert := require.New(t)
+
+	for _, m := range []uint64{3, 5, 6, 9, 10, 12, 15, 24, 45, 96, 100, 384, 1000} {
+		domain, err := NewDomainMixedRadix(m)
+		assert.NoError(err)
+		if !domain.isMixedRadix() {
+			// the field doesn't have the roots of unity, the domain is a power of 2
+			continue
+		}
+		n := int(domain.Cardinality)
+
+		// the generator has order exactly n
+		var one fr.Element
+		one.SetOne()
+		gn := domain.Generator
+		gn.Exp(gn, big.NewInt(int64(n)))
+		assert.True(gn.Equal(&one))
+
+		domainWithoutPrecompute, err := NewDomainMixedRadix(m, WithoutPrecompute())
+		assert.NoError(err)
+
+		for _, d := range []*Domain{domain, domainWithoutPrecompute} {
+			pol := make([]fr.Element, n)
+			for i := range pol {
+				pol[i].SetRandom()
+			}
+			backup := make([]fr.Element, n)
+			copy(backup, pol)
+
+			// evaluations, in natural order
+			d.FFT(pol, DIF)
+			var x fr.Element
+			x.SetOne()
+			for i := 0; i < n; i++ {
+				e := evaluatePolynomial(backup, x)
+				assert.True(e.Equal(&pol[i]), "size %d, evaluation %d", n, i)
+				x.Mul(&x, &d.Generator)
+			}
+
+			d.FFTInverse(pol, DIT)
+			assert.Equal(backup, pol, "size %d, inverse FFT", n)
+
+			// evaluations on the coset
+			d.FFT(pol, DIT, OnCoset())
+			x.Set(&d.FrMultiplicativeGen)
+			for i := 0; i < n; i++ {
+				e := evaluatePolynomial(backup, x)
+				assert.True(e.Equal(&pol[i]), "size %d, coset evaluation %d", n, i)
+				x.Mul(&x, &d.Generator)
+			}
+
+			d.FFTInverse(pol, DIF, OnCoset())
+			assert.Equal(backup, pol, "size %d, inverse coset FFT", n)
+		}
+	}
+}
+
+func TestDomainMixedRadixSerialization(t *testing.T) {
+	assert := require.New(t)
+
+	domain, err := NewDomainMixedRadix(3 << 4)
+	assert.NoError(err)
+
+	var buf bytes.Buffer
+	_, err = domain.WriteTo(&buf)
+	assert.NoError(err)
+
+	var reconstructed Domain
+	_, err = reconstructed.ReadFrom(&buf)
+	assert.NoError(err)
+	assert.Equal(domain.Cardinality, reconstructed.Cardinality)
+
+	pol := make([]fr.Element, domain.Cardinality)
+	for i := range pol {
+		pol[i].SetRandom()
+	}
+	expected := make([]fr.Element, len(pol))
+	copy(expected, pol)
+	domain.FFT(expected, DIF)
+	reconstructed.FFT(pol, DIF)
+	assert.Equal(expected, pol)
+}
+
+func BenchmarkFFTMixedRadix(b *testing.B) {
+	const m = 3 << 15
+	domain, err := NewDomainMixedRadix(m)
+	if err != nil {
+		b.Fatal(err)
+	}
+	pol := make([]fr.Element, domain.Cardinality)
+	for i := range pol {
+		pol[i].SetRandom()
+	}
+
+	b.Run("mixed radix", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			domain.FFT(pol, DIF)
+		}
+	})
+
+	domain2 := NewDomain(m)
+	pol2 := make([]fr.Element, domain2.Cardinality)
+	copy(pol2, pol)
+	b.Run("power of 2", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			domain2.FFT(pol2, DIF)
+		}
+	})
+}
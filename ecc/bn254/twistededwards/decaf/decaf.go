@@ -0,0 +1,290 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package decaf
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+
+	fp "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/twistededwards"
+	"github.com/consensys/gnark-crypto/ecc/bn254/twistededwards/fr"
+)
+
+// SizeElement size of the encoding of an Element
+const SizeElement = fp.Bytes
+
+// logCofactor log₂ of the cofactor of the curve
+const logCofactor = 3
+
+var (
+	ErrInvalidEncoding = errors.New("invalid or non-canonical encoding of a group element")
+	ErrNotOnCurve      = errors.New("the encoded y-coordinate isn't the one of a point of the curve")
+)
+
+var (
+	initOnce sync.Once
+
+	// sqrtMinusOne i, such that (i, 0) is a point of order 4
+	sqrtMinusOne fp.Element
+
+	// torsion8 point of order 8, so that E[8] = E[4] ∪ (torsion8 + E[4])
+	torsion8 twistededwards.PointExtended
+)
+
+func initConstants() {
+	var minusOne fp.Element
+	minusOne.SetOne().Neg(&minusOne)
+	if sqrtMinusOne.Sqrt(&minusOne) == nil {
+		panic("decaf: -1 is not a square in the base field")
+	}
+
+	// a point of order 8 is the projection on E[8] of a point outside of the subgroup 2⋅E, which
+	// is found by incrementing y
+	params := twistededwards.GetEdwardsCurve()
+	var one, y fp.Element
+	one.SetOne()
+	y.SetOne()
+	for {
+		y.Add(&y, &one)
+		x, ok := recoverX(&y)
+		if !ok {
+			continue
+		}
+		p := twistededwards.NewPointAffine(x, y)
+		torsion8.FromAffine(&p)
+		torsion8.ScalarMultiplication(&torsion8, &params.Order)
+		var t twistededwards.PointExtended
+		t.Double(&torsion8).Double(&t)
+		if !t.IsZero() {
+			return
+		}
+	}
+}
+
+// Element element of the prime order group E / E[8], stored as any of its representatives.
+//
+// The zero value isn't a valid element, use SetIdentity.
+type Element struct {
+	inner twistededwards.PointExtended
+}
+
+// Generator returns the generator of the group, the class of the base point of the curve
+func Generator() Element {
+	base := twistededwards.GetEdwardsCurve().Base
+	var res Element
+	res.FromAffine(&base)
+	return res
+}
+
+// SetIdentity sets p to the identity element and returns it
+func (p *Element) SetIdentity() *Element {
+	p.inner.X.SetZero()
+	p.inner.Y.SetOne()
+	p.inner.Z.SetOne()
+	p.inner.T.SetZero()
+	return p
+}
+
+// Set sets p to p1 and returns it
+func (p *Element) Set(p1 *Element) *Element {
+	p.inner.Set(&p1.inner)
+	return p
+}
+
+// FromAffine sets p to the class of the point p1, any point of the curve, and returns it
+func (p *Element) FromAffine(p1 *twistededwards.PointAffine) *Element {
+	p.inner.FromAffine(p1)
+	return p
+}
+
+// FromExtended sets p to the class of the point p1, any point of the curve, and returns it
+func (p *Element) FromExtended(p1 *twistededwards.PointExtended) *Element {
+	p.inner.Set(p1)
+	return p
+}
+
+// IsIdentity returns true if p is the identity element, that is if its representative is in E[8]
+func (p *Element) IsIdentity() bool {
+	var q twistededwards.PointExtended
+	q.Set(&p.inner)
+	for i := 0; i < logCofactor; i++ {
+		q.Double(&q)
+	}
+	return q.IsZero()
+}
+
+// Equal returns true if p and p1 are the same element, that is if their representatives differ by
+// a point of E[8]
+func (p *Element) Equal(p1 *Element) bool {
+	var d Element
+	return d.Sub(p, p1).IsIdentity()
+}
+
+// Add sets p to p1 + p2 and returns it
+func (p *Element) Add(p1, p2 *Element) *Element {
+	p.inner.Add(&p1.inner, &p2.inner)
+	return p
+}
+
+// Sub sets p to p1 - p2 and returns it
+func (p *Element) Sub(p1, p2 *Element) *Element {
+	var neg twistededwards.PointExtended
+	neg.Neg(&p2.inner)
+	p.inner.Add(&p1.inner, &neg)
+	return p
+}
+
+// Neg sets p to -p1 and returns it
+func (p *Element) Neg(p1 *Element) *Element {
+	p.inner.Neg(&p1.inner)
+	return p
+}
+
+// Double sets p to 2⋅p1 and returns it
+func (p *Element) Double(p1 *Element) *Element {
+	p.inner.Double(&p1.inner)
+	return p
+}
+
+// ScalarMultiplication sets p to s⋅p1 and returns it
+func (p *Element) ScalarMultiplication(p1 *Element, s *fr.Element) *Element {
+	var sBig big.Int
+	s.BigInt(&sBig)
+	p.inner.ScalarMultiplication(&p1.inner, &sBig)
+	return p
+}
+
+// Bytes returns the canonical encoding of p, the big-endian encoding of the y-coordinate of the
+// canonical representative of p.
+//
+// The canonical representatives of the two cosets P + E[4] and P + torsion8 + E[4] that make up
+// P + E[8] are computed, and the one with the smallest y-coordinate is chosen.
+func (p *Element) Bytes() [SizeElement]byte {
+	initOnce.Do(initConstants)
+	var q twistededwards.PointExtended
+	q.Add(&p.inner, &torsion8)
+
+	// 1/Z for both points, with a single inversion
+	var zInv, zpInv, zqInv fp.Element
+	zInv.Mul(&p.inner.Z, &q.Z).Inverse(&zInv)
+	zpInv.Mul(&zInv, &q.Z)
+	zqInv.Mul(&zInv, &p.inner.Z)
+
+	_, yp := canonical(&p.inner, &zpInv)
+	_, yq := canonical(&q, &zqInv)
+	if yq.Cmp(&yp) < 0 {
+		return yq.Bytes()
+	}
+	return yp.Bytes()
+}
+
+// SetBytes sets p from its canonical encoding. It returns an error if buf isn't the canonical
+// encoding of an element: the non-canonical encodings of the field element, those of y-coordinates
+// that aren't the one of the canonical representative, and those of points not on the curve are
+// rejected.
+func (p *Element) SetBytes(buf []byte) error {
+	initOnce.Do(initConstants)
+	if len(buf) != SizeElement {
+		return ErrInvalidEncoding
+	}
+	var y fp.Element
+	if err := y.SetBytesCanonical(buf); err != nil {
+		return ErrInvalidEncoding
+	}
+
+	// y must be non-negative, and not 0: the points (±i, 0) represent the identity, whose
+	// canonical representative is (0, 1)
+	if y.LexicographicallyLargest() || y.IsZero() {
+		return ErrInvalidEncoding
+	}
+	x, ok := recoverX(&y)
+	if !ok {
+		return ErrNotOnCurve
+	}
+
+	// x⋅y must be non-negative
+	var xy fp.Element
+	xy.Mul(&x, &y)
+	if xy.LexicographicallyLargest() {
+		x.Neg(&x)
+	}
+	point := twistededwards.NewPointAffine(x, y)
+	p.inner.FromAffine(&point)
+
+	// the canonical representative of the other coset of E[4] must have a larger y-coordinate
+	var q twistededwards.PointExtended
+	q.Add(&p.inner, &torsion8)
+	var zInv fp.Element
+	zInv.Inverse(&q.Z)
+	_, yq := canonical(&q, &zInv)
+	if yq.Cmp(&y) < 0 {
+		return ErrInvalidEncoding
+	}
+
+	return nil
+}
+
+// canonical returns the canonical representative of P + E[4], given 1/Z: the one among (x, y),
+// (-x, -y), (i⋅y, i⋅x) and (-i⋅y, -i⋅x) such that x⋅y and y are non-negative, that is not
+// lexicographically largest, or (0, 1) for the identity.
+func canonical(p *twistededwards.PointExtended, zInv *fp.Element) (x, y fp.Element) {
+	x.Mul(&p.X, zInv)
+	y.Mul(&p.Y, zInv)
+
+	var xy fp.Element
+	xy.Mul(&x, &y)
+	if xy.IsZero() {
+		x.SetZero()
+		y.SetOne()
+		return
+	}
+
+	// (x, y) + (i, 0) = (i⋅y, i⋅x), whose product x⋅y has the opposite sign
+	if xy.LexicographicallyLargest() {
+		x, y = y, x
+		x.Mul(&x, &sqrtMinusOne)
+		y.Mul(&y, &sqrtMinusOne)
+	}
+
+	// (x, y) + (0, -1) = (-x, -y)
+	if y.LexicographicallyLargest() {
+		x.Neg(&x)
+		y.Neg(&y)
+	}
+	return
+}
+
+// recoverX returns x such that (x, y) is on the curve, x² = (1 - y²) / (a - d⋅y²), and false if
+// there is none
+func recoverX(y *fp.Element) (fp.Element, bool) {
+	params := twistededwards.GetEdwardsCurve()
+	var one, yy, num, den, x fp.Element
+	one.SetOne()
+	yy.Square(y)
+	num.Sub(&one, &yy)
+	den.Mul(&yy, &params.D).Sub(&params.A, &den)
+	if den.IsZero() {
+		return fp.Element{}, false
+	}
+	num.Div(&num, &den)
+	if x.Sqrt(&num) == nil {
+		return fp.Element{}, false
+	}
+	return x, true
+}
@@ -0,0 +1,28 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package ipa provides a transparent polynomial commitment scheme based on the inner-product
+// argument of Bulletproofs (Bünz et al., https://eprint.iacr.org/2017/1066), as used in Halo
+// (Bowe et al., https://eprint.iacr.org/2019/1021).
+//
+// A vector a ∈ 𝔽ⁿ is committed with a Pedersen vector commitment C = ∑ᵢ aᵢGᵢ, where the Gᵢ are
+// nothing-up-my-sleeve generators derived from a public seed: there is no trusted setup.
+// Given a public vector b, a prover convinces a verifier that ⟨a, b⟩ = v with 2⋅log₂(n) group
+// elements and one scalar; the verifier checks the proof with a single multi-scalar multiplication
+// of size O(n). Opening a polynomial p at z is the particular case b = (1, z, z², ...).
+//
+// The commitments and proofs are binding but not hiding.
+package ipa
@@ -0,0 +1,22 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package extensions provides the extensions E2 = 𝔽ᵣ[u]/(u²-13) and E4 = E2[v]/(v²-u) of 𝔽ᵣ,
+// and polynomials with coefficients in these extensions.
+//
+// They are meant for protocols (FRI, sumcheck, ...) sampling their challenges in an extension field;
+// see fft.Domain.FFTE2 and fft.Domain.FFTE4 for Fourier transforms with twiddles in 𝔽ᵣ.
+package extensions
@@ -19,6 +19,7 @@ package bw6761
 import (
 	"github.com/consensys/gnark-crypto/ecc/bw6-761/fp"
 	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
 	"github.com/leanovate/gopter/prop"
 	"math/rand"
 	"testing"
@@ -163,6 +164,19 @@ func TestMapToG2(t *testing.T) {
 		GenFp(),
 	))
 
+	properties.Property("[G2] encoding and hashing to curve should output points in the subgroup", prop.ForAll(
+		func(msg string) bool {
+			dst := []byte("BW6-761_XMD:SHA-256_SSWU_TEST_")
+			p, err := EncodeToG2([]byte(msg), dst)
+			if err != nil || !p.IsInSubGroup() {
+				return false
+			}
+			q, err := HashToG2([]byte(msg), dst)
+			return err == nil && q.IsInSubGroup()
+		},
+		gen.AnyString(),
+	))
+
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
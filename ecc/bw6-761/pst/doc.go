@@ -0,0 +1,23 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package pst provides a commitment scheme for multilinear polynomials: the multilinear KZG
+// of Papamanthou, Shi and Tamassia (https://eprint.iacr.org/2011/587).
+//
+// A polynomial in n variables is given by its evaluations on the hypercube {0,1}ⁿ (polynomial.MultiLin);
+// the commitment is [f(τ)]G₁, and an opening proof at z ∈ 𝔽ⁿ consists of the n commitments to the
+// quotients qᵢ such that f - f(z) = ∑ᵢ (Xᵢ - zᵢ)⋅qᵢ(Xᵢ₊₁, ..., Xₙ).
+package pst
@@ -24,6 +24,7 @@ limitations under the License.
 //   - MiMC
 //   - twisted edwards "companion curves"
 //   - EdDSA (on the "companion" twisted edwards curves)
+//   - Ed25519 and Ed448 of RFC 8032 (on edwards25519 and edwards448)
 package ecc
 
 import (
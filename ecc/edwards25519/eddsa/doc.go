@@ -0,0 +1,27 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package eddsa provides the Ed25519, Ed25519ctx and Ed25519ph signature schemes of RFC 8032
+// on the twisted edwards curve edwards25519.
+//
+// Key derivation and signing handle the secret scalars in constant time, with a fixed-window
+// scalar multiplication of the base point and the constant-time arithmetic of the fields.
+// Verification only involves public values, and uses the faster variable-time arithmetic.
+//
+// # See also
+//
+// https://www.rfc-editor.org/rfc/rfc8032
+package eddsa
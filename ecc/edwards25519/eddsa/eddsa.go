@@ -0,0 +1,291 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package eddsa
+
+import (
+	"crypto/sha512"
+	"crypto/subtle"
+	"errors"
+	"hash"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/edwards25519"
+	"github.com/consensys/gnark-crypto/ecc/edwards25519/fr"
+	"github.com/consensys/gnark-crypto/signature"
+)
+
+const (
+	// SeedSize size in bytes of the seed of a private key
+	SeedSize = 32
+	// PublicKeySize size in bytes of an encoded public key
+	PublicKeySize = SeedSize
+	// PrivateKeySize size in bytes of an encoded private key, seed||publicKey
+	PrivateKeySize = SeedSize + PublicKeySize
+	// SignatureSize size in bytes of an encoded signature, R||S
+	SignatureSize = 2 * SeedSize
+	// PreHashSize size in bytes of the digest signed by Ed25519ph
+	PreHashSize = 64
+	// MaxContextSize maximal size in bytes of a context string
+	MaxContextSize = 255
+
+	// sizeHash size in bytes of the output of H
+	sizeHash = 2 * SeedSize
+)
+
+var errNotOnCurve = errors.New("point not on curve")
+var errContextTooLong = errors.New("context must be at most 255 bytes")
+var errPreHashSize = errors.New("pre-hashed message must be 64 bytes")
+
+// dom2 prefix of the hashes of Ed25519ctx and Ed25519ph
+const domPrefix = "SigEd25519 no Ed25519 collisions"
+
+// PublicKey Ed25519 public key
+type PublicKey struct {
+	A edwards25519.PointAffine
+}
+
+// PrivateKey Ed25519 private key
+type PrivateKey struct {
+	PublicKey PublicKey      // copy of the associated public key
+	seed      [SeedSize]byte // seed from which the key is derived
+	scalar    fr.Element     // secret scalar s
+	prefix    [SeedSize]byte // second half of H(seed), for the deterministic nonces
+}
+
+// Signature represents an Ed25519 signature
+type Signature struct {
+	R edwards25519.PointAffine
+	S fr.Element
+}
+
+// Options selects the variant of the signature scheme, see
+// https://www.rfc-editor.org/rfc/rfc8032#section-5.1
+type Options struct {
+	// PreHashed selects Ed25519ph: the message is then the digest by SHA-512, on PreHashSize
+	// bytes, of the message to sign.
+	PreHashed bool
+
+	// Context is a context string of at most MaxContextSize bytes, bound to the signature.
+	// A non empty Context selects Ed25519ctx if PreHashed is not set.
+	Context string
+}
+
+// GenerateKey generates a public and private key pair, from a seed read from r.
+func GenerateKey(r io.Reader) (*PrivateKey, error) {
+	var seed [SeedSize]byte
+	if _, err := io.ReadFull(r, seed[:]); err != nil {
+		return nil, err
+	}
+	return NewKeyFromSeed(seed[:])
+}
+
+// NewKeyFromSeed derives the private key from its seed, following
+// https://www.rfc-editor.org/rfc/rfc8032#section-5.1.5
+//
+// The secret scalar s and the public key [s]B are computed in constant time.
+func NewKeyFromSeed(seed []byte) (*PrivateKey, error) {
+	if len(seed) != SeedSize {
+		return nil, errWrongSize
+	}
+	var priv PrivateKey
+	copy(priv.seed[:], seed)
+
+	// H(seed) = s || prefix, with s pruned
+	h := hashWithDom(nil, seed)
+	h[0] &= 0xF8
+	h[31] &= 0x7F
+	h[31] |= 0x40
+	setLittleEndian(&priv.scalar, h[:SeedSize])
+	copy(priv.prefix[:], h[SeedSize:])
+
+	// A = [s]B
+	scalarMulBase(&priv.PublicKey.A, &priv.scalar)
+
+	return &priv, nil
+}
+
+// Seed returns the seed from which the private key is derived
+func (privKey *PrivateKey) Seed() []byte {
+	res := make([]byte, SeedSize)
+	copy(res, privKey.seed[:])
+	return res
+}
+
+// Equal compares 2 public keys
+func (pub *PublicKey) Equal(x signature.PublicKey) bool {
+	xx, ok := x.(*PublicKey)
+	if !ok {
+		return false
+	}
+	bpk := pub.Bytes()
+	bxx := xx.Bytes()
+	return subtle.ConstantTimeCompare(bpk, bxx) == 1
+}
+
+// Public returns the public key associated to the private key.
+func (privKey *PrivateKey) Public() signature.PublicKey {
+	var pub PublicKey
+	pub.A.Set(&privKey.PublicKey.A)
+	return &pub
+}
+
+// Sign signs message with Ed25519 if hFunc is nil. Otherwise, it signs the digest of message by
+// hFunc with Ed25519ph: hFunc must then output PreHashSize bytes, and is sha512.New() in RFC 8032.
+func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error) {
+	if hFunc == nil {
+		return privKey.SignWithOptions(message, &Options{})
+	}
+	digest, err := preHash(message, hFunc)
+	if err != nil {
+		return nil, err
+	}
+	return privKey.SignWithOptions(digest, &Options{PreHashed: true})
+}
+
+// SignWithOptions signs message with the variant of Ed25519 selected by opts, following
+// https://www.rfc-editor.org/rfc/rfc8032#section-5.1.6
+//
+// The nonce r, [r]B and S = r + H(dom || R || A || M)⋅s are computed in constant time.
+func (privKey *PrivateKey) SignWithOptions(message []byte, opts *Options) ([]byte, error) {
+	if err := opts.check(message); err != nil {
+		return nil, err
+	}
+
+	// r = H(dom || prefix || M)
+	var r fr.Element
+	h := hashWithDom(opts, privKey.prefix[:], message)
+	setLittleEndian(&r, h[:])
+
+	// R = [r]B
+	var res Signature
+	scalarMulBase(&res.R, &r)
+
+	// S = r + H(dom || R || A || M)⋅s
+	k := challenge(opts, &res.R, &privKey.PublicKey.A, message)
+	res.S.MulCT(&k, &privKey.scalar).
+		AddCT(&res.S, &r)
+
+	return res.Bytes(), nil
+}
+
+// Verify verifies the Ed25519 signature sigBin of message if hFunc is nil. Otherwise, it verifies
+// the Ed25519ph signature of the digest of message by hFunc, see Sign.
+func (pub *PublicKey) Verify(sigBin, message []byte, hFunc hash.Hash) (bool, error) {
+	if hFunc == nil {
+		return pub.VerifyWithOptions(sigBin, message, &Options{})
+	}
+	digest, err := preHash(message, hFunc)
+	if err != nil {
+		return false, err
+	}
+	return pub.VerifyWithOptions(sigBin, digest, &Options{PreHashed: true})
+}
+
+// VerifyWithOptions verifies the signature sigBin of message with the variant of Ed25519
+// selected by opts, following https://www.rfc-editor.org/rfc/rfc8032#section-5.1.7
+func (pub *PublicKey) VerifyWithOptions(sigBin, message []byte, opts *Options) (bool, error) {
+	if err := opts.check(message); err != nil {
+		return false, err
+	}
+
+	// verify that pubKey is on the curve
+	if !pub.A.IsOnCurve() {
+		return false, errNotOnCurve
+	}
+
+	// Deserialize the signature, S < L is checked there
+	var sig Signature
+	if _, err := sig.SetBytes(sigBin); err != nil {
+		return false, err
+	}
+
+	curveParams := edwards25519.GetEdwardsCurve()
+	k := challenge(opts, &sig.R, &pub.A, message)
+
+	// lhs = cofactor*S*Base
+	var lhs edwards25519.PointAffine
+	var bCofactor, bs, bk big.Int
+	curveParams.Cofactor.BigInt(&bCofactor)
+	lhs.ScalarMultiplication(&curveParams.Base, sig.S.BigInt(&bs)).
+		ScalarMultiplication(&lhs, &bCofactor)
+
+	// rhs = cofactor*(R + H(dom,R,A,M)*A)
+	var rhs edwards25519.PointAffine
+	rhs.ScalarMultiplication(&pub.A, k.BigInt(&bk)).
+		Add(&rhs, &sig.R).
+		ScalarMultiplication(&rhs, &bCofactor)
+
+	return lhs.Equal(&rhs), nil
+}
+
+// check returns an error if opts and message don't define a valid instance of the scheme
+func (opts *Options) check(message []byte) error {
+	if len(opts.Context) > MaxContextSize {
+		return errContextTooLong
+	}
+	if opts.PreHashed && len(message) != PreHashSize {
+		return errPreHashSize
+	}
+	return nil
+}
+
+// challenge returns H(dom || R || A || M) mod L
+func challenge(opts *Options, R, A *edwards25519.PointAffine, message []byte) fr.Element {
+	RBytes := R.Bytes()
+	ABytes := A.Bytes()
+	h := hashWithDom(opts, RBytes[:], ABytes[:], message)
+
+	var res fr.Element
+	setLittleEndian(&res, h[:])
+	return res
+}
+
+// hashWithDom returns H(dom || data), with H = SHA-512 and the domain separation of the variant
+// selected by opts. dom is empty for Ed25519, and omitted if opts is nil, to hash the seed.
+func hashWithDom(opts *Options, data ...[]byte) [sizeHash]byte {
+	h := sha512.New()
+	if opts != nil && (opts.PreHashed || len(opts.Context) > 0) {
+		var phFlag byte
+		if opts.PreHashed {
+			phFlag = 1
+		}
+		h.Write([]byte(domPrefix))
+		h.Write([]byte{phFlag, byte(len(opts.Context))})
+		h.Write([]byte(opts.Context))
+	}
+	for _, d := range data {
+		h.Write(d)
+	}
+
+	var res [sizeHash]byte
+	h.Sum(res[:0])
+	return res
+}
+
+// preHash returns the digest of message by hFunc, which must be of size PreHashSize
+func preHash(message []byte, hFunc hash.Hash) ([]byte, error) {
+	hFunc.Reset()
+	if _, err := hFunc.Write(message); err != nil {
+		return nil, err
+	}
+	digest := hFunc.Sum(nil)
+	if len(digest) != PreHashSize {
+		return nil, errPreHashSize
+	}
+	return digest, nil
+}
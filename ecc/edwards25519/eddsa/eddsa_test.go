@@ -0,0 +1,365 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package eddsa
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"crypto"
+	"crypto/ed25519"
+	crand "crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+
+	"github.com/consensys/gnark-crypto/ecc/edwards25519"
+	"github.com/consensys/gnark-crypto/ecc/edwards25519/fr"
+)
+
+func Example() {
+	// create an Ed25519 key pair
+	privateKey, _ := GenerateKey(crand.Reader)
+	publicKey := privateKey.PublicKey
+
+	// sign the message
+	msg := []byte("message to sign")
+	signature, _ := privateKey.Sign(msg, nil)
+
+	// verifies signature
+	isValid, _ := publicKey.Verify(signature, msg, nil)
+	if !isValid {
+		fmt.Println("1. invalid signature")
+	} else {
+		fmt.Println("1. valid signature")
+	}
+
+	// Output: 1. valid signature
+}
+
+// rfc8032Vector test vector of https://www.rfc-editor.org/rfc/rfc8032#section-7
+type rfc8032Vector struct {
+	seed, publicKey, message, signature string
+	opts                                Options
+}
+
+var rfc8032Vectors = []rfc8032Vector{
+	{ // 7.1, test 1
+		seed:      "9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60",
+		publicKey: "d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a",
+		message:   "",
+		signature: "e5564300c360ac729086e2cc806e828a84877f1eb8e5d974d873e065224901555fb8821590a33bacc61e39701cf9b46bd25bf5f0595bbe24655141438e7a100b",
+	},
+	{ // 7.1, test 2
+		seed:      "4ccd089b28ff96da9db6c346ec114e0f5b8a319f35aba624da8cf6ed4fb8a6fb",
+		publicKey: "3d4017c3e843895a92b70aa74d1b7ebc9c982ccf2ec4968cc0cd55f12af4660c",
+		message:   "72",
+		signature: "92a009a9f0d4cab8720e820b5f642540a2b27b5416503f8fb3762223ebdb69da085ac1e43e15996e458f3613d0f11d8c387b2eaeb4302aeeb00d291612bb0c00",
+	},
+	{ // 7.2, Ed25519ctx, foo
+		seed:      "0305334e381af78f141cb666f6199f57bc3495335a256a95bd2a55bf546663f6",
+		publicKey: "dfc9425e4f968f7f0c29f0259cf5f9aed6851c2bb4ad8bfb860cfee0ab248292",
+		message:   "f726936d19c800494e3fdaff20b276a8",
+		signature: "55a4cc2f70a54e04288c5f4cd1e45a7bb520b36292911876cada7323198dd87a8b36950b95130022907a7fb7c4e9b2d5f6cca685a587b4b21f4b888e4e7edb0d",
+		opts:      Options{Context: "foo"},
+	},
+	{ // 7.3, Ed25519ph, the message being SHA-512("abc")
+		seed:      "833fe62409237b9d62ec77587520911e9a759cec1d19755b7da901b96dca3d42",
+		publicKey: "ec172b93ad5e563bf4932c70e1245034c35467ef2efd4d64ebf819683467e2bf",
+		message:   "ddaf35a193617abacc417349ae20413112e6fa4e89a97ea20a9eeee64b55d39a2192992a274fc1a836ba3c23a3feebbd454d4423643ce80e2a9ac94fa54ca49f",
+		signature: "98a70222f0b8121aa9d30f813d683f809e462b469c7ff87639499bb94e6dae4131f85042463c2a355a2003d062adf5aaa10b8c61e636062aaad11c2a26083406",
+		opts:      Options{PreHashed: true},
+	},
+}
+
+func decodeHex(t *testing.T, s string) []byte {
+	res, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res
+}
+
+func TestRFC8032Vectors(t *testing.T) {
+	for i, v := range rfc8032Vectors {
+		privKey, err := NewKeyFromSeed(decodeHex(t, v.seed))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(privKey.PublicKey.Bytes(), decodeHex(t, v.publicKey)) {
+			t.Fatalf("vector %d: wrong public key", i)
+		}
+
+		message := decodeHex(t, v.message)
+		sig, err := privKey.SignWithOptions(message, &v.opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(sig, decodeHex(t, v.signature)) {
+			t.Fatalf("vector %d: wrong signature", i)
+		}
+
+		var pub PublicKey
+		if _, err = pub.SetBytes(decodeHex(t, v.publicKey)); err != nil {
+			t.Fatal(err)
+		}
+		res, err := pub.VerifyWithOptions(sig, message, &v.opts)
+		if err != nil || !res {
+			t.Fatalf("vector %d: verification failed", i)
+		}
+
+		// the signature is bound to the variant of the scheme
+		wrongOpts := v.opts
+		wrongOpts.Context = "bar"
+		res, err = pub.VerifyWithOptions(sig, message, &wrongOpts)
+		if err != nil || res {
+			t.Fatalf("vector %d: verification with another context should fail", i)
+		}
+	}
+}
+
+func TestCompatibilityStdlib(t *testing.T) {
+	src := rand.NewSource(0)
+	r := rand.New(src) //#nosec G404 weak rng is fine here
+
+	for i := 0; i < 32; i++ {
+		seed := make([]byte, SeedSize)
+		r.Read(seed)
+		message := make([]byte, i)
+		r.Read(message)
+
+		privKey, err := NewKeyFromSeed(seed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		stdPrivKey := ed25519.NewKeyFromSeed(seed)
+		if !bytes.Equal(privKey.Bytes(), stdPrivKey) {
+			t.Fatal("private keys don't match crypto/ed25519")
+		}
+
+		digest := sha512.Sum512(message)
+		for _, c := range []struct {
+			message []byte
+			opts    Options
+			stdOpts ed25519.Options
+		}{
+			{message, Options{}, ed25519.Options{}},
+			{message, Options{Context: "ctx"}, ed25519.Options{Context: "ctx"}},
+			{digest[:], Options{PreHashed: true}, ed25519.Options{Hash: crypto.SHA512}},
+			{digest[:], Options{PreHashed: true, Context: "ctx"}, ed25519.Options{Hash: crypto.SHA512, Context: "ctx"}},
+		} {
+			sig, err := privKey.SignWithOptions(c.message, &c.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			stdSig, err := stdPrivKey.Sign(nil, c.message, &c.stdOpts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(sig, stdSig) {
+				t.Fatal("signatures don't match crypto/ed25519")
+			}
+		}
+	}
+}
+
+func TestSigner(t *testing.T) {
+	src := rand.NewSource(0)
+	r := rand.New(src) //#nosec G404 weak rng is fine here
+
+	privKey, err := GenerateKey(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubKey := privKey.Public()
+	message := []byte("message")
+
+	// pure Ed25519, and Ed25519ph with a pre-hash
+	for _, hFunc := range []func() hash.Hash{nil, sha512.New} {
+		var h hash.Hash
+		if hFunc != nil {
+			h = hFunc()
+		}
+		sig, err := privKey.Sign(message, h)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := pubKey.Verify(sig, message, h)
+		if err != nil || !res {
+			t.Fatal("Verify correct signature should return true")
+		}
+		res, err = pubKey.Verify(sig, []byte("wrong_message"), h)
+		if err != nil || res {
+			t.Fatal("Verify wrong signature should be false")
+		}
+	}
+
+	// the pre-hash must be of size PreHashSize
+	if _, err = privKey.Sign(message, sha256.New()); err != errPreHashSize {
+		t.Fatal("Sign should fail with a pre-hash of the wrong size")
+	}
+	if _, err = privKey.SignWithOptions(message, &Options{Context: string(make([]byte, MaxContextSize+1))}); err != errContextTooLong {
+		t.Fatal("Sign should fail with a context too long")
+	}
+
+	// keys round trip
+	var privKey2 PrivateKey
+	if _, err = privKey2.SetBytes(privKey.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(privKey2.Bytes(), privKey.Bytes()) || !bytes.Equal(privKey2.Seed(), privKey.Seed()) {
+		t.Fatal("private key round trip failed")
+	}
+	wrongPrivKey := privKey.Bytes()
+	wrongPrivKey[0] ^= 1
+	if _, err = privKey2.SetBytes(wrongPrivKey); err != errWrongPublicKey {
+		t.Fatal("SetBytes should fail when the public key doesn't match the seed")
+	}
+
+	var pubKey2 PublicKey
+	if _, err = pubKey2.SetBytes(pubKey.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+	if !pubKey2.Equal(pubKey) {
+		t.Fatal("public key round trip failed")
+	}
+}
+
+func TestNonMalleability(t *testing.T) {
+	src := rand.NewSource(0)
+	r := rand.New(src) //#nosec G404 weak rng is fine here
+
+	privKey, err := GenerateKey(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	message := []byte("message")
+	sig, err := privKey.Sign(message, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// S + L is rejected
+	var s Signature
+	if _, err = s.SetBytes(sig); err != nil {
+		t.Fatal(err)
+	}
+	var sPlusL [SeedSize]byte
+	var carry uint16
+	l := fr.Modulus().FillBytes(make([]byte, SeedSize))
+	var sBin [fr.Bytes]byte
+	fr.LittleEndian.PutElement(&sBin, s.S)
+	for i := 0; i < SeedSize; i++ {
+		carry += uint16(l[SeedSize-1-i])
+		if i < fr.Bytes {
+			carry += uint16(sBin[i])
+		}
+		sPlusL[i] = byte(carry)
+		carry >>= 8
+	}
+	malleated := make([]byte, SignatureSize)
+	copy(malleated, sig[:SeedSize])
+	copy(malleated[SeedSize:], sPlusL[:])
+	if res, err := privKey.PublicKey.Verify(malleated, message, nil); err != errSBiggerThanRMod || res {
+		t.Fatal("Verify should reject S >= L")
+	}
+
+	// non canonical encoding of R, with y >= p
+	nonCanonical := make([]byte, SignatureSize)
+	copy(nonCanonical, sig)
+	for i := 0; i < SeedSize; i++ {
+		nonCanonical[i] = 0xff
+	}
+	if res, err := privKey.PublicKey.Verify(nonCanonical, message, nil); err == nil || res {
+		t.Fatal("Verify should reject non canonical encodings of R")
+	}
+
+	// wrong size
+	if _, err = s.SetBytes(sig[1:]); err != errWrongSize {
+		t.Fatal("SetBytes should raise wrong size error")
+	}
+}
+
+func TestConstantTimeScalars(t *testing.T) {
+	src := rand.NewSource(0)
+	r := rand.New(src) //#nosec G404 weak rng is fine here
+
+	curveParams := edwards25519.GetEdwardsCurve()
+	L := fr.Modulus()
+
+	// setLittleEndian matches the reduction mod L with math/big
+	for _, size := range []int{0, 1, SeedSize, sizeHash} {
+		buf := make([]byte, size)
+		r.Read(buf)
+		if size > 0 {
+			buf[size-1] = 0xff
+		}
+		bigEndian := make([]byte, size)
+		for i := range buf {
+			bigEndian[size-1-i] = buf[i]
+		}
+		var expected, res fr.Element
+		expected.SetBigInt(new(big.Int).SetBytes(bigEndian))
+		setLittleEndian(&res, buf)
+		if !res.Equal(&expected) {
+			t.Fatalf("setLittleEndian of %d bytes doesn't match math/big", size)
+		}
+	}
+
+	// scalarMulBase matches ScalarMultiplication
+	scalars := []*big.Int{big.NewInt(0), big.NewInt(1), big.NewInt(15), big.NewInt(16), new(big.Int).Sub(L, big.NewInt(1))}
+	for i := 0; i < 10; i++ {
+		scalars = append(scalars, new(big.Int).Rand(r, L))
+	}
+	for _, s := range scalars {
+		var k fr.Element
+		k.SetBigInt(s)
+		var expected, res edwards25519.PointAffine
+		expected.ScalarMultiplication(&curveParams.Base, s)
+		scalarMulBase(&res, &k)
+		if !res.Equal(&expected) {
+			t.Fatalf("scalarMulBase doesn't match ScalarMultiplication for %s", s.String())
+		}
+	}
+}
+
+// benchmarks
+
+func BenchmarkSign(b *testing.B) {
+	privKey, _ := GenerateKey(crand.Reader)
+	message := []byte("message to sign")
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = privKey.Sign(message, nil)
+	}
+}
+
+func BenchmarkVerify(b *testing.B) {
+	privKey, _ := GenerateKey(crand.Reader)
+	message := []byte("message to sign")
+	sig, _ := privKey.Sign(message, nil)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = privKey.PublicKey.Verify(sig, message, nil)
+	}
+}
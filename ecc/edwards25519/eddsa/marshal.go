@@ -0,0 +1,121 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package eddsa
+
+import (
+	"crypto/subtle"
+	"errors"
+	"io"
+
+	"github.com/consensys/gnark-crypto/ecc/edwards25519/fr"
+)
+
+var errWrongSize = errors.New("wrong size buffer")
+var errSBiggerThanRMod = errors.New("s >= r_mod")
+var errWrongPublicKey = errors.New("public key doesn't match the seed")
+
+// Bytes returns the binary representation of the public key, the compressed
+// encoding of the point A of https://www.rfc-editor.org/rfc/rfc8032#section-5.1.2
+func (pk *PublicKey) Bytes() []byte {
+	var res [PublicKeySize]byte
+	pkBin := pk.A.Bytes()
+	subtle.ConstantTimeCopy(1, res[:], pkBin[:])
+	return res[:]
+}
+
+// SetBytes sets pk from its binary representation in buf, see Bytes.
+// It returns the number of bytes read from the buffer, and an error if buf
+// isn't the canonical encoding of a point of the curve.
+func (pk *PublicKey) SetBytes(buf []byte) (int, error) {
+	if len(buf) < PublicKeySize {
+		return 0, io.ErrShortBuffer
+	}
+	return pk.A.SetBytes(buf[:PublicKeySize])
+}
+
+// Bytes returns the binary representation of privKey,
+// as byte array seed||publicKey where publicKey is as publicKey.Bytes().
+func (privKey *PrivateKey) Bytes() []byte {
+	var res [PrivateKeySize]byte
+	pubkBin := privKey.PublicKey.A.Bytes()
+	subtle.ConstantTimeCopy(1, res[:SeedSize], privKey.seed[:])
+	subtle.ConstantTimeCopy(1, res[SeedSize:], pubkBin[:])
+	return res[:]
+}
+
+// SetBytes sets privKey from buf, where buf is interpreted
+// as seed||publicKey where publicKey is as publicKey.Bytes().
+// The key is derived from the seed, and must match publicKey.
+// It returns the number byte read.
+func (privKey *PrivateKey) SetBytes(buf []byte) (int, error) {
+	if len(buf) < PrivateKeySize {
+		return 0, io.ErrShortBuffer
+	}
+	res, err := NewKeyFromSeed(buf[:SeedSize])
+	if err != nil {
+		return 0, err
+	}
+	pubkBin := res.PublicKey.A.Bytes()
+	if subtle.ConstantTimeCompare(pubkBin[:], buf[SeedSize:PrivateKeySize]) != 1 {
+		return 0, errWrongPublicKey
+	}
+	*privKey = *res
+	return PrivateKeySize, nil
+}
+
+// Bytes returns the binary representation of sig
+// as a byte array of size SignatureSize: R||S, where R is the
+// compressed encoding of the point and S the scalar in little endian.
+func (sig *Signature) Bytes() []byte {
+	var res [SignatureSize]byte
+	sigRBin := sig.R.Bytes()
+	var sBin [fr.Bytes]byte
+	fr.LittleEndian.PutElement(&sBin, sig.S)
+	subtle.ConstantTimeCopy(1, res[:SeedSize], sigRBin[:])
+	subtle.ConstantTimeCopy(1, res[SeedSize:SeedSize+fr.Bytes], sBin[:])
+	return res[:]
+}
+
+// SetBytes sets sig from a buffer in binary.
+// buf is read interpreted as R||S, see Bytes.
+// It returns the number of bytes read from buf, and an error if R isn't the
+// canonical encoding of a point of the curve, or if S is not reduced mod L.
+func (sig *Signature) SetBytes(buf []byte) (int, error) {
+	if len(buf) != SignatureSize {
+		return 0, errWrongSize
+	}
+
+	if _, err := sig.R.SetBytes(buf[:SeedSize]); err != nil {
+		return 0, err
+	}
+
+	// S < R_mod (to avoid malleability)
+	for _, b := range buf[SeedSize+fr.Bytes:] {
+		if b != 0 {
+			return 0, errSBiggerThanRMod
+		}
+	}
+	var sBin [fr.Bytes]byte
+	copy(sBin[:], buf[SeedSize:SeedSize+fr.Bytes])
+	s, err := fr.LittleEndian.Element(&sBin)
+	if err != nil {
+		return 0, errSBiggerThanRMod
+	}
+	sig.S = s
+
+	return SignatureSize, nil
+}
@@ -0,0 +1,67 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package edwards448
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc/edwards448/fp"
+)
+
+// CurveParams curve parameters: ax^2 + y^2 = 1 + d*x^2*y^2
+type CurveParams struct {
+	A, D     fp.Element
+	Cofactor fp.Element
+	Order    big.Int
+	Base     PointAffine
+}
+
+// GetEdwardsCurve returns the twisted Edwards curve edwards448
+func GetEdwardsCurve() CurveParams {
+	initOnce.Do(initCurveParams)
+	// copy to keep Order private
+	var res CurveParams
+
+	res.A.Set(&curveParams.A)
+	res.D.Set(&curveParams.D)
+	res.Cofactor.Set(&curveParams.Cofactor)
+	res.Order.Set(&curveParams.Order)
+	res.Base.Set(&curveParams.Base)
+
+	return res
+}
+
+var (
+	initOnce    sync.Once
+	curveParams CurveParams
+)
+
+func initCurveParams() {
+	curveParams.A.SetString("1")
+	curveParams.D.SetString("-39081")
+	curveParams.Cofactor.SetString("4")
+	curveParams.Order.SetString("181709681073901722637330951972001133588410340171829515070372549795146003961539585716195755291692375963310293709091662304773755859649779", 10)
+
+	curveParams.Base.X.SetString("224580040295924300187604334099896036246789641632564134246125461686950415467406032909029192869357953282578032075146446173674602635247710")
+	curveParams.Base.Y.SetString("298819210078481492676017930443930673437544040154080242095928241372331506189835876003536878655418784733982303233503462500531545062832660")
+}
+
+// mulByA multiplies fp.Element by curveParams.A
+func mulByA(x *fp.Element) {
+	// a = 1, nothing to do
+}
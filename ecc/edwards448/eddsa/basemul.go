@@ -0,0 +1,183 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package eddsa
+
+import (
+	"crypto/subtle"
+	"encoding/binary"
+	"math/big"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc/edwards448"
+	"github.com/consensys/gnark-crypto/ecc/edwards448/fp"
+	"github.com/consensys/gnark-crypto/ecc/edwards448/fr"
+)
+
+// The secret scalars (the key s and the nonces r) are only handled by the constant-time functions
+// below: the reduction of the hashes mod L, the fixed-base scalar multiplication, and the
+// computation of S. Verification, which only involves public values, uses the faster
+// variable-time arithmetic of the curve package.
+
+// windowSize size in bits of the windows of the fixed-base scalar multiplication
+const windowSize = 4
+
+// nbChunks number of fr.Limbs-word chunks of the largest buffer reduced mod L, a hash
+const nbChunks = (sizeHash + 8*fr.Limbs - 1) / (8 * fr.Limbs)
+
+var (
+	initOnce sync.Once
+
+	// baseTable [j]B for 0 ⩽ j < 2^windowSize
+	baseTable [1 << windowSize]edwards448.PointExtended
+
+	// montgomeryPowers Rⁱ⁺¹ mod L, with R = 2^(64⋅fr.Limbs) the Montgomery constant of fr
+	montgomeryPowers [nbChunks]fr.Element
+
+	// d parameter of the curve
+	d fp.Element
+)
+
+func initConstants() {
+	curveParams := edwards448.GetEdwardsCurve()
+	d.Set(&curveParams.D)
+
+	baseTable[0].X.SetZero()
+	baseTable[0].Y.SetOne()
+	baseTable[0].Z.SetOne()
+	baseTable[0].T.SetZero()
+	baseTable[1].FromAffine(&curveParams.Base)
+	for j := 2; j < len(baseTable); j++ {
+		baseTable[j].Add(&baseTable[j-1], &baseTable[1])
+	}
+
+	R := new(big.Int).Lsh(big.NewInt(1), 64*fr.Limbs)
+	power := new(big.Int).Set(R)
+	for i := range montgomeryPowers {
+		montgomeryPowers[i].SetBigInt(power)
+		power.Mul(power, R)
+	}
+}
+
+// setLittleEndian sets res to the integer encoded in little endian in buf, mod L.
+//
+// Its running time only depends on len(buf): writing buf = ∑ᵢ bᵢ⋅Rⁱ with bᵢ < R, the limbs of bᵢ are
+// the Montgomery form of bᵢ⋅R⁻¹, so that res = ∑ᵢ bᵢ⋅Rⁱ⁺¹ in Montgomery form, with constant-time
+// multiplications and additions.
+func setLittleEndian(res *fr.Element, buf []byte) {
+	initOnce.Do(initConstants)
+
+	var chunk [8 * fr.Limbs]byte
+	var b, t fr.Element
+	res.SetZero()
+	for i := 0; i*len(chunk) < len(buf); i++ {
+		chunk = [8 * fr.Limbs]byte{}
+		copy(chunk[:], buf[i*len(chunk):])
+		for j := range b {
+			b[j] = binary.LittleEndian.Uint64(chunk[8*j:])
+		}
+		t.MulCT(&b, &montgomeryPowers[i])
+		res.AddCT(res, &t)
+	}
+}
+
+// scalarMulBase sets res to [k]B, in constant time.
+//
+// k is processed from its most significant window of windowSize bits down, with a fixed number of
+// doublings and additions of points of a precomputed table, which are all read to select the one
+// to add. The addition and doubling formulas are complete on the curve, and only use the
+// constant-time arithmetic of fp.
+func scalarMulBase(res *edwards448.PointAffine, k *fr.Element) {
+	initOnce.Do(initConstants)
+
+	// the regular form of k
+	var kRegular fr.Element
+	kRegular.MulCT(k, &fr.Element{1})
+
+	var acc, q edwards448.PointExtended
+	acc.Set(&baseTable[0])
+	for i := fr.Limbs*64/windowSize - 1; i >= 0; i-- {
+		for j := 0; j < windowSize; j++ {
+			doubleCT(&acc, &acc)
+		}
+		window := int32((kRegular[i*windowSize/64] >> (i * windowSize % 64)) & (1<<windowSize - 1))
+		lookupCT(&q, window)
+		addCT(&acc, &acc, &q)
+	}
+
+	// to affine coordinates
+	var zInv fp.Element
+	zInv.InverseCT(&acc.Z)
+	res.X.MulCT(&acc.X, &zInv)
+	res.Y.MulCT(&acc.Y, &zInv)
+}
+
+// lookupCT sets res to baseTable[j], reading all the entries of the table
+func lookupCT(res *edwards448.PointExtended, j int32) {
+	for i := range baseTable {
+		c := subtle.ConstantTimeEq(int32(i), j)
+		res.X.Select(c, &res.X, &baseTable[i].X)
+		res.Y.Select(c, &res.Y, &baseTable[i].Y)
+		res.Z.Select(c, &res.Z, &baseTable[i].Z)
+		res.T.Select(c, &res.T, &baseTable[i].T)
+	}
+}
+
+// addCT sets p to p1 + p2 with the unified formulas of PointExtended.Add, complete since a = 1
+// is a square and d is not, and the constant-time arithmetic of fp
+func addCT(p, p1, p2 *edwards448.PointExtended) {
+	var A, B, C, D, E, F, G, H, tmp fp.Element
+	A.MulCT(&p1.X, &p2.X)
+	B.MulCT(&p1.Y, &p2.Y)
+	C.MulCT(&p1.T, &p2.T).MulCT(&C, &d)
+	D.MulCT(&p1.Z, &p2.Z)
+	tmp.AddCT(&p1.X, &p1.Y)
+	E.AddCT(&p2.X, &p2.Y).
+		MulCT(&E, &tmp).
+		SubCT(&E, &A).
+		SubCT(&E, &B)
+	F.SubCT(&D, &C)
+	G.AddCT(&D, &C)
+	H.SubCT(&B, &A)
+
+	p.X.MulCT(&E, &F)
+	p.Y.MulCT(&G, &H)
+	p.T.MulCT(&E, &H)
+	p.Z.MulCT(&F, &G)
+}
+
+// doubleCT sets p to 2⋅p1 with the formulas of PointExtended.Double and the constant-time
+// arithmetic of fp
+func doubleCT(p, p1 *edwards448.PointExtended) {
+	var A, B, C, D, E, F, G, H fp.Element
+	A.MulCT(&p1.X, &p1.X)
+	B.MulCT(&p1.Y, &p1.Y)
+	C.MulCT(&p1.Z, &p1.Z)
+	C.AddCT(&C, &C)
+	D.Set(&A)
+	E.AddCT(&p1.X, &p1.Y)
+	E.MulCT(&E, &E).
+		SubCT(&E, &A).
+		SubCT(&E, &B)
+	G.AddCT(&D, &B)
+	F.SubCT(&G, &C)
+	H.SubCT(&D, &B)
+
+	p.X.MulCT(&E, &F)
+	p.Y.MulCT(&G, &H)
+	p.T.MulCT(&H, &E)
+	p.Z.MulCT(&F, &G)
+}
@@ -0,0 +1,319 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package eddsa
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	crand "crypto/rand"
+	"crypto/sha256"
+
+	"golang.org/x/crypto/sha3"
+
+	"github.com/consensys/gnark-crypto/ecc/edwards448"
+	"github.com/consensys/gnark-crypto/ecc/edwards448/fr"
+)
+
+func Example() {
+	// create an Ed448 key pair
+	privateKey, _ := GenerateKey(crand.Reader)
+	publicKey := privateKey.PublicKey
+
+	// sign the message
+	msg := []byte("message to sign")
+	signature, _ := privateKey.Sign(msg, nil)
+
+	// verifies signature
+	isValid, _ := publicKey.Verify(signature, msg, nil)
+	if !isValid {
+		fmt.Println("1. invalid signature")
+	} else {
+		fmt.Println("1. valid signature")
+	}
+
+	// Output: 1. valid signature
+}
+
+// rfc8032Vector test vector of https://www.rfc-editor.org/rfc/rfc8032#section-7
+type rfc8032Vector struct {
+	seed, publicKey, message, signature string
+	opts                                Options
+}
+
+var rfc8032Vectors = []rfc8032Vector{
+	{ // 7.4, blank
+		seed:      "6c82a562cb808d10d632be89c8513ebf6c929f34ddfa8c9f63c9960ef6e348a3528c8a3fcc2f044e39a3fc5b94492f8f032e7549a20098f95b",
+		publicKey: "5fd7449b59b461fd2ce787ec616ad46a1da1342485a70e1f8a0ea75d80e96778edf124769b46c7061bd6783df1e50f6cd1fa1abeafe8256180",
+		message:   "",
+		signature: "533a37f6bbe457251f023c0d88f976ae2dfb504a843e34d2074fd823d41a591f2b233f034f628281f2fd7a22ddd47d7828c59bd0a21bfd3980ff0d2028d4b18a9df63e006c5d1c2d345b925d8dc00b4104852db99ac5c7cdda8530a113a0f4dbb61149f05a7363268c71d95808ff2e652600",
+	},
+	{ // 7.4, 1 octet
+		seed:      "c4eab05d357007c632f3dbb48489924d552b08fe0c353a0d4a1f00acda2c463afbea67c5e8d2877c5e3bc397a659949ef8021e954e0a12274e",
+		publicKey: "43ba28f430cdff456ae531545f7ecd0ac834a55d9358c0372bfa0c6c6798c0866aea01eb00742802b8438ea4cb82169c235160627b4c3a9480",
+		message:   "03",
+		signature: "26b8f91727bd62897af15e41eb43c377efb9c610d48f2335cb0bd0087810f4352541b143c4b981b7e18f62de8ccdf633fc1bf037ab7cd779805e0dbcc0aae1cbcee1afb2e027df36bc04dcecbf154336c19f0af7e0a6472905e799f1953d2a0ff3348ab21aa4adafd1d234441cf807c03a00",
+	},
+	{ // 7.4, 1 octet (with context)
+		seed:      "c4eab05d357007c632f3dbb48489924d552b08fe0c353a0d4a1f00acda2c463afbea67c5e8d2877c5e3bc397a659949ef8021e954e0a12274e",
+		publicKey: "43ba28f430cdff456ae531545f7ecd0ac834a55d9358c0372bfa0c6c6798c0866aea01eb00742802b8438ea4cb82169c235160627b4c3a9480",
+		message:   "03",
+		signature: "d4f8f6131770dd46f40867d6fd5d5055de43541f8c5e35abbcd001b32a89f7d2151f7647f11d8ca2ae279fb842d607217fce6e042f6815ea000c85741de5c8da1144a6a1aba7f96de42505d7a7298524fda538fccbbb754f578c1cad10d54d0d5428407e85dcbc98a49155c13764e66c3c00",
+		opts:      Options{Context: "foo"},
+	},
+	{ // 7.5, Ed448ph, the message being SHAKE256("abc", 64)
+		seed:      "833fe62409237b9d62ec77587520911e9a759cec1d19755b7da901b96dca3d42ef7822e0d5104127dc05d6dbefde69e3ab2cec7c867c6e2c49",
+		publicKey: "259b71c19f83ef77a7abd26524cbdb3161b590a48f7d17de3ee0ba9c52beb743c09428a131d6b1b57303d90d8132c276d5ed3d5d01c0f53880",
+		message:   "483366601360a8771c6863080cc4114d8db44530f8f1e1ee4f94ea37e78b5739d5a15bef186a5386c75744c0527e1faa9f8726e462a12a4feb06bd8801e751e4",
+		signature: "822f6901f7480f3d5f562c592994d9693602875614483256505600bbc281ae381f54d6bce2ea911574932f52a4e6cadd78769375ec3ffd1b801a0d9b3f4030cd433964b6457ea39476511214f97469b57dd32dbc560a9a94d00bff07620464a3ad203df7dc7ce360c3cd3696d9d9fab90f00",
+		opts:      Options{PreHashed: true},
+	},
+}
+
+func decodeHex(t *testing.T, s string) []byte {
+	res, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res
+}
+
+func TestRFC8032Vectors(t *testing.T) {
+	for i, v := range rfc8032Vectors {
+		privKey, err := NewKeyFromSeed(decodeHex(t, v.seed))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(privKey.PublicKey.Bytes(), decodeHex(t, v.publicKey)) {
+			t.Fatalf("vector %d: wrong public key", i)
+		}
+
+		message := decodeHex(t, v.message)
+		sig, err := privKey.SignWithOptions(message, &v.opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(sig, decodeHex(t, v.signature)) {
+			t.Fatalf("vector %d: wrong signature", i)
+		}
+
+		var pub PublicKey
+		if _, err = pub.SetBytes(decodeHex(t, v.publicKey)); err != nil {
+			t.Fatal(err)
+		}
+		res, err := pub.VerifyWithOptions(sig, message, &v.opts)
+		if err != nil || !res {
+			t.Fatalf("vector %d: verification failed", i)
+		}
+
+		// the signature is bound to the variant of the scheme
+		wrongOpts := v.opts
+		wrongOpts.Context = "bar"
+		res, err = pub.VerifyWithOptions(sig, message, &wrongOpts)
+		if err != nil || res {
+			t.Fatalf("vector %d: verification with another context should fail", i)
+		}
+	}
+}
+
+func TestSigner(t *testing.T) {
+	src := rand.NewSource(0)
+	r := rand.New(src) //#nosec G404 weak rng is fine here
+
+	privKey, err := GenerateKey(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubKey := privKey.Public()
+	message := []byte("message")
+
+	// pure Ed448, and Ed448ph with a pre-hash
+	for _, hFunc := range []func() hash.Hash{nil, func() hash.Hash { return sha3.NewShake256() }} {
+		var h hash.Hash
+		if hFunc != nil {
+			h = hFunc()
+		}
+		sig, err := privKey.Sign(message, h)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := pubKey.Verify(sig, message, h)
+		if err != nil || !res {
+			t.Fatal("Verify correct signature should return true")
+		}
+		res, err = pubKey.Verify(sig, []byte("wrong_message"), h)
+		if err != nil || res {
+			t.Fatal("Verify wrong signature should be false")
+		}
+	}
+
+	// the pre-hash must be of size PreHashSize
+	if _, err = privKey.Sign(message, sha256.New()); err != errPreHashSize {
+		t.Fatal("Sign should fail with a pre-hash of the wrong size")
+	}
+	if _, err = privKey.SignWithOptions(message, &Options{Context: string(make([]byte, MaxContextSize+1))}); err != errContextTooLong {
+		t.Fatal("Sign should fail with a context too long")
+	}
+
+	// keys round trip
+	var privKey2 PrivateKey
+	if _, err = privKey2.SetBytes(privKey.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(privKey2.Bytes(), privKey.Bytes()) || !bytes.Equal(privKey2.Seed(), privKey.Seed()) {
+		t.Fatal("private key round trip failed")
+	}
+	wrongPrivKey := privKey.Bytes()
+	wrongPrivKey[0] ^= 1
+	if _, err = privKey2.SetBytes(wrongPrivKey); err != errWrongPublicKey {
+		t.Fatal("SetBytes should fail when the public key doesn't match the seed")
+	}
+
+	var pubKey2 PublicKey
+	if _, err = pubKey2.SetBytes(pubKey.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+	if !pubKey2.Equal(pubKey) {
+		t.Fatal("public key round trip failed")
+	}
+}
+
+func TestNonMalleability(t *testing.T) {
+	src := rand.NewSource(0)
+	r := rand.New(src) //#nosec G404 weak rng is fine here
+
+	privKey, err := GenerateKey(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	message := []byte("message")
+	sig, err := privKey.Sign(message, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// S + L is rejected
+	var s Signature
+	if _, err = s.SetBytes(sig); err != nil {
+		t.Fatal(err)
+	}
+	var sPlusL [SeedSize]byte
+	var carry uint16
+	l := fr.Modulus().FillBytes(make([]byte, SeedSize))
+	var sBin [fr.Bytes]byte
+	fr.LittleEndian.PutElement(&sBin, s.S)
+	for i := 0; i < SeedSize; i++ {
+		carry += uint16(l[SeedSize-1-i])
+		if i < fr.Bytes {
+			carry += uint16(sBin[i])
+		}
+		sPlusL[i] = byte(carry)
+		carry >>= 8
+	}
+	malleated := make([]byte, SignatureSize)
+	copy(malleated, sig[:SeedSize])
+	copy(malleated[SeedSize:], sPlusL[:])
+	if res, err := privKey.PublicKey.Verify(malleated, message, nil); err != errSBiggerThanRMod || res {
+		t.Fatal("Verify should reject S >= L")
+	}
+
+	// non canonical encoding of R, with y >= p
+	nonCanonical := make([]byte, SignatureSize)
+	copy(nonCanonical, sig)
+	for i := 0; i < SeedSize; i++ {
+		nonCanonical[i] = 0xff
+	}
+	if res, err := privKey.PublicKey.Verify(nonCanonical, message, nil); err == nil || res {
+		t.Fatal("Verify should reject non canonical encodings of R")
+	}
+
+	// wrong size
+	if _, err = s.SetBytes(sig[1:]); err != errWrongSize {
+		t.Fatal("SetBytes should raise wrong size error")
+	}
+}
+
+func TestConstantTimeScalars(t *testing.T) {
+	src := rand.NewSource(0)
+	r := rand.New(src) //#nosec G404 weak rng is fine here
+
+	curveParams := edwards448.GetEdwardsCurve()
+	L := fr.Modulus()
+
+	// setLittleEndian matches the reduction mod L with math/big
+	for _, size := range []int{0, 1, SeedSize, sizeHash} {
+		buf := make([]byte, size)
+		r.Read(buf)
+		if size > 0 {
+			buf[size-1] = 0xff
+		}
+		bigEndian := make([]byte, size)
+		for i := range buf {
+			bigEndian[size-1-i] = buf[i]
+		}
+		var expected, res fr.Element
+		expected.SetBigInt(new(big.Int).SetBytes(bigEndian))
+		setLittleEndian(&res, buf)
+		if !res.Equal(&expected) {
+			t.Fatalf("setLittleEndian of %d bytes doesn't match math/big", size)
+		}
+	}
+
+	// scalarMulBase matches ScalarMultiplication
+	scalars := []*big.Int{big.NewInt(0), big.NewInt(1), big.NewInt(15), big.NewInt(16), new(big.Int).Sub(L, big.NewInt(1))}
+	for i := 0; i < 10; i++ {
+		scalars = append(scalars, new(big.Int).Rand(r, L))
+	}
+	for _, s := range scalars {
+		var k fr.Element
+		k.SetBigInt(s)
+		var expected, res edwards448.PointAffine
+		expected.ScalarMultiplication(&curveParams.Base, s)
+		scalarMulBase(&res, &k)
+		if !res.Equal(&expected) {
+			t.Fatalf("scalarMulBase doesn't match ScalarMultiplication for %s", s.String())
+		}
+	}
+}
+
+// benchmarks
+
+func BenchmarkSign(b *testing.B) {
+	privKey, _ := GenerateKey(crand.Reader)
+	message := []byte("message to sign")
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = privKey.Sign(message, nil)
+	}
+}
+
+func BenchmarkVerify(b *testing.B) {
+	privKey, _ := GenerateKey(crand.Reader)
+	message := []byte("message to sign")
+	sig, _ := privKey.Sign(message, nil)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = privKey.PublicKey.Verify(sig, message, nil)
+	}
+}
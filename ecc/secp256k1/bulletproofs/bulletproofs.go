@@ -0,0 +1,378 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bulletproofs
+
+import (
+	"errors"
+	"hash"
+	"math/big"
+	"math/bits"
+	"strconv"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	curve "github.com/consensys/gnark-crypto/ecc/secp256k1"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/ipa"
+	"github.com/consensys/gnark-crypto/fiat-shamir"
+)
+
+var (
+	ErrInvalidNbBits        = errors.New("the number of bits must be a power of 2, at most 64")
+	ErrInvalidNbValues      = errors.New("invalid number of values (zero, not a power of 2, more than the generators allow, or not the number of blindings)")
+	ErrValueOutOfRange      = errors.New("a value is out of range")
+	ErrInvalidProofSize     = errors.New("the number of rounds of the proof doesn't match the number of values")
+	ErrInvalidNbCommitments = errors.New("number of commitment vectors is not the same as the number of proofs")
+	ErrZeroChallenge        = errors.New("a challenge is zero")
+	ErrVerifyRangeProof     = errors.New("can't verify range proof")
+)
+
+// Generators public parameters of the range proofs on n-bit values, aggregating up to m values.
+type Generators struct {
+	// NbBits n
+	NbBits int
+
+	// G, H Pedersen generators of the value commitments v⋅G + γ⋅H
+	G, H curve.G1Affine
+
+	// GVec, HVec vector generators, of size n⋅m
+	GVec, HVec []curve.G1Affine
+
+	// U generator binding the inner product
+	U curve.G1Affine
+}
+
+// Proof aggregated range proof
+type Proof struct {
+	// A, S commitments to the bits of the values and to the blinding vectors sₗ, sᵣ
+	A, S curve.G1Affine
+
+	// T1, T2 commitments to the coefficients of t(X) = ⟨l(X), r(X)⟩
+	T1, T2 curve.G1Affine
+
+	// TauX, Mu blinding factors of t(x) and of A + x⋅S
+	TauX, Mu fr.Element
+
+	// THat t(x) = ⟨l(x), r(x)⟩
+	THat fr.Element
+
+	// InnerProduct proof that THat = ⟨l(x), r(x)⟩
+	InnerProduct InnerProductProof
+}
+
+// NewGenerators derives from seed the generators of range proofs on nbBits-bit values, aggregating
+// up to maxNbValues values. Both must be powers of 2, with nbBits ≤ 64.
+func NewGenerators(nbBits, maxNbValues int, seed []byte) (*Generators, error) {
+	if nbBits <= 0 || nbBits > 64 || !isPowerOfTwo(nbBits) {
+		return nil, ErrInvalidNbBits
+	}
+	if maxNbValues <= 0 || !isPowerOfTwo(maxNbValues) {
+		return nil, ErrInvalidNbValues
+	}
+	n := nbBits * maxNbValues
+
+	vec, err := ipa.NewSRS(uint64(2*n), seed)
+	if err != nil {
+		return nil, err
+	}
+	pedersen, err := ipa.NewSRS(1, append([]byte("pedersen:"), seed...))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Generators{
+		NbBits: nbBits,
+		G:      pedersen.Basis[0],
+		H:      pedersen.Q,
+		GVec:   vec.Basis[:n:n],
+		HVec:   vec.Basis[n:],
+		U:      vec.Q,
+	}, nil
+}
+
+// Commit returns the Pedersen commitment value⋅G + blinding⋅H
+func (gens *Generators) Commit(value, blinding fr.Element) curve.G1Affine {
+	var v, b big.Int
+	var p curve.G1Jac
+	p.JointScalarMultiplication(&gens.G, &gens.H, value.BigInt(&v), blinding.BigInt(&b))
+
+	var res curve.G1Affine
+	res.FromJacobian(&p)
+	return res
+}
+
+// Prove computes an aggregated proof that the values, committed with the given blindings (see
+// Generators.Commit), are in [0, 2ⁿ). It's an interactive protocol, made non-interactive using
+// Fiat Shamir; dataTranscript is extra data bound to the challenges.
+//
+// If the number of values isn't a power of 2, it is padded with zero values committed with zero
+// blindings, that is with the neutral element.
+func Prove(values, blindings []fr.Element, gens *Generators, hf hash.Hash, dataTranscript ...[]byte) (Proof, error) {
+	if len(values) == 0 || len(values) != len(blindings) {
+		return Proof{}, ErrInvalidNbValues
+	}
+	nbBits := gens.NbBits
+	m := int(ecc.NextPowerOfTwo(uint64(len(values))))
+	n := nbBits * m
+	if n > len(gens.GVec) {
+		return Proof{}, ErrInvalidNbValues
+	}
+	gVec, hVec := gens.GVec[:n], gens.HVec[:n]
+
+	// aₗ bits of the values, aᵣ = aₗ - 1
+	aL := make([]fr.Element, n)
+	aR := make([]fr.Element, n)
+	var one, z, x fr.Element
+	one.SetOne()
+	var v big.Int
+	for j := range values {
+		values[j].BigInt(&v)
+		if v.BitLen() > nbBits {
+			return Proof{}, ErrValueOutOfRange
+		}
+		for i := 0; i < nbBits; i++ {
+			if v.Bit(i) == 1 {
+				aL[j*nbBits+i].SetOne()
+			}
+		}
+	}
+	for i := range aR {
+		aR[i].Sub(&aL[i], &one)
+	}
+
+	commitments := make([]curve.G1Affine, m)
+	for j := range values {
+		commitments[j] = gens.Commit(values[j], blindings[j])
+	}
+
+	var res Proof
+
+	// A = α⋅H + ⟨aₗ, G⟩ + ⟨aᵣ, H⟩, S = ρ⋅H + ⟨sₗ, G⟩ + ⟨sᵣ, H⟩
+	alpha, err := randomVector(1)
+	if err != nil {
+		return Proof{}, err
+	}
+	rho, err := randomVector(1)
+	if err != nil {
+		return Proof{}, err
+	}
+	sL, err := randomVector(n)
+	if err != nil {
+		return Proof{}, err
+	}
+	sR, err := randomVector(n)
+	if err != nil {
+		return Proof{}, err
+	}
+	if res.A, err = multiExp(concatPoints([]curve.G1Affine{gens.H}, gVec, hVec), concatScalars(alpha, aL, aR)); err != nil {
+		return Proof{}, err
+	}
+	if res.S, err = multiExp(concatPoints([]curve.G1Affine{gens.H}, gVec, hVec), concatScalars(rho, sL, sR)); err != nil {
+		return Proof{}, err
+	}
+
+	nbRounds := bits.TrailingZeros(uint(n))
+	fs := newTranscript(hf, nbBits, commitments, nbRounds, dataTranscript)
+	y, err := bindAndDeriveChallenge(fs, "y", marshal(&res.A), marshal(&res.S))
+	if err != nil {
+		return Proof{}, err
+	}
+	if z, err = bindAndDeriveChallenge(fs, "z"); err != nil {
+		return Proof{}, err
+	}
+
+	// l(X) = (aₗ - z⋅1) + sₗ⋅X
+	// r(X) = yⁿ∘(aᵣ + z⋅1 + sᵣ⋅X) + ∑ⱼ z²⁺ʲ⋅(0,...,0,2ⁿ,0,...,0)
+	yPow := powers(y, n)
+	d := rangeCoefficients(z, nbBits, m)
+	l0, l1 := aL, sL
+	r0, r1 := aR, sR
+	for i := range l0 {
+		l0[i].Sub(&l0[i], &z)
+		r0[i].Add(&r0[i], &z).
+			Mul(&r0[i], &yPow[i]).
+			Add(&r0[i], &d[i])
+		r1[i].Mul(&r1[i], &yPow[i])
+	}
+
+	// t(X) = t₀ + t₁⋅X + t₂⋅X², with t₁ = ⟨l₀, r₁⟩ + ⟨l₁, r₀⟩ and t₂ = ⟨l₁, r₁⟩
+	t1 := innerProduct(l0, r1)
+	t := innerProduct(l1, r0)
+	t1.Add(&t1, &t)
+	t2 := innerProduct(l1, r1)
+	tau, err := randomVector(2)
+	if err != nil {
+		return Proof{}, err
+	}
+	res.T1 = gens.Commit(t1, tau[0])
+	res.T2 = gens.Commit(t2, tau[1])
+
+	if x, err = bindAndDeriveChallenge(fs, "x", marshal(&res.T1), marshal(&res.T2)); err != nil {
+		return Proof{}, err
+	}
+
+	// l = l(x), r = r(x)
+	for i := range l0 {
+		t.Mul(&l1[i], &x)
+		l0[i].Add(&l0[i], &t)
+		t.Mul(&r1[i], &x)
+		r0[i].Add(&r0[i], &t)
+	}
+	res.THat = innerProduct(l0, r0)
+
+	// τₓ = τ₂⋅x² + τ₁⋅x + ∑ⱼ z²⁺ʲ⋅γⱼ, μ = α + ρ⋅x
+	res.TauX.Mul(&tau[1], &x).
+		Add(&res.TauX, &tau[0]).
+		Mul(&res.TauX, &x)
+	var zPow fr.Element
+	zPow.Square(&z)
+	for j := range blindings {
+		t.Mul(&zPow, &blindings[j])
+		res.TauX.Add(&res.TauX, &t)
+		zPow.Mul(&zPow, &z)
+	}
+	res.Mu.Mul(&rho[0], &x).Add(&res.Mu, &alpha[0])
+
+	w, err := bindAndDeriveChallenge(fs, "w", res.TauX.Marshal(), res.Mu.Marshal(), res.THat.Marshal())
+	if err != nil {
+		return Proof{}, err
+	}
+
+	// H'ᵢ = y⁻ⁱ⋅Hᵢ, so that ⟨r, H'⟩ commits to r with the generators of r(X)
+	var yInv fr.Element
+	yInv.Inverse(&y)
+	hPrime := scalePoints(hVec, powers(yInv, n))
+	var u curve.G1Affine
+	var wBigInt big.Int
+	u.ScalarMultiplication(&gens.U, w.BigInt(&wBigInt))
+
+	if res.InnerProduct, err = proveInnerProduct(fs, l0, r0, gVec, hPrime, &u); err != nil {
+		return Proof{}, err
+	}
+
+	return res, nil
+}
+
+// newTranscript returns a transcript with the challenges y, z, x, w, and one challenge per round of
+// the inner-product argument, the number of bits and the commitments being bound to y
+func newTranscript(hf hash.Hash, nbBits int, commitments []curve.G1Affine, nbRounds int, dataTranscript [][]byte) *fiatshamir.Transcript {
+	challenges := []string{"y", "z", "x", "w"}
+	for j := 0; j < nbRounds; j++ {
+		challenges = append(challenges, roundChallengeName(j))
+	}
+	fs := fiatshamir.NewTranscript(hf, challenges...)
+
+	toBind := [][]byte{{byte(nbBits)}}
+	for i := range commitments {
+		toBind = append(toBind, marshal(&commitments[i]))
+	}
+	toBind = append(toBind, dataTranscript...)
+	for _, b := range toBind {
+		// can't fail, the challenge "y" exists and isn't computed yet
+		_ = fs.Bind("y", b)
+	}
+	return fs
+}
+
+func roundChallengeName(j int) string {
+	return "ipa" + strconv.Itoa(j)
+}
+
+// bindAndDeriveChallenge binds data to the challenge of the given name, and computes it as a
+// non-zero field element
+func bindAndDeriveChallenge(fs *fiatshamir.Transcript, name string, data ...[]byte) (fr.Element, error) {
+	for _, b := range data {
+		if err := fs.Bind(name, b); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	b, err := fs.ComputeChallenge(name)
+	if err != nil {
+		return fr.Element{}, err
+	}
+	var res fr.Element
+	res.SetBytes(b)
+	if res.IsZero() {
+		return fr.Element{}, ErrZeroChallenge
+	}
+	return res, nil
+}
+
+// rangeCoefficients returns the vector d, with d[j⋅n+k] = z²⁺ʲ⋅2ᵏ, for j < m and k < n
+func rangeCoefficients(z fr.Element, n, m int) []fr.Element {
+	res := make([]fr.Element, n*m)
+	var zPow fr.Element
+	zPow.Square(&z)
+	for j := 0; j < m; j++ {
+		block := res[j*n : (j+1)*n]
+		block[0].Set(&zPow)
+		for k := 1; k < n; k++ {
+			block[k].Double(&block[k-1])
+		}
+		zPow.Mul(&zPow, &z)
+	}
+	return res
+}
+
+// powers returns (1, x, x², ..., xⁿ⁻¹)
+func powers(x fr.Element, n int) []fr.Element {
+	res := make([]fr.Element, n)
+	res[0].SetOne()
+	for i := 1; i < n; i++ {
+		res[i].Mul(&res[i-1], &x)
+	}
+	return res
+}
+
+// innerProduct returns ∑ᵢ a[i]⋅b[i], with len(a) = len(b)
+func innerProduct(a, b []fr.Element) fr.Element {
+	var res, t fr.Element
+	for i := range a {
+		t.Mul(&a[i], &b[i])
+		res.Add(&res, &t)
+	}
+	return res
+}
+
+func randomVector(n int) ([]fr.Element, error) {
+	res := make([]fr.Element, n)
+	for i := range res {
+		if _, err := res[i].SetRandom(); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func concatPoints(points ...[]curve.G1Affine) []curve.G1Affine {
+	var res []curve.G1Affine
+	for _, p := range points {
+		res = append(res, p...)
+	}
+	return res
+}
+
+func concatScalars(scalars ...[]fr.Element) []fr.Element {
+	var res []fr.Element
+	for _, s := range scalars {
+		res = append(res, s...)
+	}
+	return res
+}
+
+func isPowerOfTwo(n int) bool {
+	return n&(n-1) == 0
+}
@@ -0,0 +1,24 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package bulletproofs provides aggregated range proofs (Bünz et al., https://eprint.iacr.org/2017/1066):
+// given Pedersen commitments Vⱼ = vⱼ⋅G + γⱼ⋅H to m values, a prover convinces a verifier that every
+// vⱼ lies in [0, 2ⁿ), with a proof of 2⋅log₂(n⋅m) + 4 group elements and 5 scalars.
+//
+// There is no trusted setup: the generators are derived from a public seed with the ipa package.
+// Proofs are made non-interactive with Fiat Shamir, and a verifier checks one or several proofs
+// with a single multi-scalar multiplication.
+package bulletproofs
@@ -0,0 +1,152 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bulletproofs
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	curve "github.com/consensys/gnark-crypto/ecc/secp256k1"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
+	"github.com/consensys/gnark-crypto/fiat-shamir"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// InnerProductProof proof that P = ⟨a, G⟩ + ⟨b, H⟩ + ⟨a, b⟩⋅U, for secret vectors a and b
+// (protocol 2 of the Bulletproofs paper).
+type InnerProductProof struct {
+	// L, R commitments to the cross terms of each folding round
+	L, R []curve.G1Affine
+
+	// A, B the vectors a and b, folded down to a single element
+	A, B fr.Element
+}
+
+// proveInnerProduct runs the inner-product argument on a, b with the generators g, h and u, the
+// challenges being derived from fs. a and b are modified.
+func proveInnerProduct(fs *fiatshamir.Transcript, a, b []fr.Element, g, h []curve.G1Affine, u *curve.G1Affine) (InnerProductProof, error) {
+	var res InnerProductProof
+	var x, xInv, t fr.Element
+	var err error
+	for j := 0; len(a) > 1; j++ {
+		m := len(a) / 2
+		aLo, aHi := a[:m], a[m:]
+		bLo, bHi := b[:m], b[m:]
+		gLo, gHi := g[:m], g[m:]
+		hLo, hHi := h[:m], h[m:]
+
+		// L = ⟨a_lo, G_hi⟩ + ⟨b_hi, H_lo⟩ + ⟨a_lo, b_hi⟩⋅U, R = ⟨a_hi, G_lo⟩ + ⟨b_lo, H_hi⟩ + ⟨a_hi, b_lo⟩⋅U
+		var l, r curve.G1Affine
+		cL, cR := innerProduct(aLo, bHi), innerProduct(aHi, bLo)
+		if l, err = multiExp(concatPoints(gHi, hLo, []curve.G1Affine{*u}), concatScalars(aLo, bHi, []fr.Element{cL})); err != nil {
+			return InnerProductProof{}, err
+		}
+		if r, err = multiExp(concatPoints(gLo, hHi, []curve.G1Affine{*u}), concatScalars(aHi, bLo, []fr.Element{cR})); err != nil {
+			return InnerProductProof{}, err
+		}
+		res.L = append(res.L, l)
+		res.R = append(res.R, r)
+
+		if x, err = bindAndDeriveChallenge(fs, roundChallengeName(j), marshal(&l), marshal(&r)); err != nil {
+			return InnerProductProof{}, err
+		}
+		xInv.Inverse(&x)
+
+		// a ← x⋅a_lo + x⁻¹⋅a_hi, b ← x⁻¹⋅b_lo + x⋅b_hi, G ← x⁻¹⋅G_lo + x⋅G_hi, H ← x⋅H_lo + x⁻¹⋅H_hi,
+		// so that P ← x²⋅L + P + x⁻²⋅R
+		for i := 0; i < m; i++ {
+			aLo[i].Mul(&aLo[i], &x)
+			t.Mul(&aHi[i], &xInv)
+			aLo[i].Add(&aLo[i], &t)
+			bLo[i].Mul(&bLo[i], &xInv)
+			t.Mul(&bHi[i], &x)
+			bLo[i].Add(&bLo[i], &t)
+		}
+		a, b = aLo, bLo
+		g = foldPoints(gLo, gHi, xInv, x)
+		h = foldPoints(hLo, hHi, x, xInv)
+	}
+	res.A, res.B = a[0], b[0]
+
+	return res, nil
+}
+
+// foldingCoefficients returns the vector s with sᵢ = ∏ⱼ xⱼ^(±1), the sign being the bit of i split
+// at round j (most significant first), so that the generators folded by the prover are ∑ᵢ sᵢ⋅Gᵢ
+// and ∑ᵢ sᵢ⁻¹⋅Hᵢ. The coefficients sᵢ⁻¹ are the ones of s in reverse order.
+func foldingCoefficients(x []fr.Element) []fr.Element {
+	n := 1 << len(x)
+	res := make([]fr.Element, n)
+
+	// s₀ = ∏ⱼ xⱼ⁻¹, and setting the bit of round j multiplies by xⱼ²
+	res[0].SetOne()
+	for j := range x {
+		res[0].Mul(&res[0], &x[j])
+	}
+	res[0].Inverse(&res[0])
+	var xSquare fr.Element
+	for j, size := 0, 1; j < len(x); j, size = j+1, size*2 {
+		xSquare.Square(&x[j])
+		for i := size - 1; i >= 0; i-- {
+			res[2*i+1].Mul(&res[i], &xSquare)
+			res[2*i] = res[i]
+		}
+	}
+	return res
+}
+
+// multiExp computes ∑ᵢ scalars[i]⋅points[i]
+func multiExp(points []curve.G1Affine, scalars []fr.Element) (curve.G1Affine, error) {
+	var res curve.G1Affine
+	if _, err := res.MultiExp(points, scalars, ecc.MultiExpConfig{}); err != nil {
+		return curve.G1Affine{}, err
+	}
+	return res, nil
+}
+
+// scalePoints returns the points scalars[i]⋅points[i]
+func scalePoints(points []curve.G1Affine, scalars []fr.Element) []curve.G1Affine {
+	res := make([]curve.G1Jac, len(points))
+	parallel.Execute(len(points), func(start, end int) {
+		var s big.Int
+		for i := start; i < end; i++ {
+			res[i].FromAffine(&points[i])
+			res[i].ScalarMultiplication(&res[i], scalars[i].BigInt(&s))
+		}
+	})
+	return curve.BatchJacobianToAffineG1(res)
+}
+
+// foldPoints returns the points sLo⋅lo[i] + sHi⋅hi[i]
+func foldPoints(lo, hi []curve.G1Affine, sLo, sHi fr.Element) []curve.G1Affine {
+	res := make([]curve.G1Jac, len(lo))
+	var s1, s2 big.Int
+	sLo.BigInt(&s1)
+	sHi.BigInt(&s2)
+	parallel.Execute(len(lo), func(start, end int) {
+		for i := start; i < end; i++ {
+			res[i].JointScalarMultiplication(&lo[i], &hi[i], &s1, &s2)
+		}
+	})
+	return curve.BatchJacobianToAffineG1(res)
+}
+
+// marshal returns the encoding of p bound to the transcripts
+func marshal(p *curve.G1Affine) []byte {
+	b := p.RawBytes()
+	return b[:]
+}
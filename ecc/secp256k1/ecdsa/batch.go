@@ -0,0 +1,160 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ecdsa
+
+import (
+	"crypto/rand"
+	"errors"
+	"hash"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var errBatchSize = errors.New("pubs, msgs, vs and sigs must have the same length")
+
+// sizeBatchCoefficient size in bytes of the random coefficients of the linear combination
+const sizeBatchCoefficient = 16
+
+// batchEntry a signature to verify in a batch, once deserialized: zᵢ⋅Rᵢ = zᵢ⋅u₁⋅g + zᵢ⋅u₂⋅Qᵢ
+// with the random coefficient zᵢ
+type batchEntry struct {
+	R, Q     secp256k1.G1Affine
+	z        fr.Element // zᵢ
+	zu1, zu2 fr.Element // zᵢ⋅u₁, zᵢ⋅u₂
+}
+
+// BatchVerify verifies the ECDSA signatures sigs of the messages msgs by the public keys
+// pubs, as PublicKey.Verify does for each of them. vs are the public key recovery
+// information returned by SignForRecover along with the signatures, from which the
+// points Rᵢ are recovered (SEC 1, Version 2.0, Section 4.1.6).
+//
+// The signatures are checked all at once with a random linear combination of the
+// verification equations, and a single multi-exponentiation: with random 128-bit zᵢ,
+//
+//	(∑ᵢ zᵢ⋅u₁ᵢ)⋅g + ∑ᵢ zᵢ⋅u₂ᵢ⋅Qᵢ - ∑ᵢ zᵢ⋅Rᵢ = 0
+//
+// The curve has prime order, so that the recovered Rᵢ are in the subgroup generated by g.
+// If the check fails, the batch is split in halves to find the invalid signature.
+//
+// It returns -1 if all the signatures are valid, and otherwise the index of the first invalid one,
+// along with an error if this signature is malformed or if Rᵢ can't be recovered: the signatures
+// before the first malformed one are checked, and an invalid one among them takes precedence. An
+// error is returned with the index -1 if the inputs don't have the same length.
+func BatchVerify(pubs []PublicKey, msgs [][]byte, vs []uint, sigs [][]byte, hFunc hash.Hash) (int, error) {
+	if len(pubs) != len(msgs) || len(pubs) != len(vs) || len(pubs) != len(sigs) {
+		return -1, errBatchSize
+	}
+
+	// deserialize the signatures and compute the coefficients of the linear combination, up to
+	// the first malformed signature
+	entries := make([]batchEntry, len(pubs))
+	malformed := -1
+	var errMalformed error
+	var zBytes [sizeBatchCoefficient]byte
+	for i := range entries {
+		e := &entries[i]
+
+		r, u1, u2, err := verifyScalars(sigs[i], msgs[i], hFunc)
+		if err != nil {
+			// a high S is invalid, but not malformed
+			if err == errHighS {
+				err = nil
+			}
+			malformed, errMalformed = i, err
+			break
+		}
+		R, err := recoverP(vs[i], r)
+		if err != nil {
+			malformed, errMalformed = i, err
+			break
+		}
+		e.R.Set(R)
+		e.Q.Set(&pubs[i].A)
+
+		if _, err := rand.Read(zBytes[:]); err != nil {
+			return -1, err
+		}
+		e.z.SetBytes(zBytes[:])
+		e.zu1.SetBigInt(u1)
+		e.zu1.Mul(&e.zu1, &e.z)
+		e.zu2.SetBigInt(u2)
+		e.zu2.Mul(&e.zu2, &e.z)
+	}
+
+	// an invalid signature before the first malformed one is reported first
+	if malformed != -1 {
+		entries = entries[:malformed]
+	}
+	if len(entries) != 0 {
+		ok, err := batchCheck(entries)
+		if err != nil {
+			return -1, err
+		}
+		if !ok {
+			return firstInvalid(entries, 0)
+		}
+	}
+	return malformed, errMalformed
+}
+
+// firstInvalid returns the index of the first invalid signature in entries, which don't verify
+// as a batch, offset being the index of entries[0] in the batch
+func firstInvalid(entries []batchEntry, offset int) (int, error) {
+	if len(entries) == 1 {
+		return offset, nil
+	}
+	m := len(entries) / 2
+	ok, err := batchCheck(entries[:m])
+	if err != nil {
+		return -1, err
+	}
+	if !ok {
+		return firstInvalid(entries[:m], offset)
+	}
+	return firstInvalid(entries[m:], offset+m)
+}
+
+// batchCheck returns true if the random linear combination of the verification equations of
+// entries holds
+func batchCheck(entries []batchEntry) (bool, error) {
+	n := len(entries)
+
+	// the points g, R₀, ..., Rₙ₋₁, Q₀, ..., Qₙ₋₁ and the scalars ∑ᵢ zᵢ⋅u₁ᵢ, -zᵢ, zᵢ⋅u₂ᵢ
+	points := make([]secp256k1.G1Affine, 2*n+1)
+	scalars := make([]fr.Element, 2*n+1)
+	_, points[0] = secp256k1.Generators()
+	parallel.Execute(n, func(start, end int) {
+		for i := start; i < end; i++ {
+			points[1+i].Set(&entries[i].R)
+			points[1+n+i].Set(&entries[i].Q)
+			scalars[1+i].Neg(&entries[i].z)
+			scalars[1+n+i].Set(&entries[i].zu2)
+		}
+	})
+	for i := range entries {
+		scalars[0].Add(&scalars[0], &entries[i].zu1)
+	}
+
+	var res secp256k1.G1Jac
+	if _, err := res.MultiExp(points, scalars, ecc.MultiExpConfig{}); err != nil {
+		return false, err
+	}
+	return res.Z.IsZero(), nil
+}
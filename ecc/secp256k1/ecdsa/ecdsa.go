@@ -51,6 +51,9 @@ var (
 
 var order = fr.Modulus()
 
+// halfOrder is ⌊order/2⌋, the largest s accepted by Verify (EIP-2)
+var halfOrder = new(big.Int).Rsh(order, 1)
+
 // PublicKey represents an ECDSA public key
 type PublicKey struct {
 	A secp256k1.G1Affine
@@ -136,6 +139,9 @@ func recoverP(v uint, r *big.Int) (*secp256k1.G1Affine, error) {
 	kn := big.NewInt(int64(xChoice))
 	kn.Mul(kn, fr.Modulus())
 	x.Add(x, kn)
+	if x.Cmp(fp.Modulus()) >= 0 {
+		return nil, errors.New("x is larger than the base field modulus")
+	}
 	// y^2 = x^3+ax+b
 	a, b := secp256k1.CurveCoefficients()
 	y := new(big.Int).Exp(x, big.NewInt(3), fp.Modulus())
@@ -242,6 +248,9 @@ func (privKey *PrivateKey) Public() signature.PublicKey {
 // s = k⁻¹ . (m + sk ⋅ r)
 // v = (div(x_P, order)<<1) || y_P[-1]
 //
+// s is normalized to the lower half of the scalar field (EIP-2), and v is
+// adjusted accordingly.
+//
 // SEC 1, Version 2.0, Section 4.1.3
 func (privKey *PrivateKey) SignForRecover(message []byte, hFunc hash.Hash) (v uint, r, s *big.Int, err error) {
 	r, s = new(big.Int), new(big.Int)
@@ -300,6 +309,13 @@ func (privKey *PrivateKey) SignForRecover(message []byte, hFunc hash.Hash) (v ui
 		}
 	}
 
+	// low-S normalization (EIP-2): (r, -s) is the signature obtained with -k,
+	// for which y_P has the opposite parity.
+	if s.Cmp(halfOrder) > 0 {
+		s.Sub(order, s)
+		v ^= 1
+	}
+
 	return v, r, s, nil
 }
 
@@ -328,19 +344,44 @@ func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error)
 //
 // R ?= (s⁻¹ ⋅ m ⋅ Base + s⁻¹ ⋅ R ⋅ publiKey)_x
 //
+// Signatures with s > order/2 are rejected (EIP-2), so that (r, -s) is not a
+// second valid signature of the same message.
+//
 // SEC 1, Version 2.0, Section 4.1.4
 func (publicKey *PublicKey) Verify(sigBin, message []byte, hFunc hash.Hash) (bool, error) {
 
+	r, u1, u2, err := verifyScalars(sigBin, message, hFunc)
+	if err != nil {
+		if err == errHighS {
+			return false, nil
+		}
+		return false, err
+	}
+
+	var U secp256k1.G1Jac
+	U.JointScalarMultiplicationBase(&publicKey.A, u1, u2)
+
+	return checkX(&U, r), nil
+}
+
+// verifyScalars deserializes the signature sigBin = r||s of message, and returns r
+// and the scalars u₁ = s⁻¹ ⋅ m and u₂ = s⁻¹ ⋅ r of the verification equation.
+func verifyScalars(sigBin, message []byte, hFunc hash.Hash) (r, u1, u2 *big.Int, err error) {
+
 	// Deserialize the signature
 	var sig Signature
 	if _, err := sig.SetBytes(sigBin); err != nil {
-		return false, err
+		return nil, nil, nil, err
 	}
 
 	r, s := new(big.Int), new(big.Int)
 	r.SetBytes(sig.R[:sizeFr])
 	s.SetBytes(sig.S[:sizeFr])
 
+	if s.Cmp(halfOrder) > 0 {
+		return nil, nil, nil, errHighS
+	}
+
 	sInv := new(big.Int).ModInverse(s, order)
 
 	var m *big.Int
@@ -351,7 +392,7 @@ func (publicKey *PublicKey) Verify(sigBin, message []byte, hFunc hash.Hash) (boo
 		hFunc.Reset()
 		_, err := hFunc.Write(dataToHash[:])
 		if err != nil {
-			return false, err
+			return nil, nil, nil, err
 		}
 		hramBin := hFunc.Sum(nil)
 		m = HashToInt(hramBin)
@@ -359,13 +400,16 @@ func (publicKey *PublicKey) Verify(sigBin, message []byte, hFunc hash.Hash) (boo
 		m = HashToInt(message)
 	}
 
-	u1 := new(big.Int).Mul(m, sInv)
+	u1 = new(big.Int).Mul(m, sInv)
 	u1.Mod(u1, order)
-	u2 := new(big.Int).Mul(r, sInv)
+	u2 = new(big.Int).Mul(r, sInv)
 	u2.Mod(u2, order)
-	var U secp256k1.G1Jac
-	U.JointScalarMultiplicationBase(&publicKey.A, u1, u2)
 
+	return r, u1, u2, nil
+}
+
+// checkX returns true if the x coordinate of U is r (mod order). U is modified.
+func checkX(U *secp256k1.G1Jac, r *big.Int) bool {
 	var z big.Int
 	U.Z.Square(&U.Z).
 		Inverse(&U.Z).
@@ -374,6 +418,5 @@ func (publicKey *PublicKey) Verify(sigBin, message []byte, hFunc hash.Hash) (boo
 
 	z.Mod(&z, order)
 
-	return z.Cmp(r) == 0, nil
-
+	return z.Cmp(r) == 0
 }
@@ -0,0 +1,142 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ecdsa
+
+import (
+	"crypto/subtle"
+	"errors"
+	"hash"
+	"math/big"
+
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	sizeRecoverableSignature = sizeSignature + 1
+	sizeAddress              = 20
+)
+
+var errHighS = errors.New("s > r_mod/2")
+var errWrongRecoveryID = errors.New("recovery id must be 0, 1, 27 or 28")
+
+// RecoverableSignature represents an ECDSA signature along with the public
+// key recovery information, as used in Ethereum
+type RecoverableSignature struct {
+	R, S [sizeFr]byte
+	V    byte // recovery id, 0 or 1
+}
+
+// SignRecoverable performs the ECDSA signature and returns it in the 65 bytes
+// Ethereum format r||s||v, with s ≤ order/2 (EIP-2) and v ∈ {0, 1}.
+//
+// If hFunc is nil, message is interpreted as the hash of the signed message, e.g.
+// its Keccak-256 digest.
+func (privKey *PrivateKey) SignRecoverable(message []byte, hFunc hash.Hash) ([]byte, error) {
+	for {
+		v, r, s, err := privKey.SignForRecover(message, hFunc)
+		if err != nil {
+			return nil, err
+		}
+		// x_P ≥ order happens with negligible probability, but can't be encoded
+		// in the recovery id. As the nonce is randomized, we just sign again.
+		if v > 1 {
+			continue
+		}
+		var sig RecoverableSignature
+		r.FillBytes(sig.R[:sizeFr])
+		s.FillBytes(sig.S[:sizeFr])
+		sig.V = byte(v)
+		return sig.Bytes(), nil
+	}
+}
+
+// Bytes returns the binary representation of sig
+// as a byte array of size 2*sizeFr+1 r||s||v
+func (sig *RecoverableSignature) Bytes() []byte {
+	var res [sizeRecoverableSignature]byte
+	subtle.ConstantTimeCopy(1, res[:sizeFr], sig.R[:])
+	subtle.ConstantTimeCopy(1, res[sizeFr:sizeSignature], sig.S[:])
+	res[sizeSignature] = sig.V
+	return res[:]
+}
+
+// SetBytes sets sig from a buffer in binary.
+// buf is read interpreted as r||s||v, where v is either 0 or 1, or 27 or 28
+// (legacy Ethereum encoding). s must be in the lower half of the scalar
+// field (EIP-2).
+// It returns the number of bytes read from buf.
+func (sig *RecoverableSignature) SetBytes(buf []byte) (int, error) {
+	if len(buf) != sizeRecoverableSignature {
+		return 0, errWrongSize
+	}
+
+	var rs Signature
+	n, err := rs.SetBytes(buf[:sizeSignature])
+	if err != nil {
+		return 0, err
+	}
+	if new(big.Int).SetBytes(rs.S[:]).Cmp(halfOrder) > 0 {
+		return 0, errHighS
+	}
+
+	v := buf[sizeSignature]
+	if v >= 27 {
+		v -= 27
+	}
+	if v > 1 {
+		return 0, errWrongRecoveryID
+	}
+
+	sig.R, sig.S, sig.V = rs.R, rs.S, v
+	return n + 1, nil
+}
+
+// RecoverFromBytes recovers the public key from the message and the 65 bytes
+// signature r||s||v, as returned by SignRecoverable. If hFunc is nil, message
+// is interpreted as the hash of the signed message. If recovery succeeded, the
+// methods sets the current public key to the recovered value. Otherwise returns
+// error and leaves current public key unchanged.
+func (pk *PublicKey) RecoverFromBytes(sigBin, message []byte, hFunc hash.Hash) error {
+	var sig RecoverableSignature
+	if _, err := sig.SetBytes(sigBin); err != nil {
+		return err
+	}
+
+	if hFunc != nil {
+		hFunc.Reset()
+		if _, err := hFunc.Write(message); err != nil {
+			return err
+		}
+		message = hFunc.Sum(nil)
+	}
+
+	r := new(big.Int).SetBytes(sig.R[:])
+	s := new(big.Int).SetBytes(sig.S[:])
+	return pk.RecoverFrom(message, uint(sig.V), r, s)
+}
+
+// Address returns the Ethereum address of the public key, that is the last 20
+// bytes of the Keccak-256 digest of x||y.
+func (pk *PublicKey) Address() [sizeAddress]byte {
+	var res [sizeAddress]byte
+	pkBin := pk.A.RawBytes()
+	h := sha3.NewLegacyKeccak256()
+	h.Write(pkBin[:])
+	digest := h.Sum(nil)
+	copy(res[:], digest[len(digest)-sizeAddress:])
+	return res
+}
@@ -0,0 +1,176 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ecdsa
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+	"golang.org/x/crypto/sha3"
+)
+
+func TestRecoverableSignature(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("[SECP256K1] recoverable signature: the public key should be recovered from r||s||v", prop.ForAll(
+		func() bool {
+			privKey, err := GenerateKey(rand.Reader)
+			if err != nil {
+				return false
+			}
+			msg := []byte("testing ECDSA")
+			sig, err := privKey.SignRecoverable(msg, sha3.NewLegacyKeccak256())
+			if err != nil || len(sig) != sizeRecoverableSignature {
+				return false
+			}
+			var recovered PublicKey
+			if err = recovered.RecoverFromBytes(sig, msg, sha3.NewLegacyKeccak256()); err != nil {
+				return false
+			}
+			ok, err := privKey.PublicKey.Verify(sig[:sizeSignature], msg, sha3.NewLegacyKeccak256())
+			return err == nil && ok && recovered.Equal(&privKey.PublicKey)
+		},
+	))
+
+	properties.Property("[SECP256K1] recoverable signature: s should be in the lower half of the scalar field", prop.ForAll(
+		func() bool {
+			privKey, err := GenerateKey(rand.Reader)
+			if err != nil {
+				return false
+			}
+			digest := make([]byte, 32)
+			if _, err = rand.Read(digest); err != nil {
+				return false
+			}
+			sig, err := privKey.SignRecoverable(digest, nil)
+			if err != nil {
+				return false
+			}
+			s := new(big.Int).SetBytes(sig[sizeFr:sizeSignature])
+			return s.Cmp(halfOrder) <= 0 && sig[sizeSignature] <= 1
+		},
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestRecoverableSignatureVector(t *testing.T) {
+	t.Parallel()
+
+	// web3.eth.accounts.sign("Some data", privateKey), which signs the digest
+	// of "\x19Ethereum Signed Message:\n" || len(message) || message
+	const (
+		privateKey = "4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318"
+		address    = "2c7536e3605d9c16a7a3d7b1898e529396a65c23"
+		message    = "\x19Ethereum Signed Message:\n9Some data"
+		signature  = "b91467e570a6466aa9e9876cbcd013baba02900b8979d43fe208a4a4f339f5fd6007e74cd82e037b800186422fc2da167c747ef045e5d18a5f5d4300f8e1a0291c"
+	)
+
+	var privKey PrivateKey
+	sk, _ := new(big.Int).SetString(privateKey, 16)
+	sk.FillBytes(privKey.scalar[:])
+	privKey.PublicKey.A.ScalarMultiplicationBase(sk)
+	addr := privKey.PublicKey.Address()
+	if hex.EncodeToString(addr[:]) != address {
+		t.Fatal("wrong address")
+	}
+
+	sig, _ := hex.DecodeString(signature)
+	var recovered PublicKey
+	if err := recovered.RecoverFromBytes(sig, []byte(message), sha3.NewLegacyKeccak256()); err != nil {
+		t.Fatal(err)
+	}
+	if !recovered.Equal(&privKey.PublicKey) {
+		t.Fatal("wrong recovered public key")
+	}
+
+	// v ∈ {0, 1} encoding
+	sig[sizeSignature] -= 27
+	if err := recovered.RecoverFromBytes(sig, []byte(message), sha3.NewLegacyKeccak256()); err != nil {
+		t.Fatal(err)
+	}
+	if !recovered.Equal(&privKey.PublicKey) {
+		t.Fatal("wrong recovered public key")
+	}
+}
+
+func TestRecoverableSignatureMalleability(t *testing.T) {
+	t.Parallel()
+
+	privKey, _ := GenerateKey(rand.Reader)
+	msg := []byte("testing ECDSA")
+	sig, err := privKey.SignRecoverable(msg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// (r, -s) is a valid ECDSA signature, rejected per EIP-2
+	highS := make([]byte, sizeRecoverableSignature)
+	copy(highS, sig)
+	s := new(big.Int).SetBytes(sig[sizeFr:sizeSignature])
+	s.Sub(fr.Modulus(), s).FillBytes(highS[sizeFr:sizeSignature])
+	highS[sizeSignature] ^= 1
+
+	var rsig RecoverableSignature
+	if _, err = rsig.SetBytes(highS); err != errHighS {
+		t.Fatal("should raise error s > r_mod/2")
+	}
+	ok, err := privKey.PublicKey.Verify(highS[:sizeSignature], msg, nil)
+	if err != nil || ok {
+		t.Fatal("Verify should reject s > r_mod/2")
+	}
+
+	t.Run("wrong_v", func(t *testing.T) {
+		wrongV := make([]byte, sizeRecoverableSignature)
+		copy(wrongV, sig)
+		wrongV[sizeSignature] = 2
+		if _, err := rsig.SetBytes(wrongV); err != errWrongRecoveryID {
+			t.Fatal("should raise wrong recovery id error")
+		}
+	})
+
+	t.Run("wrong_size", func(t *testing.T) {
+		if _, err := rsig.SetBytes(sig[:sizeSignature]); err != errWrongSize {
+			t.Fatal("should raise wrong size error")
+		}
+	})
+}
+
+func BenchmarkRecoverFromBytes(b *testing.B) {
+	privKey, _ := GenerateKey(rand.Reader)
+	msg := []byte("benchmarking ECDSA recover")
+	sig, _ := privKey.SignRecoverable(msg, nil)
+
+	b.ResetTimer()
+	var recovered PublicKey
+	for i := 0; i < b.N; i++ {
+		_ = recovered.RecoverFromBytes(sig, msg, nil)
+	}
+}
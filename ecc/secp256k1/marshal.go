@@ -1,4 +1,4 @@
-// Copyright 2020 ConsenSys Software Inc.
+// Copyright 2020 Consensys Software Inc.
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -12,7 +12,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-// FOO
+// Code generated by consensys/gnark-crypto DO NOT EDIT
 
 package secp256k1
 
@@ -24,7 +24,7 @@ import (
 )
 
 // SizeOfG1AffineCompressed represents the size in bytes that a G1Affine need in binary form, compressed
-const SizeOfG1AffineCompressed = 32
+const SizeOfG1AffineCompressed = fp.Bytes
 
 // SizeOfG1AffineUncompressed represents the size in bytes that a G1Affine need in binary form, uncompressed
 const SizeOfG1AffineUncompressed = SizeOfG1AffineCompressed * 2
@@ -34,7 +34,7 @@ func (p *G1Affine) RawBytes() (res [SizeOfG1AffineUncompressed]byte) {
 
 	// not compressed
 	// we store the Y coordinate
-	fp.BigEndian.PutElement((*[fp.Bytes]byte)(res[32:32+fp.Bytes]), p.Y)
+	fp.BigEndian.PutElement((*[fp.Bytes]byte)(res[fp.Bytes:2*fp.Bytes]), p.Y)
 
 	// we store the X coordinate
 	fp.BigEndian.PutElement((*[fp.Bytes]byte)(res[0:0+fp.Bytes]), p.X)
@@ -55,7 +55,7 @@ func (p *G1Affine) SetBytes(buf []byte) (int, error) {
 
 // we store both X and Y and there is no spare bit for flagging
 func (p *G1Affine) setBytes(buf []byte, subGroupCheck bool) (int, error) {
-	if len(buf) < SizeOfG1AffineCompressed {
+	if len(buf) < SizeOfG1AffineUncompressed {
 		return 0, io.ErrShortBuffer
 	}
 
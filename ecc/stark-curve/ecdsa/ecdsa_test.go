@@ -19,6 +19,7 @@ package ecdsa
 import (
 	"crypto/rand"
 	"crypto/sha256"
+	"fmt"
 	"github.com/consensys/gnark-crypto/ecc/stark-curve/fr"
 	"math/big"
 	"testing"
@@ -84,12 +85,131 @@ func TestRecoverPublicKey(t *testing.T) {
 			if err = recovered.RecoverFrom(msg, v, r, s); err != nil {
 				return false
 			}
-			return pk.Equal(&recovered)
+			pk2, err := RecoverPublicKey(msg, v, r, s)
+			if err != nil {
+				return false
+			}
+			return pk.Equal(&recovered) && pk.Equal(pk2)
 		},
 	))
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
+func TestPreparedPublicKey(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("[STARK-CURVE] the prepared public key should verify as the public key", prop.ForAll(
+		func() bool {
+			privKey, _ := GenerateKey(rand.Reader)
+			prepared := privKey.PublicKey.Prepare()
+
+			msg := []byte("testing ECDSA")
+			hFunc := sha256.New()
+			sig, _ := privKey.Sign(msg, hFunc)
+			flag, err := prepared.Verify(sig, msg, hFunc)
+			if err != nil || !flag {
+				return false
+			}
+
+			// wrong message
+			flag, err = prepared.Verify(sig, []byte("testing ECDSA!"), hFunc)
+			if err != nil || flag {
+				return false
+			}
+
+			// wrong public key
+			otherKey, _ := GenerateKey(rand.Reader)
+			flag, err = otherKey.PublicKey.Prepare().Verify(sig, msg, hFunc)
+			return err == nil && !flag
+		},
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestBatchVerify(t *testing.T) {
+	t.Parallel()
+
+	const nbSignatures = 20
+	pubs, msgs, vs, sigs := batchSignatures(t, nbSignatures)
+	hFunc := sha256.New()
+
+	if i, err := BatchVerify(pubs, msgs, vs, sigs, hFunc); i != -1 || err != nil {
+		t.Fatalf("valid batch rejected: index %d, error %v", i, err)
+	}
+	if i, err := BatchVerify(nil, nil, nil, nil, hFunc); i != -1 || err != nil {
+		t.Fatal("empty batch should verify")
+	}
+
+	// wrong message
+	msgs[13] = []byte("wrong message")
+	if i, err := BatchVerify(pubs, msgs, vs, sigs, hFunc); i != 13 || err != nil {
+		t.Fatalf("expected index 13, got %d, error %v", i, err)
+	}
+
+	// wrong public keys, the first invalid signature is returned
+	pubs[3], pubs[7] = pubs[7], pubs[3]
+	if i, err := BatchVerify(pubs, msgs, vs, sigs, hFunc); i != 3 || err != nil {
+		t.Fatalf("expected index 3, got %d, error %v", i, err)
+	}
+
+	// wrong recovery information
+	pubs, msgs, vs, sigs = batchSignatures(t, nbSignatures)
+	vs[5] ^= 1
+	if i, err := BatchVerify(pubs, msgs, vs, sigs, hFunc); i != 5 || err != nil {
+		t.Fatalf("expected index 5, got %d, error %v", i, err)
+	}
+
+	// malformed signature
+	vs[5] ^= 1
+	sigs[9] = sigs[9][:sizeSignature-1]
+	if i, err := BatchVerify(pubs, msgs, vs, sigs, hFunc); i != 9 || err != errWrongSize {
+		t.Fatalf("expected index 9 and errWrongSize, got %d, error %v", i, err)
+	}
+
+	// an invalid signature before the malformed one is returned first, not one after it
+	msgs[4] = []byte("wrong message")
+	if i, err := BatchVerify(pubs, msgs, vs, sigs, hFunc); i != 4 || err != nil {
+		t.Fatalf("expected index 4, got %d, error %v", i, err)
+	}
+	msgs[4], msgs[15] = []byte{4, 0xca, 0xfe}, []byte("wrong message")
+	if i, err := BatchVerify(pubs, msgs, vs, sigs, hFunc); i != 9 || err != errWrongSize {
+		t.Fatalf("expected index 9 and errWrongSize, got %d, error %v", i, err)
+	}
+
+	if _, err := BatchVerify(pubs[1:], msgs, vs, sigs, hFunc); err != errBatchSize {
+		t.Fatal("expected errBatchSize")
+	}
+}
+
+// batchSignatures returns nbSignatures signatures by distinct keys, with their recovery information
+func batchSignatures(tb testing.TB, nbSignatures int) ([]PublicKey, [][]byte, []uint, [][]byte) {
+	pubs := make([]PublicKey, nbSignatures)
+	msgs := make([][]byte, nbSignatures)
+	vs := make([]uint, nbSignatures)
+	sigs := make([][]byte, nbSignatures)
+	hFunc := sha256.New()
+	for i := range sigs {
+		privKey, err := GenerateKey(rand.Reader)
+		if err != nil {
+			tb.Fatal(err)
+		}
+		pubs[i] = privKey.PublicKey
+		msgs[i] = []byte{byte(i), 0xca, 0xfe}
+		v, r, s, err := privKey.SignForRecover(msgs[i], hFunc)
+		if err != nil {
+			tb.Fatal(err)
+		}
+		var sig Signature
+		r.FillBytes(sig.R[:])
+		s.FillBytes(sig.S[:])
+		vs[i], sigs[i] = v, sig.Bytes()
+	}
+	return pubs, msgs, vs, sigs
+}
+
 func TestNonMalleability(t *testing.T) {
 
 	// buffer too big
@@ -206,3 +326,29 @@ func BenchmarkRecoverPublicKey(b *testing.B) {
 		}
 	}
 }
+
+func BenchmarkVerifyPreparedECDSA(b *testing.B) {
+
+	privKey, _ := GenerateKey(rand.Reader)
+	prepared := privKey.PublicKey.Prepare()
+	msg := []byte("benchmarking ECDSA sign()")
+	sig, _ := privKey.Sign(msg, nil)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		prepared.Verify(sig, msg, nil)
+	}
+}
+
+func BenchmarkBatchVerify(b *testing.B) {
+	for _, nbSignatures := range []int{16, 128, 1024} {
+		pubs, msgs, vs, sigs := batchSignatures(b, nbSignatures)
+		b.Run(fmt.Sprintf("%d signatures", nbSignatures), func(b *testing.B) {
+			hFunc := sha256.New()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_, _ = BatchVerify(pubs, msgs, vs, sigs, hFunc)
+			}
+		})
+	}
+}
@@ -90,6 +90,17 @@ func (pk *PublicKey) RecoverFrom(msg []byte, v uint, r, s *big.Int) error {
 	return nil
 }
 
+// RecoverPublicKey recovers the public key from the message msg, recovery
+// information v and decomposed signature {r,s}, as returned by SignForRecover.
+// As in RecoverFrom, msg is interpreted as the hash of the signed message.
+func RecoverPublicKey(msg []byte, v uint, r, s *big.Int) (*PublicKey, error) {
+	pk := new(PublicKey)
+	if err := pk.RecoverFrom(msg, v, r, s); err != nil {
+		return nil, err
+	}
+	return pk, nil
+}
+
 // Bytes returns the binary representation of pk,
 // as byte array publicKey||scalar
 // where publicKey is as publicKey.Bytes(), and
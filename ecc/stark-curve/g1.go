@@ -1,4 +1,4 @@
-// Copyright 2020 ConsenSys Software Inc.
+// Copyright 2020 Consensys Software Inc.
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -12,16 +12,16 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-// FOO
+// Code generated by consensys/gnark-crypto DO NOT EDIT
 
 package starkcurve
 
 import (
-	"math/big"
-
 	"github.com/consensys/gnark-crypto/ecc/stark-curve/fp"
 	"github.com/consensys/gnark-crypto/ecc/stark-curve/fr"
 	"github.com/consensys/gnark-crypto/internal/parallel"
+	"math/big"
+	"runtime"
 )
 
 // G1Affine is a point in affine coordinates (x,y)
@@ -48,6 +48,14 @@ func (p *G1Affine) Set(a *G1Affine) *G1Affine {
 	return p
 }
 
+// setInfinity sets p to the infinity point, which is encoded as (0,0).
+// N.B.: (0,0) is never on the curve for j=0 curves (Y²=X³+B).
+func (p *G1Affine) setInfinity() *G1Affine {
+	p.X.SetZero()
+	p.Y.SetZero()
+	return p
+}
+
 // ScalarMultiplication computes and returns p = [s]a
 // where p and a are affine points.
 func (p *G1Affine) ScalarMultiplication(a *G1Affine, s *big.Int) *G1Affine {
@@ -72,22 +80,67 @@ func (p *G1Affine) ScalarMultiplicationBase(s *big.Int) *G1Affine {
 //
 // https://www.hyperelliptic.org/EFD/g1p/auto-shortw-jacobian-0.html#addition-mmadd-2007-bl
 func (p *G1Affine) Add(a, b *G1Affine) *G1Affine {
-	var p1, p2 G1Jac
-	p1.FromAffine(a)
-	p2.FromAffine(b)
-	p1.AddAssign(&p2)
-	p.FromJacobian(&p1)
+	var q G1Jac
+	// a is infinity, return b
+	if a.IsInfinity() {
+		p.Set(b)
+		return p
+	}
+	// b is infinity, return a
+	if b.IsInfinity() {
+		p.Set(a)
+		return p
+	}
+	if a.X.Equal(&b.X) {
+		// if b == a, we double instead
+		if a.Y.Equal(&b.Y) {
+			q.DoubleMixed(a)
+			return p.FromJacobian(&q)
+		} else {
+			// if b == -a, we return 0
+			return p.setInfinity()
+		}
+	}
+	var H, HH, I, J, r, V fp.Element
+	H.Sub(&b.X, &a.X)
+	HH.Square(&H)
+	I.Double(&HH).Double(&I)
+	J.Mul(&H, &I)
+	r.Sub(&b.Y, &a.Y)
+	r.Double(&r)
+	V.Mul(&a.X, &I)
+	q.X.Square(&r).
+		Sub(&q.X, &J).
+		Sub(&q.X, &V).
+		Sub(&q.X, &V)
+	q.Y.Sub(&V, &q.X).
+		Mul(&q.Y, &r)
+	J.Mul(&a.Y, &J).Double(&J)
+	q.Y.Sub(&q.Y, &J)
+	q.Z.Double(&H)
+
+	return p.FromJacobian(&q)
+}
+
+// Double doubles a point in affine coordinates.
+// It converts the point to Jacobian coordinates, doubles it using Jacobian
+// addition with a.Z=1, and converts it back to affine coordinates.
+//
+// http://www.hyperelliptic.org/EFD/g1p/auto-shortw-jacobian-0.html#doubling-mdbl-2007-bl
+func (p *G1Affine) Double(a *G1Affine) *G1Affine {
+	var q G1Jac
+	q.FromAffine(a)
+	q.DoubleMixed(a)
+	p.FromJacobian(&q)
 	return p
 }
 
 // Sub subtracts two points in affine coordinates.
 // It uses a similar approach to Add, but negates the second point before adding.
 func (p *G1Affine) Sub(a, b *G1Affine) *G1Affine {
-	var p1, p2 G1Jac
-	p1.FromAffine(a)
-	p2.FromAffine(b)
-	p1.SubAssign(&p2)
-	p.FromJacobian(&p1)
+	var bneg G1Affine
+	bneg.Neg(b)
+	p.Add(a, &bneg)
 	return p
 }
 
@@ -131,7 +184,7 @@ func (p *G1Affine) String() string {
 }
 
 // IsInfinity checks if the affine point p is infinity, which is encoded as (0,0).
-// N.B.: (0,0) is not on the STARK curve (Y²=X³+X+B).
+// N.B.: (0,0) is never on the curve for j=0 curves (Y²=X³+B).
 func (p *G1Affine) IsInfinity() bool {
 	return p.X.IsZero() && p.Y.IsZero()
 }
@@ -160,18 +213,31 @@ func (p *G1Jac) Set(q *G1Jac) *G1Jac {
 }
 
 // Equal tests if two points in Jacobian coordinates are equal.
-func (p *G1Jac) Equal(a *G1Jac) bool {
-
-	if p.Z.IsZero() && a.Z.IsZero() {
-		return true
+func (p *G1Jac) Equal(q *G1Jac) bool {
+	// If one point is infinity, the other must also be infinity.
+	if p.Z.IsZero() {
+		return q.Z.IsZero()
+	}
+	// If the other point is infinity, return false since we can't
+	// the following checks would be incorrect.
+	if q.Z.IsZero() {
+		return false
 	}
-	_p := G1Affine{}
-	_p.FromJacobian(p)
 
-	_a := G1Affine{}
-	_a.FromJacobian(a)
+	var pZSquare, aZSquare fp.Element
+	pZSquare.Square(&p.Z)
+	aZSquare.Square(&q.Z)
 
-	return _p.X.Equal(&_a.X) && _p.Y.Equal(&_a.Y)
+	var lhs, rhs fp.Element
+	lhs.Mul(&p.X, &aZSquare)
+	rhs.Mul(&q.X, &pZSquare)
+	if !lhs.Equal(&rhs) {
+		return false
+	}
+	lhs.Mul(&p.Y, &aZSquare).Mul(&lhs, &q.Z)
+	rhs.Mul(&q.Y, &pZSquare).Mul(&rhs, &p.Z)
+
+	return lhs.Equal(&rhs)
 }
 
 // Neg sets p to the Jacobian negative point -q = (q.X, -q.Y, q.Z).
@@ -181,43 +247,33 @@ func (p *G1Jac) Neg(q *G1Jac) *G1Jac {
 	return p
 }
 
-// SubAssign sets p to p-a in Jacobian coordinates.
-// It uses a similar approach to AddAssign, but negates the point a before adding.
-func (p *G1Jac) SubAssign(a *G1Jac) *G1Jac {
-	var tmp G1Jac
-	tmp.Set(a)
-	tmp.Y.Neg(&tmp.Y)
-	p.AddAssign(&tmp)
-	return p
-}
-
 // AddAssign sets p to p+a in Jacobian coordinates.
 //
 // https://hyperelliptic.org/EFD/g1p/auto-shortw-jacobian-3.html#addition-add-2007-bl
-func (p *G1Jac) AddAssign(a *G1Jac) *G1Jac {
+func (p *G1Jac) AddAssign(q *G1Jac) *G1Jac {
 
-	// p is infinity, return a
+	// p is infinity, return q
 	if p.Z.IsZero() {
-		p.Set(a)
+		p.Set(q)
 		return p
 	}
 
-	// a is infinity, return p
-	if a.Z.IsZero() {
+	// q is infinity, return p
+	if q.Z.IsZero() {
 		return p
 	}
 
 	var Z1Z1, Z2Z2, U1, U2, S1, S2, H, I, J, r, V fp.Element
-	Z1Z1.Square(&a.Z)
+	Z1Z1.Square(&q.Z)
 	Z2Z2.Square(&p.Z)
-	U1.Mul(&a.X, &Z2Z2)
+	U1.Mul(&q.X, &Z2Z2)
 	U2.Mul(&p.X, &Z1Z1)
-	S1.Mul(&a.Y, &p.Z).
+	S1.Mul(&q.Y, &p.Z).
 		Mul(&S1, &Z2Z2)
-	S2.Mul(&p.Y, &a.Z).
+	S2.Mul(&p.Y, &q.Z).
 		Mul(&S2, &Z1Z1)
 
-	// if p == a, we double instead
+	// if p == q, we double instead
 	if U1.Equal(&U2) && S1.Equal(&S2) {
 		return p.DoubleAssign()
 	}
@@ -236,7 +292,7 @@ func (p *G1Jac) AddAssign(a *G1Jac) *G1Jac {
 		Mul(&p.Y, &r)
 	S1.Mul(&S1, &J).Double(&S1)
 	p.Y.Sub(&p.Y, &S1)
-	p.Z.Add(&p.Z, &a.Z)
+	p.Z.Add(&p.Z, &q.Z)
 	p.Z.Square(&p.Z).
 		Sub(&p.Z, &Z1Z1).
 		Sub(&p.Z, &Z2Z2).
@@ -245,6 +301,47 @@ func (p *G1Jac) AddAssign(a *G1Jac) *G1Jac {
 	return p
 }
 
+// SubAssign sets p to p-a in Jacobian coordinates.
+// It uses a similar approach to AddAssign, but negates the point a before adding.
+func (p *G1Jac) SubAssign(q *G1Jac) *G1Jac {
+	var tmp G1Jac
+	tmp.Set(q)
+	tmp.Y.Neg(&tmp.Y)
+	p.AddAssign(&tmp)
+	return p
+}
+
+// Double sets p to [2]q in Jacobian coordinates.
+//
+// https://hyperelliptic.org/EFD/g1p/auto-shortw-jacobian-3.html#doubling-dbl-2007-bl
+func (p *G1Jac) DoubleMixed(a *G1Affine) *G1Jac {
+	var XX, YY, YYYY, S, M, T fp.Element
+	XX.Square(&a.X)
+	YY.Square(&a.Y)
+	YYYY.Square(&YY)
+	S.Add(&a.X, &YY).
+		Square(&S).
+		Sub(&S, &XX).
+		Sub(&S, &YYYY).
+		Double(&S)
+	M.Double(&XX).
+		Add(&M, &XX)
+	M.Add(&M, &aCurveCoeff)
+	T.Square(&M).
+		Sub(&T, &S).
+		Sub(&T, &S)
+	p.X.Set(&T)
+	p.Y.Sub(&S, &T).
+		Mul(&p.Y, &M)
+	YYYY.Double(&YYYY).
+		Double(&YYYY).
+		Double(&YYYY)
+	p.Y.Sub(&p.Y, &YYYY)
+	p.Z.Double(&a.Y)
+
+	return p
+}
+
 // AddMixed sets p to p+a in Jacobian coordinates, where a.Z = 1.
 //
 // http://www.hyperelliptic.org/EFD/g1p/auto-shortw-jacobian-0.html#addition-madd-2007-bl
@@ -270,7 +367,7 @@ func (p *G1Jac) AddMixed(a *G1Affine) *G1Jac {
 
 	// if p == a, we double instead
 	if U2.Equal(&p.X) && S2.Equal(&p.Y) {
-		return p.DoubleAssign()
+		return p.DoubleMixed(a)
 	}
 
 	H.Sub(&U2, &p.X)
@@ -306,10 +403,10 @@ func (p *G1Jac) Double(q *G1Jac) *G1Jac {
 
 // DoubleAssign doubles p in Jacobian coordinates.
 //
-// https://hyperelliptic.org/EFD/g1p/auto-shortw-jacobian-3.html#doubling-dbl-2007-bl
+// https://hyperelliptic.org/EFD/g1p/auto-shortw-jacobian.html#doubling-dbl-2007-bl
 func (p *G1Jac) DoubleAssign() *G1Jac {
 
-	var XX, YY, YYYY, ZZ, S, M, T, ZZZZ fp.Element
+	var XX, YY, YYYY, ZZ, S, M, T fp.Element
 
 	XX.Square(&p.X)
 	YY.Square(&p.Y)
@@ -321,8 +418,8 @@ func (p *G1Jac) DoubleAssign() *G1Jac {
 		Sub(&S, &YYYY).
 		Double(&S)
 	M.Double(&XX).Add(&M, &XX)
-	ZZZZ.Square(&ZZ)
-	M.Add(&M, &ZZZZ)
+	T.Square(&ZZ).Mul(&T, &aCurveCoeff)
+	M.Add(&M, &T)
 	p.Z.Add(&p.Z, &p.Y).
 		Square(&p.Z).
 		Sub(&p.Z, &YY).
@@ -340,9 +437,17 @@ func (p *G1Jac) DoubleAssign() *G1Jac {
 }
 
 // ScalarMultiplication computes and returns p = [s]a
+// where p and a are Jacobian points.
 // using a 2-bits windowed double-and-add method.
-func (p *G1Jac) ScalarMultiplication(a *G1Jac, s *big.Int) *G1Jac {
-	return p.mulWindowed(a, s)
+func (p *G1Jac) ScalarMultiplication(q *G1Jac, s *big.Int) *G1Jac {
+	return p.mulWindowed(q, s)
+}
+
+// ScalarMultiplicationBase computes and returns p = [s]g
+// where g is the prime subgroup generator.
+func (p *G1Jac) ScalarMultiplicationBase(s *big.Int) *G1Jac {
+	return p.mulWindowed(&g1Gen, s)
+
 }
 
 // String converts p to affine coordinates and returns its string representation E(x,y) or "O" if it is infinity.
@@ -353,32 +458,29 @@ func (p *G1Jac) String() string {
 }
 
 // FromAffine converts a point a from affine to Jacobian coordinates.
-func (p *G1Jac) FromAffine(Q *G1Affine) *G1Jac {
-	if Q.IsInfinity() {
+func (p *G1Jac) FromAffine(a *G1Affine) *G1Jac {
+	if a.IsInfinity() {
 		p.Z.SetZero()
 		p.X.SetOne()
 		p.Y.SetOne()
 		return p
 	}
 	p.Z.SetOne()
-	p.X.Set(&Q.X)
-	p.Y.Set(&Q.Y)
+	p.X.Set(&a.X)
+	p.Y.Set(&a.Y)
 	return p
 }
 
-// IsOnCurve returns true if p in on the curve
-// Y^2=X^3+X*Z^4+b*Z^6
+// IsOnCurve returns true if the Jacobian point p in on the curve.
 func (p *G1Jac) IsOnCurve() bool {
-	var left, right, tmp, u fp.Element
+	var left, right, tmp, ZZ fp.Element
 	left.Square(&p.Y)
 	right.Square(&p.X).Mul(&right, &p.X)
-	tmp.Square(&p.Z).
-		Square(&tmp)
-	u.Mul(&p.X, &tmp)
-	right.Add(&u, &right)
-	tmp.Mul(&tmp, &p.Z).
-		Mul(&tmp, &p.Z).
-		Mul(&tmp, &bCurveCoeff)
+	ZZ.Square(&p.Z)
+	tmp.Square(&ZZ).Mul(&tmp, &ZZ)
+	tmp.Mul(&tmp, &bCurveCoeff)
+	right.Add(&right, &tmp)
+	tmp.Square(&ZZ).Mul(&tmp, &p.X).Mul(&tmp, &aCurveCoeff)
 	right.Add(&right, &tmp)
 	return left.Equal(&right)
 }
@@ -394,13 +496,16 @@ func (p *G1Jac) IsInSubGroup() bool {
 
 // mulWindowed computes the 2-bits windowed double-and-add scalar
 // multiplication p=[s]q in Jacobian coordinates.
-func (p *G1Jac) mulWindowed(a *G1Jac, s *big.Int) *G1Jac {
+func (p *G1Jac) mulWindowed(q *G1Jac, s *big.Int) *G1Jac {
 
 	var res G1Jac
 	var ops [3]G1Jac
 
+	ops[0].Set(q)
+	if s.Sign() == -1 {
+		ops[0].Neg(&ops[0])
+	}
 	res.Set(&g1Infinity)
-	ops[0].Set(a)
 	ops[1].Double(&ops[0])
 	ops[2].Set(&ops[0]).AddAssign(&ops[1])
 
@@ -423,14 +528,14 @@ func (p *G1Jac) mulWindowed(a *G1Jac, s *big.Int) *G1Jac {
 
 }
 
-// JointScalarMultiplicationBase computes [s1]g+[s2]a using Straus-Shamir technique
-// where g is the prime subgroup generator
-func (p *G1Jac) JointScalarMultiplicationBase(a *G1Affine, s1, s2 *big.Int) *G1Jac {
+// JointScalarMultiplication computes [s1]a1+[s2]a2 using Strauss-Shamir technique
+// where a1 and a2 are affine points.
+func (p *G1Jac) JointScalarMultiplication(a1, a2 *G1Affine, s1, s2 *big.Int) *G1Jac {
 
 	var res, p1, p2 G1Jac
 	res.Set(&g1Infinity)
-	p1.Set(&g1Gen)
-	p2.FromAffine(a)
+	p1.FromAffine(a1)
+	p2.FromAffine(a2)
 
 	var table [15]G1Jac
 
@@ -494,72 +599,10 @@ func (p *G1Jac) JointScalarMultiplicationBase(a *G1Affine, s1, s2 *big.Int) *G1J
 
 }
 
-// JointScalarMultiplication computes [s1]p1+[s2]p1 using Straus-Shamir technique
-// where g is the prime subgroup generator
-func (p *G1Jac) JointScalarMultiplication(p1, p2 *G1Jac, s1, s2 *big.Int) *G1Jac {
-
-	var res G1Jac
-	res.Set(&g1Infinity)
-
-	var table [15]G1Jac
-
-	var k1, k2 big.Int
-	if s1.Sign() == -1 {
-		k1.Neg(s1)
-		table[0].Neg(p1)
-	} else {
-		k1.Set(s1)
-		table[0].Set(p1)
-	}
-	if s2.Sign() == -1 {
-		k2.Neg(s2)
-		table[3].Neg(p2)
-	} else {
-		k2.Set(s2)
-		table[3].Set(p2)
-	}
-
-	// precompute table (2 bits sliding window)
-	table[1].Double(&table[0])
-	table[2].Set(&table[1]).AddAssign(&table[0])
-	table[4].Set(&table[3]).AddAssign(&table[0])
-	table[5].Set(&table[3]).AddAssign(&table[1])
-	table[6].Set(&table[3]).AddAssign(&table[2])
-	table[7].Double(&table[3])
-	table[8].Set(&table[7]).AddAssign(&table[0])
-	table[9].Set(&table[7]).AddAssign(&table[1])
-	table[10].Set(&table[7]).AddAssign(&table[2])
-	table[11].Set(&table[7]).AddAssign(&table[3])
-	table[12].Set(&table[11]).AddAssign(&table[0])
-	table[13].Set(&table[11]).AddAssign(&table[1])
-	table[14].Set(&table[11]).AddAssign(&table[2])
-
-	var s [2]fr.Element
-	s[0] = s[0].SetBigInt(&k1).Bits()
-	s[1] = s[1].SetBigInt(&k2).Bits()
-
-	maxBit := k1.BitLen()
-	if k2.BitLen() > maxBit {
-		maxBit = k2.BitLen()
-	}
-	hiWordIndex := (maxBit - 1) / 64
-
-	for i := hiWordIndex; i >= 0; i-- {
-		mask := uint64(3) << 62
-		for j := 0; j < 32; j++ {
-			res.Double(&res).Double(&res)
-			b1 := (s[0][i] & mask) >> (62 - 2*j)
-			b2 := (s[1][i] & mask) >> (62 - 2*j)
-			if b1|b2 != 0 {
-				s := (b2<<2 | b1)
-				res.AddAssign(&table[s-1])
-			}
-			mask = mask >> 2
-		}
-	}
-
-	p.Set(&res)
-	return p
+// JointScalarMultiplicationBase computes [s1]g+[s2]a using Straus-Shamir technique
+// where g is the prime subgroup generator.
+func (p *G1Jac) JointScalarMultiplicationBase(a *G1Affine, s1, s2 *big.Int) *G1Jac {
+	return p.JointScalarMultiplication(&g1GenAff, a, s1, s2)
 
 }
 
@@ -567,32 +610,54 @@ func (p *G1Jac) JointScalarMultiplication(p1, p2 *G1Jac, s1, s2 *big.Int) *G1Jac
 // extended Jacobian coordinates
 
 // Set sets p to a in extended Jacobian coordinates.
-func (p *g1JacExtended) Set(a *g1JacExtended) *g1JacExtended {
-	p.X, p.Y, p.ZZ, p.ZZZ = a.X, a.Y, a.ZZ, a.ZZZ
+func (p *g1JacExtended) Set(q *g1JacExtended) *g1JacExtended {
+	p.X, p.Y, p.ZZ, p.ZZZ = q.X, q.Y, q.ZZ, q.ZZZ
+	return p
+}
+
+// setInfinity sets p to the infinity point (1,1,0,0).
+func (p *g1JacExtended) setInfinity() *g1JacExtended {
+	p.X.SetOne()
+	p.Y.SetOne()
+	p.ZZ = fp.Element{}
+	p.ZZZ = fp.Element{}
 	return p
 }
 
+// IsInfinity checks if the p is infinity, i.e. p.ZZ=0.
+func (p *g1JacExtended) IsInfinity() bool {
+	return p.ZZ.IsZero()
+}
+
 // fromJacExtended converts an extended Jacobian point to an affine point.
-func (p *G1Affine) fromJacExtended(Q *g1JacExtended) *G1Affine {
-	if Q.ZZ.IsZero() {
+func (p *G1Affine) fromJacExtended(q *g1JacExtended) *G1Affine {
+	if q.ZZ.IsZero() {
 		p.X = fp.Element{}
 		p.Y = fp.Element{}
 		return p
 	}
-	p.X.Inverse(&Q.ZZ).Mul(&p.X, &Q.X)
-	p.Y.Inverse(&Q.ZZZ).Mul(&p.Y, &Q.Y)
+	p.X.Inverse(&q.ZZ).Mul(&p.X, &q.X)
+	p.Y.Inverse(&q.ZZZ).Mul(&p.Y, &q.Y)
 	return p
 }
 
 // fromJacExtended converts an extended Jacobian point to a Jacobian point.
-func (p *G1Jac) fromJacExtended(Q *g1JacExtended) *G1Jac {
-	if Q.ZZ.IsZero() {
+func (p *G1Jac) fromJacExtended(q *g1JacExtended) *G1Jac {
+	if q.ZZ.IsZero() {
 		p.Set(&g1Infinity)
 		return p
 	}
-	p.X.Mul(&Q.ZZ, &Q.X).Mul(&p.X, &Q.ZZ)
-	p.Y.Mul(&Q.ZZZ, &Q.Y).Mul(&p.Y, &Q.ZZZ)
-	p.Z.Set(&Q.ZZZ)
+	p.X.Mul(&q.ZZ, &q.X).Mul(&p.X, &q.ZZ)
+	p.Y.Mul(&q.ZZZ, &q.Y).Mul(&p.Y, &q.ZZZ)
+	p.Z.Set(&q.ZZZ)
+	return p
+}
+
+// unsafeFromJacExtended converts an extended Jacobian point, distinct from Infinity, to a Jacobian point.
+func (p *G1Jac) unsafeFromJacExtended(q *g1JacExtended) *G1Jac {
+	p.X.Square(&q.ZZ).Mul(&p.X, &q.X)
+	p.Y.Square(&q.ZZZ).Mul(&p.Y, &q.Y)
+	p.Z = q.ZZZ
 	return p
 }
 
@@ -659,7 +724,7 @@ func (p *g1JacExtended) add(q *g1JacExtended) *g1JacExtended {
 // N.B.: since we consider any point on Z=0 as the point at infinity
 // this doubling formula works for infinity points as well.
 func (p *g1JacExtended) double(q *g1JacExtended) *g1JacExtended {
-	var Z, U, V, W, S, XX, M fp.Element
+	var U, V, W, S, XX, M fp.Element
 
 	U.Double(&q.Y)
 	V.Square(&U)
@@ -668,8 +733,8 @@ func (p *g1JacExtended) double(q *g1JacExtended) *g1JacExtended {
 	XX.Square(&q.X)
 	M.Double(&XX).
 		Add(&M, &XX)
-	Z.Square(&q.ZZ)
-	M.Add(&M, &Z)
+	XX.Square(&q.ZZ).Mul(&XX, &aCurveCoeff)
+	M.Add(&M, &XX)
 	U.Mul(&W, &q.Y)
 
 	p.X.Square(&M).
@@ -684,10 +749,10 @@ func (p *g1JacExtended) double(q *g1JacExtended) *g1JacExtended {
 	return p
 }
 
-// subMixed is the same as addMixed, but negates a.Y.
+// addMixed sets p to p+q in extended Jacobian coordinates, where a.ZZ=1.
 //
 // http://www.hyperelliptic.org/EFD/g1p/auto-shortw-xyzz.html#addition-madd-2008-s
-func (p *g1JacExtended) subMixed(a *G1Affine) *g1JacExtended {
+func (p *g1JacExtended) addMixed(a *G1Affine) *g1JacExtended {
 
 	//if a is infinity return p
 	if a.IsInfinity() {
@@ -696,7 +761,7 @@ func (p *g1JacExtended) subMixed(a *G1Affine) *g1JacExtended {
 	// p is infinity, return a
 	if p.ZZ.IsZero() {
 		p.X = a.X
-		p.Y.Neg(&a.Y)
+		p.Y = a.Y
 		p.ZZ.SetOne()
 		p.ZZZ.SetOne()
 		return p
@@ -709,12 +774,11 @@ func (p *g1JacExtended) subMixed(a *G1Affine) *g1JacExtended {
 	P.Sub(&P, &p.X)
 
 	R.Mul(&a.Y, &p.ZZZ)
-	R.Neg(&R)
 	R.Sub(&R, &p.Y)
 
 	if P.IsZero() {
 		if R.IsZero() {
-			return p.doubleNegMixed(a)
+			return p.doubleMixed(a)
 
 		}
 		p.ZZ = fp.Element{}
@@ -741,10 +805,10 @@ func (p *g1JacExtended) subMixed(a *G1Affine) *g1JacExtended {
 
 }
 
-// addMixed sets p to p+q in extended Jacobian coordinates, where a.ZZ=1.
+// subMixed works the same as addMixed, but negates a.Y.
 //
 // http://www.hyperelliptic.org/EFD/g1p/auto-shortw-xyzz.html#addition-madd-2008-s
-func (p *g1JacExtended) addMixed(a *G1Affine) *g1JacExtended {
+func (p *g1JacExtended) subMixed(a *G1Affine) *g1JacExtended {
 
 	//if a is infinity return p
 	if a.IsInfinity() {
@@ -753,7 +817,7 @@ func (p *g1JacExtended) addMixed(a *G1Affine) *g1JacExtended {
 	// p is infinity, return a
 	if p.ZZ.IsZero() {
 		p.X = a.X
-		p.Y = a.Y
+		p.Y.Neg(&a.Y)
 		p.ZZ.SetOne()
 		p.ZZZ.SetOne()
 		return p
@@ -766,11 +830,12 @@ func (p *g1JacExtended) addMixed(a *G1Affine) *g1JacExtended {
 	P.Sub(&P, &p.X)
 
 	R.Mul(&a.Y, &p.ZZZ)
+	R.Neg(&R)
 	R.Sub(&R, &p.Y)
 
 	if P.IsZero() {
 		if R.IsZero() {
-			return p.doubleMixed(a)
+			return p.doubleNegMixed(a)
 
 		}
 		p.ZZ = fp.Element{}
@@ -798,22 +863,21 @@ func (p *g1JacExtended) addMixed(a *G1Affine) *g1JacExtended {
 }
 
 // doubleNegMixed works the same as double, but negates q.Y.
-func (p *g1JacExtended) doubleNegMixed(q *G1Affine) *g1JacExtended {
+func (p *g1JacExtended) doubleNegMixed(a *G1Affine) *g1JacExtended {
 
-	var Z, U, V, W, S, XX, M, S2, L fp.Element
+	var U, V, W, S, XX, M, S2, L fp.Element
 
-	U.Double(&q.Y)
+	U.Double(&a.Y)
 	U.Neg(&U)
 	V.Square(&U)
 	W.Mul(&U, &V)
-	S.Mul(&q.X, &V)
-	XX.Square(&q.X)
+	S.Mul(&a.X, &V)
+	XX.Square(&a.X)
 	M.Double(&XX).
 		Add(&M, &XX)
-	Z.Square(&p.ZZ)
-	M.Add(&M, &Z)
+	M.Add(&M, &aCurveCoeff)
 	S2.Double(&S)
-	L.Mul(&W, &q.Y)
+	L.Mul(&W, &a.Y)
 
 	p.X.Square(&M).
 		Sub(&p.X, &S2)
@@ -829,21 +893,20 @@ func (p *g1JacExtended) doubleNegMixed(q *G1Affine) *g1JacExtended {
 // doubleMixed sets p to [2]a in Jacobian extended coordinates, where a.ZZ=1.
 //
 // http://www.hyperelliptic.org/EFD/g1p/auto-shortw-xyzz.html#doubling-dbl-2008-s-1
-func (p *g1JacExtended) doubleMixed(q *G1Affine) *g1JacExtended {
+func (p *g1JacExtended) doubleMixed(a *G1Affine) *g1JacExtended {
 
-	var Z, U, V, W, S, XX, M, S2, L fp.Element
+	var U, V, W, S, XX, M, S2, L fp.Element
 
-	U.Double(&q.Y)
+	U.Double(&a.Y)
 	V.Square(&U)
 	W.Mul(&U, &V)
-	S.Mul(&q.X, &V)
-	XX.Square(&q.X)
+	S.Mul(&a.X, &V)
+	XX.Square(&a.X)
 	M.Double(&XX).
 		Add(&M, &XX)
-	Z.Square(&p.ZZ)
-	M.Add(&M, &Z)
+	M.Add(&M, &aCurveCoeff)
 	S2.Double(&S)
-	L.Mul(&W, &q.Y)
+	L.Mul(&W, &a.Y)
 
 	p.X.Square(&M).
 		Sub(&p.X, &S2)
@@ -904,3 +967,137 @@ func BatchJacobianToAffineG1(points []G1Jac) []G1Affine {
 
 	return result
 }
+
+// BatchScalarMultiplicationG1 multiplies the same base by all scalars
+// and return resulting points in affine coordinates
+// uses a simple windowed-NAF-like multiplication algorithm.
+func BatchScalarMultiplicationG1(base *G1Affine, scalars []fr.Element) []G1Affine {
+	// approximate cost in group ops is
+	// cost = 2^{c-1} + n(scalar.nbBits+nbChunks)
+
+	nbPoints := uint64(len(scalars))
+	min := ^uint64(0)
+	bestC := 0
+	for c := 2; c <= 16; c++ {
+		cost := uint64(1 << (c - 1)) // pre compute the table
+		nbChunks := computeNbChunks(uint64(c))
+		cost += nbPoints * (uint64(c) + 1) * nbChunks // doublings + point add
+		if cost < min {
+			min = cost
+			bestC = c
+		}
+	}
+	c := uint64(bestC) // window size
+	nbChunks := int(computeNbChunks(c))
+
+	// last window may be slightly larger than c; in which case we need to compute one
+	// extra element in the baseTable
+	maxC := lastC(c)
+	if c > maxC {
+		maxC = c
+	}
+
+	// precompute all powers of base for our window
+	// note here that if performance is critical, we can implement as in the msmX methods
+	// this allocation to be on the stack
+	baseTable := make([]G1Jac, (1 << (maxC - 1)))
+	baseTable[0].FromAffine(base)
+	for i := 1; i < len(baseTable); i++ {
+		baseTable[i] = baseTable[i-1]
+		baseTable[i].AddMixed(base)
+	}
+	// convert our base exp table into affine to use AddMixed
+	baseTableAff := BatchJacobianToAffineG1(baseTable)
+	toReturn := make([]G1Jac, len(scalars))
+
+	// partition the scalars into digits
+	digits, _ := partitionScalars(scalars, c, runtime.NumCPU())
+
+	// for each digit, take value in the base table, double it c time, voilà.
+	parallel.Execute(len(scalars), func(start, end int) {
+		var p G1Jac
+		for i := start; i < end; i++ {
+			p.Set(&g1Infinity)
+			for chunk := nbChunks - 1; chunk >= 0; chunk-- {
+				if chunk != nbChunks-1 {
+					for j := uint64(0); j < c; j++ {
+						p.DoubleAssign()
+					}
+				}
+				offset := chunk * len(scalars)
+				digit := digits[i+offset]
+
+				if digit == 0 {
+					continue
+				}
+
+				// if msbWindow bit is set, we need to subtract
+				if digit&1 == 0 {
+					// add
+					p.AddMixed(&baseTableAff[(digit>>1)-1])
+				} else {
+					// sub
+					t := baseTableAff[digit>>1]
+					t.Neg(&t)
+					p.AddMixed(&t)
+				}
+			}
+
+			// set our result point
+			toReturn[i] = p
+
+		}
+	})
+	toReturnAff := BatchJacobianToAffineG1(toReturn)
+	return toReturnAff
+}
+
+// batchAddG1Affine adds affine points using the Montgomery batch inversion trick.
+// Special cases (doubling, infinity) must be filtered out before this call.
+func batchAddG1Affine[TP pG1Affine, TPP ppG1Affine, TC cG1Affine](R *TPP, P *TP, batchSize int) {
+	var lambda, lambdain TC
+
+	// add part
+	for j := 0; j < batchSize; j++ {
+		lambdain[j].Sub(&(*P)[j].X, &(*R)[j].X)
+	}
+
+	// invert denominator using montgomery batch invert technique
+	{
+		var accumulator fp.Element
+		lambda[0].SetOne()
+		accumulator.Set(&lambdain[0])
+
+		for i := 1; i < batchSize; i++ {
+			lambda[i] = accumulator
+			accumulator.Mul(&accumulator, &lambdain[i])
+		}
+
+		accumulator.Inverse(&accumulator)
+
+		for i := batchSize - 1; i > 0; i-- {
+			lambda[i].Mul(&lambda[i], &accumulator)
+			accumulator.Mul(&accumulator, &lambdain[i])
+		}
+		lambda[0].Set(&accumulator)
+	}
+
+	var d fp.Element
+	var rr G1Affine
+
+	// add part
+	for j := 0; j < batchSize; j++ {
+		// computa lambda
+		d.Sub(&(*P)[j].Y, &(*R)[j].Y)
+		lambda[j].Mul(&lambda[j], &d)
+
+		// compute X, Y
+		rr.X.Square(&lambda[j])
+		rr.X.Sub(&rr.X, &(*R)[j].X)
+		rr.X.Sub(&rr.X, &(*P)[j].X)
+		d.Sub(&(*R)[j].X, &rr.X)
+		rr.Y.Mul(&lambda[j], &d)
+		rr.Y.Sub(&rr.Y, &(*R)[j].Y)
+		(*R)[j].Set(&rr)
+	}
+}
@@ -1,4 +1,4 @@
-// Copyright 2020 ConsenSys Software Inc.
+// Copyright 2020 Consensys Software Inc.
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -12,15 +12,18 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-// FOO
+// Code generated by consensys/gnark-crypto DO NOT EDIT
 
 package starkcurve
 
 import (
-	"crypto/rand"
+	"fmt"
 	"math/big"
+	"math/rand/v2"
 	"testing"
 
+	crand "crypto/rand"
+
 	"github.com/consensys/gnark-crypto/ecc/stark-curve/fp"
 
 	"github.com/consensys/gnark-crypto/ecc/stark-curve/fr"
@@ -28,53 +31,6 @@ import (
 	"github.com/leanovate/gopter/prop"
 )
 
-const (
-	nbFuzzShort = 10
-	nbFuzz      = 100
-)
-
-// define Gopters generators
-
-// GenFr generates an Fr element
-func GenFr() gopter.Gen {
-	return func(genParams *gopter.GenParameters) *gopter.GenResult {
-		var elmt fr.Element
-
-		if _, err := elmt.SetRandom(); err != nil {
-			panic(err)
-		}
-
-		return gopter.NewGenResult(elmt, gopter.NoShrinker)
-	}
-}
-
-// GenFp generates an Fp element
-func GenFp() gopter.Gen {
-	return func(genParams *gopter.GenParameters) *gopter.GenResult {
-		var elmt fp.Element
-
-		if _, err := elmt.SetRandom(); err != nil {
-			panic(err)
-		}
-
-		return gopter.NewGenResult(elmt, gopter.NoShrinker)
-	}
-}
-
-// GenBigInt generates a big.Int
-func GenBigInt() gopter.Gen {
-	return func(genParams *gopter.GenParameters) *gopter.GenResult {
-		var s big.Int
-		var b [fp.Bytes]byte
-		_, err := rand.Read(b[:]) //#nosec G404 weak rng is fine here
-		if err != nil {
-			panic(err)
-		}
-		s.SetBytes(b[:])
-		genResult := gopter.NewGenResult(s, gopter.NoShrinker)
-		return genResult
-	}
-}
 func TestG1AffineIsOnCurve(t *testing.T) {
 	t.Parallel()
 	parameters := gopter.DefaultTestParameters()
@@ -251,7 +207,103 @@ func TestG1AffineOps(t *testing.T) {
 
 	genScalar := GenFr()
 
-	properties.Property("[STARK-CURVE] [Jacobian] Add should call double when having adding the same point", prop.ForAll(
+	properties.Property("[STARK-CURVE] Add(P,-P) should return the point at infinity", prop.ForAll(
+		func(s fr.Element) bool {
+			var op1, op2 G1Affine
+			var sInt big.Int
+			g := g1GenAff
+			s.BigInt(&sInt)
+			op1.ScalarMultiplication(&g, &sInt)
+			op2.Neg(&op1)
+
+			op1.Add(&op1, &op2)
+			return op1.IsInfinity()
+
+		},
+		GenFr(),
+	))
+
+	properties.Property("[STARK-CURVE] Add(P,0) and Add(0,P) should return P", prop.ForAll(
+		func(s fr.Element) bool {
+			var op1, op2 G1Affine
+			var sInt big.Int
+			g := g1GenAff
+			s.BigInt(&sInt)
+			op1.ScalarMultiplication(&g, &sInt)
+			op2.setInfinity()
+
+			op1.Add(&op1, &op2)
+			op2.Add(&op2, &op1)
+			return op1.Equal(&op2)
+
+		},
+		GenFr(),
+	))
+
+	properties.Property("[STARK-CURVE] Add should call double when adding the same point", prop.ForAll(
+		func(s fr.Element) bool {
+			var op1, op2 G1Affine
+			var sInt big.Int
+			g := g1GenAff
+			s.BigInt(&sInt)
+			op1.ScalarMultiplication(&g, &sInt)
+
+			op2.Double(&op1)
+			op1.Add(&op1, &op1)
+			return op1.Equal(&op2)
+
+		},
+		GenFr(),
+	))
+
+	properties.Property("[STARK-CURVE] [2]G = double(G) + G - G", prop.ForAll(
+		func(s fr.Element) bool {
+			var sInt big.Int
+			g := g1GenAff
+			s.BigInt(&sInt)
+			g.ScalarMultiplication(&g, &sInt)
+			var op1, op2 G1Affine
+			op1.ScalarMultiplication(&g, big.NewInt(2))
+			op2.Double(&g)
+			op2.Add(&op2, &g)
+			op2.Sub(&op2, &g)
+			return op1.Equal(&op2)
+		},
+		GenFr(),
+	))
+
+	properties.Property("[STARK-CURVE] [-s]G = -[s]G", prop.ForAll(
+		func(s fr.Element) bool {
+			g := g1GenAff
+			var gj G1Jac
+			var nbs, bs big.Int
+			s.BigInt(&bs)
+			nbs.Neg(&bs)
+
+			var res = true
+
+			// mulGLV
+			{
+				var op1, op2 G1Affine
+				op1.ScalarMultiplication(&g, &bs).Neg(&op1)
+				op2.ScalarMultiplication(&g, &nbs)
+				res = res && op1.Equal(&op2)
+			}
+
+			// mulWindowed
+			{
+				var op1, op2 G1Jac
+				op1.mulWindowed(&gj, &bs).Neg(&op1)
+				op2.mulWindowed(&gj, &nbs)
+				res = res && op1.Equal(&op2)
+			}
+
+			return res
+		},
+		GenFr(),
+	))
+
+	properties.Property("[STARK-CURVE] [Jacobian] Add should call double when adding the same point", prop.ForAll(
 		func(a, b fp.Element) bool {
 			fop1 := fuzzG1Jac(&g1Gen, a)
 			fop2 := fuzzG1Jac(&g1Gen, b)
@@ -343,7 +395,7 @@ func TestG1AffineOps(t *testing.T) {
 
 			r := fr.Modulus()
 			var g G1Jac
-			g.mulWindowed(&g1Gen, r)
+			g.ScalarMultiplication(&g1Gen, r)
 
 			var scalar, blindedScalar, rminusone big.Int
 			var op1, op2, op3, gneg G1Jac
@@ -364,19 +416,14 @@ func TestG1AffineOps(t *testing.T) {
 	properties.Property("[STARK-CURVE] JointScalarMultiplicationBase and ScalarMultiplication should output the same results", prop.ForAll(
 		func(s1, s2 fr.Element) bool {
 
-			var op1, op2, op3, temp G1Jac
-			var a G1Affine
+			var op1, op2, temp G1Jac
 
-			temp.Double(&g1Gen)
-			a.FromJacobian(&temp)
-
-			op1.JointScalarMultiplicationBase(&a, s1.BigInt(new(big.Int)), s2.BigInt(new(big.Int)))
-			op2.JointScalarMultiplication(&g1Gen, &temp, s1.BigInt(new(big.Int)), s2.BigInt(new(big.Int)))
-			temp.ScalarMultiplication(&temp, s2.BigInt(new(big.Int)))
-			op3.ScalarMultiplication(&g1Gen, s1.BigInt(new(big.Int))).
+			op1.JointScalarMultiplicationBase(&g1GenAff, s1.BigInt(new(big.Int)), s2.BigInt(new(big.Int)))
+			temp.ScalarMultiplication(&g1Gen, s2.BigInt(new(big.Int)))
+			op2.ScalarMultiplication(&g1Gen, s1.BigInt(new(big.Int))).
 				AddAssign(&temp)
 
-			return op1.Equal(&op2) && op2.Equal(&op3)
+			return op1.Equal(&op2)
 
 		},
 		genScalar,
@@ -386,6 +433,56 @@ func TestG1AffineOps(t *testing.T) {
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
+func TestG1AffineBatchScalarMultiplication(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genScalar := GenFr()
+
+	// size of the multiExps
+	const nbSamples = 10
+
+	properties.Property("[STARK-CURVE] BatchScalarMultiplication should be consistent with individual scalar multiplications", prop.ForAll(
+		func(mixer fr.Element) bool {
+			// mixer ensures that all the words of a fpElement are set
+			var sampleScalars [nbSamples]fr.Element
+
+			for i := 1; i <= nbSamples; i++ {
+				sampleScalars[i-1].SetUint64(uint64(i)).
+					Mul(&sampleScalars[i-1], &mixer)
+			}
+
+			result := BatchScalarMultiplicationG1(&g1GenAff, sampleScalars[:])
+
+			if len(result) != len(sampleScalars) {
+				return false
+			}
+
+			for i := 0; i < len(result); i++ {
+				var expectedJac G1Jac
+				var expected G1Affine
+				var b big.Int
+				expectedJac.ScalarMultiplication(&g1Gen, sampleScalars[i].BigInt(&b))
+				expected.FromJacobian(&expectedJac)
+				if !result[i].Equal(&expected) {
+					return false
+				}
+			}
+			return true
+		},
+		genScalar,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
 // ------------------------------------------------------------
 // benches
 
@@ -399,6 +496,105 @@ func BenchmarkG1JacIsInSubGroup(b *testing.B) {
 
 }
 
+func BenchmarkG1JacEqual(b *testing.B) {
+	var scalar fp.Element
+	if _, err := scalar.SetRandom(); err != nil {
+		b.Fatalf("failed to set scalar: %s", err)
+	}
+
+	var a G1Jac
+	a.ScalarMultiplication(&g1Gen, big.NewInt(42))
+
+	b.Run("equal", func(b *testing.B) {
+		var scalarSquared fp.Element
+		scalarSquared.Square(&scalar)
+
+		aZScaled := a
+		aZScaled.X.Mul(&aZScaled.X, &scalarSquared)
+		aZScaled.Y.Mul(&aZScaled.Y, &scalarSquared).Mul(&aZScaled.Y, &scalar)
+		aZScaled.Z.Mul(&aZScaled.Z, &scalar)
+
+		// Check the setup.
+		if !a.Equal(&aZScaled) {
+			b.Fatalf("invalid test setup")
+		}
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			a.Equal(&aZScaled)
+		}
+	})
+
+	b.Run("not equal", func(b *testing.B) {
+		var aPlus1 G1Jac
+		aPlus1.AddAssign(&g1Gen)
+
+		// Check the setup.
+		if a.Equal(&aPlus1) {
+			b.Fatalf("invalid test setup")
+		}
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			a.Equal(&aPlus1)
+		}
+	})
+}
+
+func BenchmarkBatchAddG1Affine(b *testing.B) {
+
+	var P, R pG1AffineC16
+	var RR ppG1AffineC16
+	ridx := make([]int, len(P))
+
+	// TODO P == R may produce skewed benches
+	fillBenchBasesG1(P[:])
+	fillBenchBasesG1(R[:])
+
+	for i := 0; i < len(ridx); i++ {
+		ridx[i] = i
+	}
+
+	// random permute
+	rand.Shuffle(len(ridx), func(i, j int) { ridx[i], ridx[j] = ridx[j], ridx[i] })
+
+	for i, ri := range ridx {
+		RR[i] = &R[ri]
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		batchAddG1Affine[pG1AffineC16, ppG1AffineC16, cG1AffineC16](&RR, &P, len(P))
+	}
+}
+
+func BenchmarkG1AffineBatchScalarMultiplication(b *testing.B) {
+	// ensure every words of the scalars are filled
+	var mixer fr.Element
+	mixer.SetString("7716837800905789770901243404444209691916730933998574719964609384059111546487")
+
+	const pow = 15
+	const nbSamples = 1 << pow
+
+	var sampleScalars [nbSamples]fr.Element
+
+	for i := 1; i <= nbSamples; i++ {
+		sampleScalars[i-1].SetUint64(uint64(i)).
+			Mul(&sampleScalars[i-1], &mixer)
+	}
+
+	for i := 5; i <= pow; i++ {
+		using := 1 << i
+
+		b.Run(fmt.Sprintf("%d points", using), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				_ = BatchScalarMultiplicationG1(&g1GenAff, sampleScalars[:using])
+			}
+		})
+	}
+}
+
 func BenchmarkG1JacScalarMultiplication(b *testing.B) {
 
 	var scalar big.Int
@@ -518,6 +714,24 @@ func BenchmarkG1JacExtDouble(b *testing.B) {
 	}
 }
 
+func BenchmarkG1AffineAdd(b *testing.B) {
+	var a G1Affine
+	a.Double(&g1GenAff)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Add(&a, &g1GenAff)
+	}
+}
+
+func BenchmarkG1AffineDouble(b *testing.B) {
+	var a G1Affine
+	a.Double(&g1GenAff)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Double(&a)
+	}
+}
+
 func fuzzG1Jac(p *G1Jac, f fp.Element) G1Jac {
 	var res G1Jac
 	res.X.Mul(&p.X, &f).Mul(&res.X, &f)
@@ -537,3 +751,51 @@ func fuzzg1JacExtended(p *g1JacExtended, f fp.Element) g1JacExtended {
 	res.ZZZ.Mul(&p.ZZZ, &fff)
 	return res
 }
+
+const (
+	nbFuzzShort = 10
+	nbFuzz      = 100
+)
+
+// define Gopters generators
+
+// GenFr generates an Fr element
+func GenFr() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var elmt fr.Element
+
+		if _, err := elmt.SetRandom(); err != nil {
+			panic(err)
+		}
+
+		return gopter.NewGenResult(elmt, gopter.NoShrinker)
+	}
+}
+
+// GenFp generates an Fp element
+func GenFp() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var elmt fp.Element
+
+		if _, err := elmt.SetRandom(); err != nil {
+			panic(err)
+		}
+
+		return gopter.NewGenResult(elmt, gopter.NoShrinker)
+	}
+}
+
+// GenBigInt generates a big.Int
+func GenBigInt() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var s big.Int
+		var b [fp.Bytes]byte
+		_, err := crand.Read(b[:]) //#nosec G404 weak rng is fine here
+		if err != nil {
+			panic(err)
+		}
+		s.SetBytes(b[:])
+		genResult := gopter.NewGenResult(s, gopter.NoShrinker)
+		return genResult
+	}
+}
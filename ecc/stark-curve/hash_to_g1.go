@@ -1,4 +1,4 @@
-// Copyright 2020 ConsenSys Software Inc.
+// Copyright 2020 Consensys Software Inc.
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -12,7 +12,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-// FOO
+// Code generated by consensys/gnark-crypto DO NOT EDIT
 
 package starkcurve
 
@@ -56,7 +56,7 @@ func MapToCurve1(u *fp.Element) G1Affine {
 	x1.Sub(&c2, &tv4)   //    10.  x1 = c2 - tv4
 
 	gx1.Square(&x1)                    //    11. gx1 = x1²
-	gx1.Add(&gx1, &one)                // 12. gx1 = gx1 + A (A=1)
+	gx1.Add(&gx1, &aCurveCoeff)        //    12. gx1 = gx1 + A
 	gx1.Mul(&gx1, &x1)                 //    13. gx1 = gx1 * x1
 	gx1.Add(&gx1, &bCurveCoeff)        //    14. gx1 = gx1 + B
 	gx1NotSquare = gx1.Legendre() >> 1 //    15.  e1 = is_square(gx1)
@@ -64,7 +64,7 @@ func MapToCurve1(u *fp.Element) G1Affine {
 
 	x2.Add(&c2, &tv4)           //    16.  x2 = c2 + tv4
 	gx2.Square(&x2)             //    17. gx2 = x2²
-	gx2.Add(&gx2, &one)         //    18. gx2 = gx2 + A (A=1)
+	gx2.Add(&gx2, &aCurveCoeff) //    18. gx2 = gx2 + A
 	gx2.Mul(&gx2, &x2)          //    19. gx2 = gx2 * x2
 	gx2.Add(&gx2, &bCurveCoeff) //    20. gx2 = gx2 + B
 
@@ -83,8 +83,8 @@ func MapToCurve1(u *fp.Element) G1Affine {
 	// Select x1 iff gx1 is square iff gx1NotSquare = 0
 	x.Select(gx1SquareOrGx2Not, &x2, &x) //    28.   x = CMOV(x, x2, e2)    # x = x2 if gx2 is square and gx1 is not
 	// Select x2 iff gx2 is square and gx1 is not, iff gx1SquareOrGx2Not = 0
-	gx.Square(&x)     //    29.  gx = x²
-	gx.Add(&gx, &one) //    30.  gx = gx + A (A=1)
+	gx.Square(&x)             //    29.  gx = x²
+	gx.Add(&gx, &aCurveCoeff) //    30.  gx = gx + A
 
 	gx.Mul(&gx, &x)           //    31.  gx = gx * x
 	gx.Add(&gx, &bCurveCoeff) //    32.  gx = gx + B
@@ -153,3 +153,9 @@ func HashToG1(msg, dst []byte) (G1Affine, error) {
 	Q1.FromJacobian(&_Q1)
 	return Q1, nil
 }
+
+func g1NotZero(x *fp.Element) uint64 {
+
+	return x[0] | x[1] | x[2] | x[3]
+
+}
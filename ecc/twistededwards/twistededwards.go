@@ -16,4 +16,6 @@ const (
 	BW6_761
 	BW6_756
 	BW6_633
+	ED25519
+	ED448
 )
@@ -0,0 +1,438 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	errParseCurveParameter = errors.New("can't parse curve parameter")
+	errNotPrime            = errors.New("modulus is not prime")
+	errSingularCurve       = errors.New("curve is singular (4a³+27b² = 0)")
+	errZeroB               = errors.New("b = 0 is not supported: (0,0) encodes the point at infinity")
+	errGeneratorNotOnCurve = errors.New("generator is not on the curve")
+	errGeneratorOrder      = errors.New("generator is not of order r")
+	errInvalidCofactor     = errors.New("invalid cofactor: h·r is not in the Hasse interval [p+1-2√p, p+1+2√p]")
+	errInvalidGLV          = errors.New("invalid GLV endomorphism parameters")
+)
+
+// CurveSpec is the user facing description of a short Weierstrass curve
+// Y² = X³ + aX + b over 𝔽p, with a generator of a subgroup of prime order r.
+//
+// Integers are given in base 10 or, with a 0x prefix, in base 16. The name defaults to the package
+// name, and can't be the one of a pairing-friendly curve of gnark-crypto (see generator.GenerateCurve).
+// A spec can be decoded from YAML or JSON (see ReadCurveSpec):
+//
+//	name: secp256k1
+//	package: secp256k1
+//	import_path: github.com/me/mymodule/secp256k1
+//	fp: 0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f
+//	fr: 0xfffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141
+//	a: 0
+//	b: 7
+//	gx: 0x79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798
+//	gy: 0x483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8
+//	cofactor: 1
+//	glv:
+//	  third_root_one: 0x7ae96a2b657c07106e64479eac3434e99cf0497512f58995c1396c28719501ee
+//	  lambda: 0x5363ad4cc05c30e0a5261c028812645a122e22ea20816678df02967c1b23bd72
+type CurveSpec struct {
+	Name        string   `yaml:"name" json:"name"`
+	PackageName string   `yaml:"package" json:"package"`
+	PackagePath string   `yaml:"import_path" json:"import_path"` // import path of the generated package
+	FpModulus   string   `yaml:"fp" json:"fp"`
+	FrModulus   string   `yaml:"fr" json:"fr"`
+	A           string   `yaml:"a" json:"a"`
+	B           string   `yaml:"b" json:"b"`
+	GeneratorX  string   `yaml:"gx" json:"gx"`
+	GeneratorY  string   `yaml:"gy" json:"gy"`
+	Cofactor    string   `yaml:"cofactor" json:"cofactor"`
+	GLV         *GLVSpec `yaml:"glv,omitempty" json:"glv,omitempty"`
+}
+
+// GLVSpec describes the endomorphism ϕ: (x,y) → (ωx,y) of a j=0 curve,
+// where ω is a primitive third root of unity in 𝔽p, and λ is the eigenvalue
+// of ϕ on the subgroup of order r, i.e. ϕ(P) = [λ]P.
+type GLVSpec struct {
+	ThirdRootOne string `yaml:"third_root_one" json:"third_root_one"`
+	Lambda       string `yaml:"lambda" json:"lambda"`
+}
+
+// ReadCurveSpec decodes a CurveSpec from r. Since YAML is a superset of JSON,
+// both formats are accepted.
+func ReadCurveSpec(r io.Reader) (*CurveSpec, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	spec := new(CurveSpec)
+	if err := yaml.Unmarshal(data, spec); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// CurveConfig precomputed values used in template for code generation of curve APIs
+type CurveConfig struct {
+	Name        string
+	PackageName string
+	PackagePath string
+
+	Fp *FieldConfig
+	Fr *FieldConfig
+
+	// curve coefficients, generator and cofactor in base 10
+	A, B                   string
+	GeneratorX, GeneratorY string
+	Cofactor               string
+	AIsZero                bool
+	CofactorIsOne          bool
+
+	// GLV scalar multiplication
+	GLV          bool
+	ThirdRootOne string
+	Lambda       string
+
+	// MultiExp window sizes: the multiExp picks c in ImplementedCs
+	ImplementedCs []int
+
+	// SVDW holds Z, c1, c2, c3, c4 of the Shallue-van de Woestijne map
+	// https://www.rfc-editor.org/rfc/rfc9380#name-shallue-van-de-woestijne-met
+	SVDW []Element
+}
+
+// NewCurveConfig validates spec and returns a data structure with needed information to generate
+// apis for the curve.
+//
+// See field/generator package
+func NewCurveConfig(spec *CurveSpec) (*CurveConfig, error) {
+	if spec.PackageName == "" || spec.PackagePath == "" {
+		return nil, errors.New("package name and import path are required")
+	}
+	name := spec.Name
+	if name == "" {
+		name = spec.PackageName
+	}
+
+	parse := func(label, s string) (*big.Int, error) {
+		if s == "" {
+			return nil, fmt.Errorf("%w: %s is missing", errParseCurveParameter, label)
+		}
+		v, ok := new(big.Int).SetString(strings.TrimSpace(s), 0)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", errParseCurveParameter, label)
+		}
+		return v, nil
+	}
+
+	p, err := parse("fp", spec.FpModulus)
+	if err != nil {
+		return nil, err
+	}
+	r, err := parse("fr", spec.FrModulus)
+	if err != nil {
+		return nil, err
+	}
+	if !p.ProbablyPrime(32) || !r.ProbablyPrime(32) {
+		return nil, errNotPrime
+	}
+
+	curve := weierstrass{p: p}
+	if curve.a, err = parse("a", spec.A); err != nil {
+		return nil, err
+	}
+	if curve.b, err = parse("b", spec.B); err != nil {
+		return nil, err
+	}
+	curve.a.Mod(curve.a, p)
+	curve.b.Mod(curve.b, p)
+	if curve.b.Sign() == 0 {
+		return nil, errZeroB
+	}
+	if curve.isSingular() {
+		return nil, errSingularCurve
+	}
+
+	var g point
+	if g.x, err = parse("gx", spec.GeneratorX); err != nil {
+		return nil, err
+	}
+	if g.y, err = parse("gy", spec.GeneratorY); err != nil {
+		return nil, err
+	}
+	g.x.Mod(g.x, p)
+	g.y.Mod(g.y, p)
+	if !curve.isOnCurve(g) {
+		return nil, errGeneratorNotOnCurve
+	}
+	if !curve.mul(g, r).inf {
+		return nil, errGeneratorOrder
+	}
+
+	cofactor := big.NewInt(1)
+	if spec.Cofactor != "" {
+		if cofactor, err = parse("cofactor", spec.Cofactor); err != nil {
+			return nil, err
+		}
+	}
+	// the generated hash to curve clears the cofactor with h, the number of points h·r must
+	// satisfy |p+1-h·r| ≤ 2√p
+	if cofactor.Sign() <= 0 || !isInHasseInterval(new(big.Int).Mul(cofactor, r), p) {
+		return nil, errInvalidCofactor
+	}
+
+	C := &CurveConfig{
+		Name:          name,
+		PackageName:   spec.PackageName,
+		PackagePath:   strings.TrimSuffix(spec.PackagePath, "/"),
+		A:             curve.a.Text(10),
+		B:             curve.b.Text(10),
+		GeneratorX:    g.x.Text(10),
+		GeneratorY:    g.y.Text(10),
+		Cofactor:      cofactor.Text(10),
+		AIsZero:       curve.a.Sign() == 0,
+		CofactorIsOne: cofactor.Cmp(big.NewInt(1)) == 0,
+	}
+
+	if spec.GLV != nil {
+		omega, err := parse("third_root_one", spec.GLV.ThirdRootOne)
+		if err != nil {
+			return nil, err
+		}
+		lambda, err := parse("lambda", spec.GLV.Lambda)
+		if err != nil {
+			return nil, err
+		}
+		omega.Mod(omega, p)
+		lambda.Mod(lambda, r)
+		// ϕ: (x,y) → (ωx,y) is an endomorphism only if j=0
+		if !C.AIsZero {
+			return nil, fmt.Errorf("%w: a must be 0", errInvalidGLV)
+		}
+		if !isPrimitiveThirdRoot(omega, p) || !isPrimitiveThirdRoot(lambda, r) {
+			return nil, fmt.Errorf("%w: ω and λ must be primitive third roots of unity", errInvalidGLV)
+		}
+		phiG := point{x: new(big.Int).Mul(g.x, omega), y: new(big.Int).Set(g.y)}
+		phiG.x.Mod(phiG.x, p)
+		if !curve.mul(g, lambda).equal(phiG) {
+			return nil, fmt.Errorf("%w: ϕ(G) != [λ]G", errInvalidGLV)
+		}
+		C.GLV = true
+		C.ThirdRootOne = omega.Text(10)
+		C.Lambda = lambda.Text(10)
+	}
+
+	if C.Fp, err = NewFieldConfig("fp", "Element", p.Text(10), false); err != nil {
+		return nil, err
+	}
+	if C.Fr, err = NewFieldConfig("fr", "Element", r.Text(10), false); err != nil {
+		return nil, err
+	}
+
+	C.ImplementedCs = msmWindowSizes(C.Fr.NbBits)
+	C.SVDW = curve.svdwConstants()
+
+	return C, nil
+}
+
+// isInHasseInterval returns true if n is a possible number of points of an elliptic curve over 𝔽p,
+// that is if (p+1-n)² ≤ 4p
+func isInHasseInterval(n, p *big.Int) bool {
+	t := new(big.Int).Add(p, big.NewInt(1))
+	t.Sub(t, n)
+	t.Mul(t, t)
+	bound := new(big.Int).Lsh(p, 2)
+	return t.Cmp(bound) <= 0
+}
+
+// msmWindowSizes returns the window sizes c for which the multiExp may be called: the last window of
+// a scalar must accommodate a carry (from the NAF decomposition), and its digits are stored on uint16.
+func msmWindowSizes(frNbBits int) (implemented []int) {
+	for c := 4; c <= 16; c++ {
+		nbChunks := (frNbBits + c - 1) / c
+		nbAvailableBits := (nbChunks * c) - frNbBits
+		if lastC := c + 1 - nbAvailableBits; lastC > 16 {
+			continue
+		}
+		implemented = append(implemented, c)
+	}
+	return implemented
+}
+
+func isPrimitiveThirdRoot(x, modulus *big.Int) bool {
+	one := big.NewInt(1)
+	if x.Cmp(one) == 0 {
+		return false
+	}
+	var x3 big.Int
+	x3.Exp(x, big.NewInt(3), modulus)
+	return x3.Cmp(one) == 0
+}
+
+// weierstrass is a minimal big.Int implementation of Y² = X³ + aX + b over 𝔽p,
+// used to validate the user input and to compute constants.
+type weierstrass struct {
+	p, a, b *big.Int
+}
+
+type point struct {
+	x, y *big.Int
+	inf  bool
+}
+
+func (c *weierstrass) g(x *big.Int) *big.Int {
+	var res, tmp big.Int
+	res.Mul(x, x).Add(&res, c.a).Mul(&res, x)
+	res.Add(&res, c.b)
+	tmp.Mod(&res, c.p)
+	return &tmp
+}
+
+func (c *weierstrass) isSingular() bool {
+	var a3, b2 big.Int
+	a3.Exp(c.a, big.NewInt(3), c.p).Mul(&a3, big.NewInt(4))
+	b2.Exp(c.b, big.NewInt(2), c.p).Mul(&b2, big.NewInt(27))
+	a3.Add(&a3, &b2).Mod(&a3, c.p)
+	return a3.Sign() == 0
+}
+
+func (c *weierstrass) isOnCurve(P point) bool {
+	var y2 big.Int
+	y2.Mul(P.y, P.y).Mod(&y2, c.p)
+	return y2.Cmp(c.g(P.x)) == 0
+}
+
+func (P point) equal(Q point) bool {
+	if P.inf || Q.inf {
+		return P.inf == Q.inf
+	}
+	return P.x.Cmp(Q.x) == 0 && P.y.Cmp(Q.y) == 0
+}
+
+func (c *weierstrass) add(P, Q point) point {
+	if P.inf {
+		return Q
+	}
+	if Q.inf {
+		return P
+	}
+	var lambda, num, den big.Int
+	if P.x.Cmp(Q.x) == 0 {
+		var sum big.Int
+		sum.Add(P.y, Q.y).Mod(&sum, c.p)
+		if sum.Sign() == 0 {
+			return point{inf: true}
+		}
+		// λ = (3x² + a) / 2y
+		num.Mul(P.x, P.x).Mul(&num, big.NewInt(3)).Add(&num, c.a)
+		den.Lsh(P.y, 1)
+	} else {
+		// λ = (y₂ - y₁) / (x₂ - x₁)
+		num.Sub(Q.y, P.y)
+		den.Sub(Q.x, P.x)
+	}
+	den.Mod(&den, c.p).ModInverse(&den, c.p)
+	lambda.Mul(&num, &den).Mod(&lambda, c.p)
+
+	R := point{x: new(big.Int), y: new(big.Int)}
+	R.x.Mul(&lambda, &lambda).Sub(R.x, P.x).Sub(R.x, Q.x).Mod(R.x, c.p)
+	R.y.Sub(P.x, R.x).Mul(R.y, &lambda).Sub(R.y, P.y).Mod(R.y, c.p)
+	return R
+}
+
+func (c *weierstrass) mul(P point, s *big.Int) point {
+	res := point{inf: true}
+	for i := s.BitLen() - 1; i >= 0; i-- {
+		res = c.add(res, res)
+		if s.Bit(i) == 1 {
+			res = c.add(res, P)
+		}
+	}
+	return res
+}
+
+func (c *weierstrass) isSquare(x *big.Int) bool {
+	return big.Jacobi(x, c.p) != -1
+}
+
+// svdwConstants returns Z, c1, c2, c3, c4 as defined in RFC 9380, section 6.6.1,
+// with Z chosen by the find_z_svdw procedure (appendix H.1).
+func (c *weierstrass) svdwConstants() []Element {
+	p := c.p
+	mod := func(x *big.Int) *big.Int { return x.Mod(x, p) }
+	three, four := big.NewInt(3), big.NewInt(4)
+
+	// h(Z) = -(3Z² + 4A) / (4g(Z))
+	num := func(z *big.Int) *big.Int {
+		var res big.Int
+		res.Mul(z, z).Mul(&res, three)
+		var a4 big.Int
+		a4.Mul(c.a, four)
+		res.Add(&res, &a4)
+		return mod(&res)
+	}
+
+	var Z *big.Int
+	for ctr := int64(1); Z == nil; ctr++ {
+		for _, candidate := range []*big.Int{big.NewInt(ctr), mod(big.NewInt(-ctr))} {
+			gz := c.g(candidate)
+			if gz.Sign() == 0 {
+				continue
+			}
+			var h, den big.Int
+			den.Mul(gz, four).ModInverse(mod(&den), p)
+			h.Mul(num(candidate), &den).Neg(&h)
+			mod(&h)
+			if h.Sign() == 0 || !c.isSquare(&h) {
+				continue
+			}
+			var minusZHalf big.Int
+			minusZHalf.Neg(candidate).Mul(&minusZHalf, new(big.Int).ModInverse(big.NewInt(2), p))
+			if c.isSquare(gz) || c.isSquare(c.g(mod(&minusZHalf))) {
+				Z = candidate
+				break
+			}
+		}
+	}
+
+	gZ := c.g(Z)
+	// c1 = g(Z)
+	c1 := new(big.Int).Set(gZ)
+	// c2 = -Z / 2
+	c2 := new(big.Int).Neg(Z)
+	c2.Mul(c2, new(big.Int).ModInverse(big.NewInt(2), p))
+	mod(c2)
+	// c3 = sqrt(-g(Z) * (3Z² + 4A)), sgn0(c3) = 0
+	c3 := new(big.Int).Neg(gZ)
+	c3.Mul(c3, num(Z))
+	c3.ModSqrt(mod(c3), p)
+	if c3.Bit(0) == 1 {
+		c3.Sub(p, c3)
+	}
+	// c4 = -4g(Z) / (3Z² + 4A)
+	c4 := new(big.Int).Mul(gZ, four)
+	c4.Neg(c4)
+	c4.Mul(c4, new(big.Int).ModInverse(num(Z), p))
+	mod(c4)
+
+	return []Element{{*Z}, {*c1}, {*c2}, {*c3}, {*c4}}
+}
@@ -0,0 +1,140 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/field/generator/config"
+	"github.com/consensys/gnark-crypto/field/generator/internal/templates/curve"
+	eccconfig "github.com/consensys/gnark-crypto/internal/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/ecc"
+)
+
+// GenerateCurve will generate go files in outputDir for the short Weierstrass curve described by C:
+// the base and scalar fields (fp/ and fr/), the G1 group arithmetic, the multi-scalar multiplication,
+// the raw serialization and the SVDW hash-to-curve, with the same layout as ecc/secp256k1.
+//
+// Example usage
+//
+//	spec, _ := config.ReadCurveSpec(f)
+//	C, _ := config.NewCurveConfig(spec)
+//	generator.GenerateCurve(C, filepath.Join(baseDir, C.PackageName))
+func GenerateCurve(C *config.CurveConfig, outputDir string) error {
+	// the templates specialize G1 for the pairing-friendly curves of gnark-crypto, by name
+	for _, c := range eccconfig.Curves {
+		if c.HasG2() && c.Name == C.Name {
+			return fmt.Errorf("curve name %q is reserved", C.Name)
+		}
+	}
+
+	if err := os.MkdirAll(outputDir, 0700); err != nil {
+		return err
+	}
+
+	// generate the base and scalar fields
+	for _, F := range []*config.FieldConfig{C.Fp, C.Fr} {
+		fieldDir := filepath.Join(outputDir, F.PackageName)
+		if err := os.MkdirAll(fieldDir, 0700); err != nil {
+			return err
+		}
+		if err := GenerateFF(F, fieldDir); err != nil {
+			return err
+		}
+	}
+
+	bavardOpts := []func(*bavard.Bavard) error{
+		bavard.Apache2("ConsenSys Software Inc.", 2020),
+		bavard.Package(C.PackageName),
+		bavard.GeneratedBy("consensys/gnark-crypto"),
+	}
+
+	// the curve constants and the package doc
+	entries := []struct {
+		file      string
+		templates []string
+	}{
+		{"doc.go", []string{curve.Doc}},
+		{C.PackageName + ".go", []string{curve.Curve}},
+	}
+	for _, e := range entries {
+		if err := bavard.GenerateFromString(filepath.Join(outputDir, e.file), e.templates, C, bavardOpts...); err != nil {
+			return err
+		}
+	}
+
+	// the G1 arithmetic, multiExp, serialization and hash to curve, from the templates of the
+	// gnark-crypto curves
+	if err := ecc.GenerateG1(eccCurve(C), outputDir, bavardOpts...); err != nil {
+		return err
+	}
+
+	// the multiExp relies on a copy of internal/parallel
+	parallelDir := filepath.Join(outputDir, "internal", "parallel")
+	if err := os.MkdirAll(parallelDir, 0700); err != nil {
+		return err
+	}
+	parallelOpts := []func(*bavard.Bavard) error{
+		bavard.Apache2("ConsenSys Software Inc.", 2020),
+		bavard.Package("parallel"),
+		bavard.GeneratedBy("consensys/gnark-crypto"),
+	}
+	if err := bavard.GenerateFromString(filepath.Join(parallelDir, "execute.go"), []string{curve.Parallel}, C, parallelOpts...); err != nil {
+		return err
+	}
+
+	// run go fmt on whole directory
+	cmd := exec.Command("gofmt", "-s", "-w", outputDir)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// eccCurve returns the description of C used by the templates of the gnark-crypto curves
+func eccCurve(C *config.CurveConfig) eccconfig.Curve {
+	svdw := make([][]string, len(C.SVDW))
+	for i := range C.SVDW {
+		svdw[i] = []string{C.SVDW[i][0].Text(10)}
+	}
+
+	conf := eccconfig.Curve{
+		Name:         C.Name,
+		CurvePackage: C.PackageName,
+		Package:      C.PackageName,
+		ImportPath:   C.PackagePath,
+		FpModulus:    C.Fp.Modulus,
+		FrModulus:    C.Fr.Modulus,
+		Fp:           C.Fp,
+		Fr:           C.Fr,
+		FpUnusedBits: 64 - (C.Fp.NbBits % 64),
+		G1: eccconfig.Point{
+			CoordType:        "fp.Element",
+			CoordExtDegree:   1,
+			PointName:        "g1",
+			GLV:              C.GLV,
+			CofactorCleaning: !C.CofactorIsOne,
+			CRange:           append([]int(nil), C.ImplementedCs...),
+		},
+		HashE1: eccconfig.NewHashSuiteSvdw(svdw[0], svdw[1], svdw[2], svdw[3], svdw[4]),
+	}
+	if !C.AIsZero {
+		conf.G1.A = []string{C.A}
+	}
+	return conf
+}
@@ -0,0 +1,159 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/field/generator/config"
+)
+
+// integration test will generate curves from specs and run the generated tests
+
+const curveRootDir = "integration_test_curve"
+
+var curveSpecs = map[string]string{
+	// j=0, GLV, prime order
+	"secp256k1": `
+name: secp256k1
+fp: 0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f
+fr: 0xfffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141
+a: 0
+b: 7
+gx: 0x79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798
+gy: 0x483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8
+cofactor: 1
+glv:
+  third_root_one: "55594575648329892869085402983802832744385952214688224221778511981742606582254"
+  lambda: "37718080363155996902926221483475020450927657555482586988616620542887997980018"
+`,
+	// a = -3, prime order, spec in JSON
+	"p256": `{
+	"name": "p256",
+	"fp": "0xffffffff00000001000000000000000000000000ffffffffffffffffffffffff",
+	"fr": "0xffffffff00000000ffffffffffffffffbce6faada7179e84f3b9cac2fc632551",
+	"a": "-3",
+	"b": "0x5ac635d8aa3a93e7b3ebbd55769886bc651d06b0cc53b0f63bce3c3e27d2604b",
+	"gx": "0x6b17d1f2e12c4247f8bce6e563a440f277037d812deb33a0f4a13945d898c296",
+	"gy": "0x4fe342e2fe1a7f9b8ee7eb4a7c0f9e162bce33576b315ececbb6406837bf51f5",
+	"cofactor": "1"
+}`,
+	// j=0, GLV, cofactor != 1
+	"bls12381g1": `
+name: bls12-381-g1
+fp: 0x1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaab
+fr: 0x73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001
+a: 0
+b: 4
+gx: 0x17f1d3a73197d7942695638c4fa9ac0fc3688c4f9774b905a14e3a3f171bac586c55e83ff97a1aeffb3af00adb22c6bb
+gy: 0x08b3f481e3aaa0f1a09e30ed741d8ae4fcf5e095d5d00af600db18cb2c04b3edd03cc744a2888ae40caa232946c5e7e1
+cofactor: 0x396c8c005555e1568c00aaab0000aaab
+glv:
+  third_root_one: "4002409555221667392624310435006688643935503118305586438271171395842971157480381377015405980053539358417135540939436"
+  lambda: "228988810152649578064853576960394133503"
+`,
+}
+
+func TestIntegrationCurve(t *testing.T) {
+	os.RemoveAll(curveRootDir)
+	err := os.MkdirAll(curveRootDir, 0700)
+	defer os.RemoveAll(curveRootDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const importPath = "github.com/consensys/gnark-crypto/field/generator/" + curveRootDir
+
+	for packageName, s := range curveSpecs {
+		spec, err := config.ReadCurveSpec(strings.NewReader(s))
+		if err != nil {
+			t.Fatal(packageName, err)
+		}
+		spec.PackageName = packageName
+		spec.PackagePath = importPath + "/" + packageName
+
+		C, err := config.NewCurveConfig(spec)
+		if err != nil {
+			t.Fatal(packageName, err)
+		}
+		if err = GenerateCurve(C, filepath.Join(curveRootDir, packageName)); err != nil {
+			t.Fatal(packageName, err)
+		}
+	}
+
+	// run go test
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	packageDir := filepath.Join(wd, curveRootDir) + string(filepath.Separator) + "..."
+	cmd := exec.Command("go", "test", "-short", packageDir)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatal(string(out))
+	}
+}
+
+func TestCurveSpecValidation(t *testing.T) {
+	spec, err := config.ReadCurveSpec(strings.NewReader(curveSpecs["secp256k1"]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec.PackageName = "secp256k1"
+	spec.PackagePath = "example.com/secp256k1"
+
+	valid := *spec
+	if _, err := config.NewCurveConfig(&valid); err != nil {
+		t.Fatal(err)
+	}
+
+	offCurve := *spec
+	offCurve.B = "5"
+	if _, err := config.NewCurveConfig(&offCurve); err == nil {
+		t.Fatal("expected an error for a generator not on the curve")
+	}
+
+	wrongOrder := *spec
+	wrongOrder.FrModulus = "0xfffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd036413f"
+	if _, err := config.NewCurveConfig(&wrongOrder); err == nil {
+		t.Fatal("expected an error for a wrong subgroup order")
+	}
+
+	wrongCofactor := *spec
+	wrongCofactor.Cofactor = "4"
+	if _, err := config.NewCurveConfig(&wrongCofactor); err == nil {
+		t.Fatal("expected an error for a cofactor outside of the Hasse interval")
+	}
+
+	wrongGLV := *spec
+	wrongGLV.GLV = &config.GLVSpec{ThirdRootOne: spec.GLV.ThirdRootOne, Lambda: "2"}
+	if _, err := config.NewCurveConfig(&wrongGLV); err == nil {
+		t.Fatal("expected an error for invalid GLV parameters")
+	}
+
+	// the names of the pairing-friendly curves select their specialized templates
+	reserved := *spec
+	reserved.Name = "bn254"
+	C, err := config.NewCurveConfig(&reserved)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := GenerateCurve(C, t.TempDir()); err == nil {
+		t.Fatal("expected an error for the name of a gnark-crypto pairing-friendly curve")
+	}
+}
@@ -34,6 +34,7 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 		element.MulNoCarry,
 		element.Sqrt,
 		element.Inverse,
+		element.ConstantTime,
 		element.BigNum,
 	}
 
@@ -50,6 +51,7 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 
 	pathSrc := filepath.Join(outputDir, eName+".go")
 	pathSrcVector := filepath.Join(outputDir, "vector.go")
+	pathSrcAccumulator := filepath.Join(outputDir, "accumulator.go")
 	pathSrcFixedExp := filepath.Join(outputDir, eName+"_exp.go")
 	pathSrcArith := filepath.Join(outputDir, "arith.go")
 	pathTest := filepath.Join(outputDir, eName+"_test.go")
@@ -103,6 +105,11 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 		return err
 	}
 
+	// generate accumulator
+	if err := bavard.GenerateFromString(pathSrcAccumulator, []string{element.Accumulator}, F, bavardOpts...); err != nil {
+		return err
+	}
+
 	// generate arithmetics source file
 	if err := bavard.GenerateFromString(pathSrcArith, []string{element.Arith}, F, bavardOpts...); err != nil {
 		return err
@@ -0,0 +1,165 @@
+package curve
+
+const Doc = `// Package {{.PackageName}} efficient elliptic curve implementation for {{.Name}}.
+//
+// {{.Name}}: a short Weierstrass curve with
+//
+//	𝔽r: r={{.Fr.Modulus}}
+//	𝔽p: p={{.Fp.Modulus}}
+//	(E/𝔽p): Y²=X³+aX+b
+//	a={{.A}}
+//	b={{.B}}
+//	cofactor={{.Cofactor}}
+//
+// # Warning
+//
+// This code has not been audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
+package {{.PackageName}}
+`
+
+const Curve = `
+
+import (
+	{{- if or .GLV (not .CofactorIsOne)}}
+	"math/big"
+	{{- end}}
+
+	{{- if .GLV}}
+	"github.com/consensys/gnark-crypto/ecc"
+	"{{.PackagePath}}/fr"
+	{{- end}}
+	"{{.PackagePath}}/fp"
+)
+
+// aCurveCoeff is the a coefficients of the curve Y²=X³+ax+b
+var aCurveCoeff fp.Element
+var bCurveCoeff fp.Element
+
+// generator of the r-torsion group
+var g1Gen G1Jac
+
+var g1GenAff G1Affine
+
+// point at infinity
+var g1Infinity G1Jac
+
+{{- if not .CofactorIsOne}}
+
+// cofactorG1 is the cofactor of the r-torsion group, #E(𝔽p) = cofactorG1 ⋅ r
+var cofactorG1 big.Int
+{{- end}}
+
+{{- if .GLV}}
+
+// Parameters useful for the GLV scalar multiplication. The third roots define the
+// endomorphisms ϕ₁ for <G1Affine>. lambda is such that <r, ϕ-λ> lies above
+// <r> in the ring Z[ϕ]. More concretely it's the associated eigenvalue
+// of ϕ₁ restricted to <G1Affine>
+// see https://www.cosic.esat.kuleuven.be/nessie/reports/phase2/GLV.pdf
+var thirdRootOneG1 fp.Element
+var lambdaGLV big.Int
+
+// glvBasis stores R-linearly independent vectors (a,b), (c,d)
+// in ker((u,v) → u+vλ[r]), and their determinant
+var glvBasis ecc.Lattice
+{{- end}}
+
+func init() {
+	aCurveCoeff.SetString("{{.A}}")
+	bCurveCoeff.SetString("{{.B}}")
+
+	g1Gen.X.SetString("{{.GeneratorX}}")
+	g1Gen.Y.SetString("{{.GeneratorY}}")
+	g1Gen.Z.SetOne()
+
+	g1GenAff.FromJacobian(&g1Gen)
+
+	// (X,Y,Z) = (1,1,0)
+	g1Infinity.X.SetOne()
+	g1Infinity.Y.SetOne()
+
+	{{- if not .CofactorIsOne}}
+
+	cofactorG1.SetString("{{.Cofactor}}", 10)
+	{{- end}}
+
+	{{- if .GLV}}
+
+	thirdRootOneG1.SetString("{{.ThirdRootOne}}")
+	lambdaGLV.SetString("{{.Lambda}}", 10)
+	_r := fr.Modulus()
+	ecc.PrecomputeLattice(_r, &lambdaGLV, &glvBasis)
+	{{- end}}
+}
+
+// Generators return the generators of the r-torsion group
+func Generators() (g1Jac G1Jac, g1Aff G1Affine) {
+	g1Aff = g1GenAff
+	g1Jac = g1Gen
+	return
+}
+
+// CurveCoefficients returns the a, b coefficients of the curve equation.
+func CurveCoefficients() (a, b fp.Element) {
+	return aCurveCoeff, bCurveCoeff
+}
+`
+
+// Parallel is a copy of gnark-crypto/internal/parallel, which can't be imported from
+// outside of gnark-crypto.
+const Parallel = `
+import (
+	"runtime"
+	"sync"
+)
+
+// Execute process in parallel the work function
+func Execute(nbIterations int, work func(int, int), maxCpus ...int) {
+
+	nbTasks := runtime.NumCPU()
+	if len(maxCpus) == 1 {
+		nbTasks = maxCpus[0]
+		if nbTasks < 1 {
+			nbTasks = 1
+		} else if nbTasks > 512 {
+			nbTasks = 512
+		}
+	}
+
+	if nbTasks == 1 {
+		// no go routines
+		work(0, nbIterations)
+		return
+	}
+
+	nbIterationsPerCpus := nbIterations / nbTasks
+
+	// more CPUs than tasks: a CPU will work on exactly one iteration
+	if nbIterationsPerCpus < 1 {
+		nbIterationsPerCpus = 1
+		nbTasks = nbIterations
+	}
+
+	var wg sync.WaitGroup
+
+	extraTasks := nbIterations - (nbTasks * nbIterationsPerCpus)
+	extraTasksOffset := 0
+
+	for i := 0; i < nbTasks; i++ {
+		wg.Add(1)
+		_start := i*nbIterationsPerCpus + extraTasksOffset
+		_end := _start + nbIterationsPerCpus
+		if extraTasks > 0 {
+			_end++
+			extraTasks--
+			extraTasksOffset++
+		}
+		go func() {
+			work(_start, _end)
+			wg.Done()
+		}()
+	}
+
+	wg.Wait()
+}
+`
@@ -0,0 +1,117 @@
+package element
+
+// Accumulator is a wide accumulator of unreduced products, used to compute sums of products
+// with a single modular reduction.
+const Accumulator = `
+import (
+	"math/bits"
+)
+
+// Accumulator holds a sum of products of {{.ElementName}}s, ∑ xᵢ⋅yᵢ, without reducing them
+// modulo q; the products are accumulated as double-width integers (in Montgomery form, xᵢ⋅yᵢ⋅r²),
+// plus an extra word to absorb the carries.
+//
+// MulAcc costs roughly half a Mul, and a single Reduce is needed at the end; up to 2⁶⁴-1 products
+// can be accumulated before an overflow.
+//
+// The zero value is an empty accumulator, ready to use.
+type Accumulator struct {
+	t [{{add (mul .NbWords 2) 1}}]uint64
+}
+
+// MulAcc adds x*y to the accumulator and returns acc
+func (acc *Accumulator) MulAcc(x, y *{{.ElementName}}) *Accumulator {
+	// p = x * y, on {{mul .NbWords 2}} words
+	var p [{{mul .NbWords 2}}]uint64
+	var c uint64
+	{{- range $i := .NbWordsIndexesFull}}
+	{{- range $j := $.NbWordsIndexesFull}}
+	{{- if eq $j 0}}
+	c, p[{{$i}}] = madd1(x[{{$i}}], y[0], p[{{$i}}])
+	{{- else}}
+	c, p[{{add $i $j}}] = madd2(x[{{$i}}], y[{{$j}}], p[{{add $i $j}}], c)
+	{{- end}}
+	{{- end}}
+	p[{{add $i $.NbWords}}] = c
+	{{- end}}
+
+	// acc += p
+	{{- range $k := iterate 0 (mul .NbWords 2)}}
+	acc.t[{{$k}}], c = bits.Add64(acc.t[{{$k}}], p[{{$k}}], {{- if eq $k 0}}0{{- else}}c{{- end}})
+	{{- end}}
+	acc.t[{{mul .NbWords 2}}] += c
+
+	return acc
+}
+
+// Add sets acc = x + y and returns acc
+func (acc *Accumulator) Add(x, y *Accumulator) *Accumulator {
+	var c uint64
+	{{- range $k := iterate 0 (mul .NbWords 2)}}
+	acc.t[{{$k}}], c = bits.Add64(x.t[{{$k}}], y.t[{{$k}}], {{- if eq $k 0}}0{{- else}}c{{- end}})
+	{{- end}}
+	acc.t[{{mul .NbWords 2}}] = x.t[{{mul .NbWords 2}}] + y.t[{{mul .NbWords 2}}] + c
+	return acc
+}
+
+// Reset sets acc to 0 and returns acc
+func (acc *Accumulator) Reset() *Accumulator {
+	acc.t = [{{add (mul .NbWords 2) 1}}]uint64{}
+	return acc
+}
+
+// Reduce returns the accumulated sum, reduced modulo q
+func (acc *Accumulator) Reduce() {{.ElementName}} {
+	// the accumulator holds t = hi⋅r² + tHi⋅r + tLo, and the result (in Montgomery form)
+	// is t⋅r⁻¹ = hi⋅r + tHi + tLo⋅r⁻¹ (mod q).
+	// each term is computed with a textbook Montgomery multiplication, which
+	// unlike {{.ElementName}}.Mul accepts any left operand < r.
+	var tLo, tHi, hi [{{.NbWords}}]uint64
+	copy(tLo[:], acc.t[:{{.NbWords}}])
+	copy(tHi[:], acc.t[{{.NbWords}}:{{mul .NbWords 2}}])
+	hi[0] = acc.t[{{mul .NbWords 2}}]
+
+	var one, rOne, z, t {{.ElementName}}
+	one[0] = 1
+	rOne.SetOne()
+
+	montReduceWide(&z, &tLo, &one)
+	montReduceWide(&t, &tHi, &rOne)
+	z.Add(&z, &t)
+	montReduceWide(&t, &hi, &rSquare)
+	z.Add(&z, &t)
+
+	return z
+}
+
+// montReduceWide sets z = x⋅y⋅r⁻¹ mod q, for any x < r and y < q.
+func montReduceWide(z *{{.ElementName}}, x *[{{.NbWords}}]uint64, y *{{.ElementName}}) {
+	// textbook CIOS; at the end of each step t < q + y, on {{.NbWords}} words and a carry bit
+	var t [{{add .NbWords 2}}]uint64
+	var c, b uint64
+	for i := 0; i < {{.NbWords}}; i++ {
+		// t += x[i] * y
+		c = 0
+		for j := 0; j < {{.NbWords}}; j++ {
+			c, t[j] = madd2(x[i], y[j], t[j], c)
+		}
+		t[{{.NbWords}}], t[{{add .NbWords 1}}] = bits.Add64(t[{{.NbWords}}], c, 0)
+
+		// t = (t + m * q) / 2⁶⁴
+		m := t[0] * qInvNeg
+		c = madd0(m, q{{.ElementName}}[0], t[0])
+		for j := 1; j < {{.NbWords}}; j++ {
+			c, t[j-1] = madd2(m, q{{.ElementName}}[j], t[j], c)
+		}
+		t[{{sub .NbWords 1}}], b = bits.Add64(t[{{.NbWords}}], c, 0)
+		t[{{.NbWords}}] = t[{{add .NbWords 1}}] + b
+	}
+
+	copy(z[:], t[:{{.NbWords}}])
+	if t[{{.NbWords}}] != 0 || !z.smallerThanModulus() {
+		{{- range $i := .NbWordsIndexesFull}}
+		z[{{$i}}], {{- if eq $i $.NbWordsLastIndex}}_{{- else}}b{{- end}} = bits.Sub64(z[{{$i}}], q{{$i}}, {{- if eq $i 0}}0{{- else}}b{{- end}})
+		{{- end}}
+	}
+}
+`
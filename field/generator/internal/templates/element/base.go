@@ -7,6 +7,7 @@ import (
 	"math/bits"
 	"io"
 	"crypto/rand"
+	"encoding/base64"
 	"encoding/binary"
 	"strconv"
 	"errors"
@@ -0,0 +1,295 @@
+package element
+
+// ConstantTime holds the constant-time variants of Mul, Add, Sub, Inverse, Sqrt and Exp, to be
+// used when the operands (or the exponent) are secret.
+//
+// Mul, Square, Sub, ... end with a conditional subtraction (if z ⩾ q → z -= q) which branches
+// on the result; the functions below use instead mulCT and subCT, whose final reduction is a
+// masked subtraction, and fixed exponentiation sequences.
+const ConstantTime = `
+
+var (
+	// _bInverseCTExponent{{.ElementName}} is q-2, used by InverseCT (Fermat's little theorem)
+	_bInverseCTExponent{{.ElementName}} [Bytes]byte
+	// _bSqrtCTExponent{{.ElementName}} is the exponent used by SqrtCT, see Sqrt
+	_bSqrtCTExponent{{.ElementName}} [Bytes]byte
+)
+
+func init() {
+	e := Modulus()
+	e.Sub(e, big.NewInt(2))
+	e.FillBytes(_bInverseCTExponent{{.ElementName}}[:])
+
+	{{- if .SqrtQ3Mod4}}
+	e.SetString("{{.SqrtQ3Mod4Exponent}}", 16)
+	{{- else if .SqrtAtkin}}
+	e.SetString("{{.SqrtAtkinExponent}}", 16)
+	{{- else if .SqrtTonelliShanks}}
+	e.SetString("{{.SqrtSMinusOneOver2}}", 16)
+	{{- end }}
+	e.FillBytes(_bSqrtCTExponent{{.ElementName}}[:])
+}
+
+// mulCT z = x * y (mod q)
+//
+// mulCT is the textbook CIOS multiplication (see _mulGeneric) followed by a branchless
+// reduction; unlike Mul, its running time doesn't depend on x and y.
+func mulCT(z, x, y *{{.ElementName}}) {
+	var t [{{add .NbWords 1}}]uint64
+	var D uint64
+	var m, C uint64
+
+	{{- range $j := .NbWordsIndexesFull}}
+	{{- if eq $j 0}}
+	C, t[0] = bits.Mul64(y[{{$j}}], x[0])
+	{{- range $i := $.NbWordsIndexesNoZero}}
+	C, t[{{$i}}] = madd1(y[{{$j}}], x[{{$i}}], C)
+	{{- end}}
+	{{- else }}
+	C, t[0] = madd1(y[{{$j}}], x[0], t[0])
+	{{- range $i := $.NbWordsIndexesNoZero}}
+	C, t[{{$i}}] = madd2(y[{{$j}}], x[{{$i}}], t[{{$i}}], C)
+	{{- end}}
+	{{- end }}
+	t[{{$.NbWords}}], D = bits.Add64(t[{{$.NbWords}}], C, 0)
+	m = t[0] * qInvNeg
+	C = madd0(m, q0, t[0])
+	{{- range $i := $.NbWordsIndexesNoZero}}
+	C, t[{{sub $i 1}}] = madd2(m, q{{$i}}, t[{{$i}}], C)
+	{{- end}}
+	t[{{sub $.NbWords 1}}], C = bits.Add64(t[{{$.NbWords}}], C, 0)
+	t[{{$.NbWords}}], _ = bits.Add64(0, D, C)
+	{{- end}}
+
+	// t < 2q; s = t - q, and b = 1 iff t < q
+	var s [{{.NbWords}}]uint64
+	var b uint64
+	{{- range $i := .NbWordsIndexesFull}}
+	s[{{$i}}], b = bits.Sub64(t[{{$i}}], q{{$i}}, {{if eq $i 0}}0{{else}}b{{end}})
+	{{- end}}
+	_, b = bits.Sub64(t[{{.NbWords}}], 0, b)
+
+	// z = t if b == 1, s otherwise
+	mask := -b
+	{{- range $i := .NbWordsIndexesFull}}
+	z[{{$i}}] = s[{{$i}}] ^ (mask & (s[{{$i}}] ^ t[{{$i}}]))
+	{{- end}}
+}
+
+// addCT z = x + y (mod q)
+//
+// unlike Add, its running time doesn't depend on x and y
+func addCT(z, x, y *{{.ElementName}}) {
+	var t [{{.NbWords}}]uint64
+	var c uint64
+	{{- range $i := .NbWordsIndexesFull}}
+	t[{{$i}}], c = bits.Add64(x[{{$i}}], y[{{$i}}], {{if eq $i 0}}0{{else}}c{{end}})
+	{{- end}}
+
+	// t + c⋅2^{{mul 64 .NbWords}} < 2q; s = t - q, and b = 1 iff t + c⋅2^{{mul 64 .NbWords}} < q
+	var s [{{.NbWords}}]uint64
+	var b uint64
+	{{- range $i := .NbWordsIndexesFull}}
+	s[{{$i}}], b = bits.Sub64(t[{{$i}}], q{{$i}}, {{if eq $i 0}}0{{else}}b{{end}})
+	{{- end}}
+	_, b = bits.Sub64(c, 0, b)
+
+	// z = t if b == 1, s otherwise
+	mask := -b
+	{{- range $i := .NbWordsIndexesFull}}
+	z[{{$i}}] = s[{{$i}}] ^ (mask & (s[{{$i}}] ^ t[{{$i}}]))
+	{{- end}}
+}
+
+// subCT z = x - y (mod q)
+//
+// unlike Sub, its running time doesn't depend on x and y
+func subCT(z, x, y *{{.ElementName}}) {
+	var b uint64
+	{{- range $i := .NbWordsIndexesFull}}
+	z[{{$i}}], b = bits.Sub64(x[{{$i}}], y[{{$i}}], {{if eq $i 0}}0{{else}}b{{end}})
+	{{- end}}
+
+	// if x < y, z += q
+	mask := -b
+	{{- if gt .NbWords 1}}
+	var c uint64
+	{{- end}}
+	{{- range $i := .NbWordsIndexesFull}}
+	{{- if eq $i $.NbWordsLastIndex}}
+	z[{{$i}}], _ = bits.Add64(z[{{$i}}], q{{$i}}&mask, {{if eq $i 0}}0{{else}}c{{end}})
+	{{- else}}
+	z[{{$i}}], c = bits.Add64(z[{{$i}}], q{{$i}}&mask, {{if eq $i 0}}0{{else}}c{{end}})
+	{{- end}}
+	{{- end}}
+}
+
+// MulCT z = x * y (mod q)
+//
+// unlike Mul, its running time doesn't depend on x and y. x may be any {{.NbWords}}-word value, and
+// y must be less than q: in particular, z.MulCT(x, &{{.ElementName}}{1}) converts x from Montgomery form.
+func (z *{{.ElementName}}) MulCT(x, y *{{.ElementName}}) *{{.ElementName}} {
+	mulCT(z, x, y)
+	return z
+}
+
+// AddCT z = x + y (mod q)
+//
+// unlike Add, its running time doesn't depend on x and y
+func (z *{{.ElementName}}) AddCT(x, y *{{.ElementName}}) *{{.ElementName}} {
+	addCT(z, x, y)
+	return z
+}
+
+// SubCT z = x - y (mod q)
+//
+// unlike Sub, its running time doesn't depend on x and y
+func (z *{{.ElementName}}) SubCT(x, y *{{.ElementName}}) *{{.ElementName}} {
+	subCT(z, x, y)
+	return z
+}
+
+// expCT z = xᵉ (mod q), where e is encoded in big endian in buf
+//
+// expCT is a Montgomery ladder processing the 8*len(buf) bits of e with mulCT and
+// constant-time conditional swaps; its running time only depends on len(buf).
+func (z *{{.ElementName}}) expCT(x {{.ElementName}}, buf []byte) *{{.ElementName}} {
+	// r0 = x^(e >> i), r1 = r0 * x
+	var r0, r1, t0, t1 {{.ElementName}}
+	r0.SetOne()
+	r1.Set(&x)
+	for i := 8*len(buf) - 1; i >= 0; i-- {
+		bit := int((buf[len(buf)-1-i/8] >> (i % 8)) & 1)
+
+		// swap r0 and r1 if bit == 1
+		t0.Select(bit, &r0, &r1)
+		t1.Select(bit, &r1, &r0)
+
+		mulCT(&t1, &t0, &t1)
+		mulCT(&t0, &t0, &t0)
+
+		r0.Select(bit, &t0, &t1)
+		r1.Select(bit, &t1, &t0)
+	}
+
+	return z.Set(&r0)
+}
+
+// InverseCT z = x⁻¹ (mod q)
+//
+// if x == 0, sets and returns z = x
+//
+// InverseCT computes x^(q-2) with a fixed sequence of constant-time multiplications;
+// unlike Inverse, its running time doesn't depend on x.
+func (z *{{.ElementName}}) InverseCT(x *{{.ElementName}}) *{{.ElementName}} {
+	return z.expCT(*x, _bInverseCTExponent{{.ElementName}}[:])
+}
+
+// ExpCT z = xᵏ (mod q)
+//
+// ExpCT is a Montgomery ladder processing 8*Bytes bits of k, with constant-time multiplications
+// and conditional swaps; unlike Exp, for 0 ⩽ k < 2^Bits its running time doesn't depend on x
+// nor on k. The sign of k isn't hidden: if k < 0, x is inverted with InverseCT. Exponents
+// k ⩾ 2^Bits are first reduced modulo q-1 with math/big, which isn't constant-time.
+func (z *{{.ElementName}}) ExpCT(x {{.ElementName}}, k *big.Int) *{{.ElementName}} {
+	e := k
+	if k.Sign() == -1 {
+		// if k < 0: xᵏ (mod q) == (x⁻¹)ᵏ (mod q)
+		x.InverseCT(&x)
+
+		e = pool.BigInt.Get()
+		defer pool.BigInt.Put(e)
+		e.Neg(k)
+	}
+
+	if e.BitLen() > Bits {
+		// xᵏ == x^((k-1 mod q-1) + 1), including for x == 0 since k > 0
+		one := big.NewInt(1)
+		qMinusOne := Modulus()
+		qMinusOne.Sub(qMinusOne, one)
+
+		r := pool.BigInt.Get()
+		defer pool.BigInt.Put(r)
+		r.Sub(e, one).Mod(r, qMinusOne).Add(r, one)
+		e = r
+	}
+
+	var buf [Bytes]byte
+	e.FillBytes(buf[:])
+	return z.expCT(x, buf[:])
+}
+
+// SqrtCT z = √x (mod q)
+// if the square root doesn't exist (x is not a square mod q)
+// SqrtCT leaves z unchanged and returns nil
+//
+// SqrtCT performs the same sequence of constant-time operations for every x; only the
+// returned value reveals whether x is a square. When both roots exist, the one returned
+// may differ from Sqrt's.
+func (z *{{.ElementName}}) SqrtCT(x *{{.ElementName}}) *{{.ElementName}} {
+	var y, square {{.ElementName}}
+	{{- if .SqrtQ3Mod4}}
+	// q ≡ 3 (mod 4)
+	// using  y ≡ ± x^((p+1)/4) (mod q)
+	y.expCT(*x, _bSqrtCTExponent{{.ElementName}}[:])
+	{{- else if .SqrtAtkin}}
+	// q ≡ 5 (mod 8)
+	// see modSqrt5Mod8Prime in math/big/int.go
+	var one, two, alpha, tx {{.ElementName}}
+	one.SetOne()
+	two.SetUint64(2)
+	mulCT(&tx, x, &two)
+	alpha.expCT(tx, _bSqrtCTExponent{{.ElementName}}[:])
+	mulCT(&y, &alpha, &alpha)
+	mulCT(&y, &y, &tx)
+	subCT(&y, &y, &one)
+	mulCT(&y, &y, x)
+	mulCT(&y, &y, &alpha)
+	{{- else if .SqrtTonelliShanks}}
+	// q ≡ 1 (mod 4)
+	// constant-time Tonelli-Shanks, see RFC 9380, appendix I.4
+
+	var one, w, t, b, c, tmp {{.ElementName}}
+	one.SetOne()
+
+	// w = x^((s-1)/2))
+	w.expCT(*x, _bSqrtCTExponent{{.ElementName}}[:])
+
+	// y = x^((s+1)/2)) = w * x
+	mulCT(&y, x, &w)
+
+	// t = xˢ = w * y
+	mulCT(&t, &w, &y)
+
+	// c = nonResidue ^ s
+	c = {{.ElementName}}{
+		{{- range $i := .SqrtG}}
+		{{$i}},{{end}}
+	}
+
+	for i := uint64({{.SqrtE}}); i >= 2; i-- {
+		b = t
+		for j := uint64(1); j <= i-2; j++ {
+			mulCT(&b, &b, &b)
+		}
+		// e = 0 iff b == 1
+		e := int(b.NotEqual(&one))
+
+		mulCT(&tmp, &y, &c)
+		y.Select(e, &y, &tmp)
+
+		mulCT(&c, &c, &c)
+
+		mulCT(&tmp, &t, &c)
+		t.Select(e, &t, &tmp)
+	}
+	{{- end}}
+
+	// ensure we found y such that y * y = x
+	mulCT(&square, &y, &y)
+	if square.NotEqual(x) != 0 {
+		return nil
+	}
+	return z.Set(&y)
+}
+`
@@ -0,0 +1,86 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/consensys/gnark-crypto/field/generator"
+	field "github.com/consensys/gnark-crypto/field/generator/config"
+	"github.com/spf13/cobra"
+)
+
+var curveCmd = &cobra.Command{
+	Use:   "curve",
+	Short: "generates a short Weierstrass curve package (fp, fr, G1, multiExp, hash to curve) from a YAML or JSON spec",
+	Long: `generates a short Weierstrass curve package (fp, fr, G1, multiExp, hash to curve) from a YAML or JSON spec
+
+Example usage:
+
+	goff curve -s ./mycurve.yaml -o ./mycurve/
+
+See field/generator/config.CurveSpec for the spec format.`,
+	Run: cmdGenerateCurve,
+}
+
+// flags
+var (
+	fSpec string
+)
+
+func init() {
+	curveCmd.Flags().StringVarP(&fSpec, "spec", "s", "", "path to the curve spec (YAML or JSON)")
+	rootCmd.AddCommand(curveCmd)
+}
+
+func cmdGenerateCurve(cmd *cobra.Command, args []string) {
+	fmt.Println()
+	fmt.Println("running goff version", Version)
+	fmt.Println()
+
+	// parse flags
+	if fSpec == "" || fOutputDir == "" {
+		_ = cmd.Usage()
+		fmt.Printf("\n%s\n", errMissingArgument.Error())
+		os.Exit(-1)
+	}
+	fOutputDir = filepath.Clean(fOutputDir)
+
+	// read and validate the spec
+	f, err := os.Open(fSpec)
+	if err != nil {
+		fmt.Printf("\n%s\n", err.Error())
+		os.Exit(-1)
+	}
+	spec, err := field.ReadCurveSpec(f)
+	_ = f.Close()
+	if err != nil {
+		fmt.Printf("\n%s\n", err.Error())
+		os.Exit(-1)
+	}
+	C, err := field.NewCurveConfig(spec)
+	if err != nil {
+		fmt.Printf("\n%s\n", err.Error())
+		os.Exit(-1)
+	}
+
+	// generate code
+	if err := generator.GenerateCurve(C, fOutputDir); err != nil {
+		fmt.Printf("\n%s\n", err.Error())
+		os.Exit(-1)
+	}
+}
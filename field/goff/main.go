@@ -20,6 +20,11 @@
 //
 //	goff -m 0xffffffff00000001 -o ./goldilocks/ -p goldilocks -e Element
 //
+// goff can also generate a short Weierstrass curve package (base and scalar fields, G1 arithmetic,
+// multi-scalar multiplication and hash to curve) from a YAML or JSON spec:
+//
+//	goff curve -s ./mycurve.yaml -o ./mycurve/
+//
 // # Warning
 //
 // The generated code has not been audited for all moduli (only bn254 and bls12-381) and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
@@ -0,0 +1,23 @@
+package bulletproofs
+
+import (
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+
+	// aggregated range proofs, on top of the generators of the ipa package
+	conf.Package = "bulletproofs"
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "bulletproofs.go"), Templates: []string{"bulletproofs.go.tmpl"}},
+		{File: filepath.Join(baseDir, "inner_product.go"), Templates: []string{"inner_product.go.tmpl"}},
+		{File: filepath.Join(baseDir, "verify.go"), Templates: []string{"verify.go.tmpl"}},
+		{File: filepath.Join(baseDir, "bulletproofs_test.go"), Templates: []string{"bulletproofs.test.go.tmpl"}},
+	}
+	return bgen.Generate(conf, conf.Package, "./bulletproofs/template/", entries...)
+
+}
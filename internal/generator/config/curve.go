@@ -25,6 +25,10 @@ type Curve struct {
 
 	HashE1 HashSuite
 	HashE2 HashSuite
+
+	// ImportPath is the import path of a curve package generated outside of ecc/ (see
+	// field/generator.GenerateCurve), empty for the curves of gnark-crypto
+	ImportPath string
 }
 
 type TwistedEdwardsCurve struct {
@@ -38,6 +42,12 @@ type TwistedEdwardsCurve struct {
 	HasEndomorphism bool
 	Endo0, Endo1    string
 	Lambda          string
+
+	// set for the standalone curves of RFC 8032, which are not "companion curves" defined on the
+	// scalar field of a pairing-friendly curve: Scheme is the name of the signature scheme, and
+	// FpModulus the modulus of the base field
+	Scheme    string
+	FpModulus string
 }
 
 type Field struct {
@@ -50,6 +60,28 @@ func (c Curve) Equal(other Curve) bool {
 	return c.Name == other.Name
 }
 
+// PackagePath returns the import path of the curve package
+func (c Curve) PackagePath() string {
+	if c.ImportPath != "" {
+		return c.ImportPath
+	}
+	return "github.com/consensys/gnark-crypto/ecc/" + c.Name
+}
+
+// ParallelPath returns the import path of the parallel package used by the curve package. A curve
+// package generated outside of ecc/ can't import internal/parallel, and gets its own copy.
+func (c Curve) ParallelPath() string {
+	if c.ImportPath != "" {
+		return c.ImportPath + "/internal/parallel"
+	}
+	return "github.com/consensys/gnark-crypto/internal/parallel"
+}
+
+// HasG2 returns true if the curve has a G2 group, i.e. is pairing-friendly
+func (c Curve) HasG2() bool {
+	return c.G2.PointName != ""
+}
+
 type Point struct {
 	CoordType        string
 	CoordExtDegree   uint8 // value n, such that q = pⁿ
@@ -59,12 +91,13 @@ type Point struct {
 	CofactorCleaning bool     // flag telling if the Cofactor cleaning is available
 	CRange           []int    // multiexp bucket method: generate inner methods (with const arrays) for each c
 	Projective       bool     // generate projective coordinates
-	A                []string //A linear coefficient in Weierstrass form
+	A                []string //A linear coefficient in Weierstrass form, nil if A = 0
 	B                []string //B constant term in Weierstrass form
 }
 
 var Curves []Curve
 var TwistedEdwardsCurves []TwistedEdwardsCurve
+var RFC8032Curves []TwistedEdwardsCurve
 
 func defaultCRange() []int {
 	// default range for C values in the multiExp
@@ -82,6 +115,10 @@ func addTwistedEdwardCurve(c *TwistedEdwardsCurve) {
 	TwistedEdwardsCurves = append(TwistedEdwardsCurves, *c)
 }
 
+func addRFC8032Curve(c *TwistedEdwardsCurve) {
+	RFC8032Curves = append(RFC8032Curves, *c)
+}
+
 func newFieldInfo(modulus string) Field {
 	var F Field
 	var bModulus big.Int
@@ -0,0 +1,20 @@
+package config
+
+// https://www.rfc-editor.org/rfc/rfc8032#section-5.1
+var EDWARDS25519 = TwistedEdwardsCurve{
+	Name:      "edwards25519",
+	Package:   "edwards25519",
+	EnumID:    "ED25519",
+	A:         "-1",
+	D:         "37095705934669439343138083508754565189542113879843219016388785533085940283555",
+	Cofactor:  "8",
+	Order:     "7237005577332262213973186563042994240857116359379907606001950938285454250989",
+	BaseX:     "15112221349535400772501151409588531511454012693041857206046113283949847762202",
+	BaseY:     "46316835694926478169428394003475163141307993866256225615783033603165251855960",
+	Scheme:    "Ed25519",
+	FpModulus: "57896044618658097711785492504343953926634992332820282019728792003956564819949",
+}
+
+func init() {
+	addRFC8032Curve(&EDWARDS25519)
+}
@@ -0,0 +1,20 @@
+package config
+
+// https://www.rfc-editor.org/rfc/rfc8032#section-5.2
+var EDWARDS448 = TwistedEdwardsCurve{
+	Name:      "edwards448",
+	Package:   "edwards448",
+	EnumID:    "ED448",
+	A:         "1",
+	D:         "-39081",
+	Cofactor:  "4",
+	Order:     "181709681073901722637330951972001133588410340171829515070372549795146003961539585716195755291692375963310293709091662304773755859649779",
+	BaseX:     "224580040295924300187604334099896036246789641632564134246125461686950415467406032909029192869357953282578032075146446173674602635247710",
+	BaseY:     "298819210078481492676017930443930673437544040154080242095928241372331506189835876003536878655418784733982303233503462500531545062832660",
+	Scheme:    "Ed448",
+	FpModulus: "726838724295606890549323807888004534353641360687318060281490199180612328166730772686396383698676545930088884461843637361053498018365439",
+}
+
+func init() {
+	addRFC8032Curve(&EDWARDS448)
+}
@@ -54,6 +54,12 @@ type HashSuiteSvdw struct {
 	c4 []string
 }
 
+// NewHashSuiteSvdw returns the Shallue-van de Woestijne suite with constants Z, c1, c2, c3 and c4,
+// as coordinates in base 10
+func NewHashSuiteSvdw(z, c1, c2, c3, c4 []string) *HashSuiteSvdw {
+	return &HashSuiteSvdw{z: z, c1: c1, c2: c2, c3: c3, c4: c4}
+}
+
 func (parameters *HashSuiteSvdw) GetInfo(baseField *field.FieldConfig, g *Point, name string) HashSuiteInfo {
 	f := field.NewTower(baseField, g.CoordExtDegree, g.CoordExtRoot)
 	c := []field.Element{
@@ -193,6 +199,7 @@ type HashSuiteInfo struct {
 	Field             *field.Extension
 	FieldCoordName    string
 	Name              string
+	PackagePath       string // import path of the curve package
 	FieldSizeMod256   uint8
 	PrecomputedParams []field.Element // PrecomputedParams[0][n] correspond to integer cₙ₋₁ in std doc
 	// PrecomputedParams[n≥1] correspond to field element c_( len(PrecomputedParams[0]) + n - 1 ) in std doc
@@ -13,6 +13,7 @@ var STARK_CURVE = Curve{
 		GLV:              false,
 		CofactorCleaning: false,
 		CRange:           defaultCRange(),
+		A:                []string{"1"},
 	},
 	HashE1: &HashSuiteSvdw{
 		z:  []string{"1"},
@@ -30,6 +30,7 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 			{File: filepath.Join(baseDir, fmt.Sprintf("hash_to_%s_test.go", point.PointName)), Templates: []string{"tests/hash_to_curve.go.tmpl"}}}
 
 		hashConf := suite.GetInfo(conf.Fp, point, conf.Name)
+		hashConf.PackagePath = conf.PackagePath()
 
 		funcs := make(template.FuncMap)
 		funcs["asElement"] = hashConf.Field.Base.WriteElement
@@ -71,26 +72,98 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		{File: filepath.Join(baseDir, "multiexp_test.go"), Templates: []string{"tests/multiexp.go.tmpl"}},
 	}
 	conf.Package = packageName
+	funcs := msmFuncs(conf.Fr.NbBits)
+	conf.G1.CRange = extendCRange(conf.G1.CRange, conf.Fr.NbBits)
+	conf.G2.CRange = extendCRange(conf.G2.CRange, conf.Fr.NbBits)
+
+	bavardOpts := []func(*bavard.Bavard) error{bavard.Funcs(funcs)}
+	if err := bgen.GenerateWithOptions(conf, packageName, "./ecc/template", bavardOpts, entries...); err != nil {
+		return err
+	}
+
+	// No G2 for secp256k1 and stark-curve, and only the raw serialization of G1 (stark-curve
+	// keeps its own encoder)
+	if !conf.HasG2() {
+		if conf.Equal(config.STARK_CURVE) {
+			return nil
+		}
+		entries = []bavard.Entry{
+			{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal_raw.go.tmpl"}},
+			{File: filepath.Join(baseDir, "marshal_test.go"), Templates: []string{"tests/marshal_raw.go.tmpl"}},
+		}
+		return bgen.Generate(conf, packageName, "./ecc/template", entries...)
+	}
+
+	// marshal
+	entries = []bavard.Entry{
+		{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
+		{File: filepath.Join(baseDir, "marshal_test.go"), Templates: []string{"tests/marshal.go.tmpl"}},
+	}
+
+	marshal := []func(*bavard.Bavard) error{bavard.Funcs(funcs)}
+	if err := bgen.GenerateWithOptions(conf, packageName, "./ecc/template", marshal, entries...); err != nil {
+		return err
+	}
+
+	// G2
+	entries = []bavard.Entry{
+		{File: filepath.Join(baseDir, "g2.go"), Templates: []string{"point.go.tmpl"}},
+		{File: filepath.Join(baseDir, "g2_test.go"), Templates: []string{"tests/point.go.tmpl"}},
+	}
+	g2 := pconf{conf, conf.G2}
+	return bgen.Generate(g2, packageName, "./ecc/template", entries...)
+}
+
+type pconf struct {
+	config.Curve
+	config.Point
+}
+
+// lastC returns the last window size for a scalar of frNbBits bits;
+// this last window should accommodate a carry (from the NAF decomposition)
+// it can be == c if we have 1 available bit
+// it can be > c if we have 0 available bit
+// it can be < c if we have 2+ available bits
+func lastC(frNbBits, c int) int {
+	nbChunks := (frNbBits + c - 1) / c
+	nbAvailableBits := (nbChunks * c) - frNbBits
+	lc := c + 1 - nbAvailableBits
+	if lc > 16 {
+		panic("we have a problem since we are using uint16 to store digits")
+	}
+	return lc
+}
+
+// extendCRange adds to cRange the sizes of the last windows, for which the multiExp templates
+// also generate inner methods
+func extendCRange(cRange []int, frNbBits int) []int {
+	lastCs := make([]int, 0)
+	for {
+		for i := 0; i < len(cRange); i++ {
+			lc := lastC(frNbBits, cRange[i])
+			if !contains(cRange, lc) && !contains(lastCs, lc) {
+				lastCs = append(lastCs, lc)
+			}
+		}
+		if len(lastCs) == 0 {
+			return cRange
+		}
+		cRange = append(cRange, lastCs...)
+		sort.Ints(cRange)
+		lastCs = lastCs[:0]
+	}
+}
+
+// msmFuncs returns the helpers of the multiExp templates
+func msmFuncs(frNbBits int) template.FuncMap {
 	funcs := make(template.FuncMap)
 	funcs["last"] = func(x int, a interface{}) bool {
 		return x == reflect.ValueOf(a).Len()-1
 	}
-
-	// return the last window size for a scalar;
-	// this last window should accommodate a carry (from the NAF decomposition)
-	// it can be == c if we have 1 available bit
-	// it can be > c if we have 0 available bit
-	// it can be < c if we have 2+ available bits
-	lastC := func(c int) int {
-		nbChunks := (conf.Fr.NbBits + c - 1) / c
-		nbAvailableBits := (nbChunks * c) - conf.Fr.NbBits
-		lc := c + 1 - nbAvailableBits
-		if lc > 16 {
-			panic("we have a problem since we are using uint16 to store digits")
-		}
-		return lc
+	funcs["lastC"] = func(c int) int {
+		return lastC(frNbBits, c)
 	}
-	batchSize := func(c int) int {
+	funcs["batchSize"] = func(c int) int {
 		// nbBuckets := (1 << (c - 1))
 		// if c <= 12 {
 		// 	return nbBuckets/10 + 3*c
@@ -121,13 +194,9 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 			return 640
 		}
 	}
-	funcs["lastC"] = lastC
-	funcs["batchSize"] = batchSize
-
 	funcs["nbBuckets"] = func(c int) int {
 		return 1 << (c - 1)
 	}
-
 	funcs["contains"] = func(v int, s []int) bool {
 		for _, sv := range s {
 			if v == sv {
@@ -136,71 +205,7 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		}
 		return false
 	}
-	lastCG1 := make([]int, 0)
-	for {
-		for i := 0; i < len(conf.G1.CRange); i++ {
-			lc := lastC(conf.G1.CRange[i])
-			if !contains(conf.G1.CRange, lc) && !contains(lastCG1, lc) {
-				lastCG1 = append(lastCG1, lc)
-			}
-		}
-		if len(lastCG1) == 0 {
-			break
-		}
-		conf.G1.CRange = append(conf.G1.CRange, lastCG1...)
-		sort.Ints(conf.G1.CRange)
-		lastCG1 = lastCG1[:0]
-	}
-
-	lastCG2 := make([]int, 0)
-	for {
-		for i := 0; i < len(conf.G2.CRange); i++ {
-			lc := lastC(conf.G2.CRange[i])
-			if !contains(conf.G2.CRange, lc) && !contains(lastCG2, lc) {
-				lastCG2 = append(lastCG2, lc)
-			}
-		}
-		if len(lastCG2) == 0 {
-			break
-		}
-		conf.G2.CRange = append(conf.G2.CRange, lastCG2...)
-		sort.Ints(conf.G2.CRange)
-		lastCG2 = lastCG2[:0]
-	}
-
-	bavardOpts := []func(*bavard.Bavard) error{bavard.Funcs(funcs)}
-	if err := bgen.GenerateWithOptions(conf, packageName, "./ecc/template", bavardOpts, entries...); err != nil {
-		return err
-	}
-
-	// No G2 for secp256k1
-	if conf.Equal(config.SECP256K1) {
-		return nil
-	}
-
-	// marshal
-	entries = []bavard.Entry{
-		{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
-		{File: filepath.Join(baseDir, "marshal_test.go"), Templates: []string{"tests/marshal.go.tmpl"}},
-	}
-
-	marshal := []func(*bavard.Bavard) error{bavard.Funcs(funcs)}
-	if err := bgen.GenerateWithOptions(conf, packageName, "./ecc/template", marshal, entries...); err != nil {
-		return err
-	}
-
-	// G2
-	entries = []bavard.Entry{
-		{File: filepath.Join(baseDir, "g2.go"), Templates: []string{"point.go.tmpl"}},
-		{File: filepath.Join(baseDir, "g2_test.go"), Templates: []string{"tests/point.go.tmpl"}},
-	}
-	g2 := pconf{conf, conf.G2}
-	return bgen.Generate(g2, packageName, "./ecc/template", entries...)
-}
-
-type pconf struct {
-	config.Curve
-	config.Point
+	return funcs
 }
 
 func contains(slice []int, v int) bool {
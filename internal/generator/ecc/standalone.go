@@ -0,0 +1,81 @@
+package ecc
+
+import (
+	"embed"
+	"errors"
+	"path"
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+// templates are embedded for GenerateG1, which doesn't run from internal/generator
+//
+//go:embed template
+var templates embed.FS
+
+// GenerateG1 generates in baseDir the G1 arithmetic, the multiExp, the raw serialization and the
+// hash to G1 of a curve without G2, from the same templates as Generate.
+//
+// It is used by field/generator.GenerateCurve, for curves defined outside of gnark-crypto: the
+// curve package must declare the curve coefficients (aCurveCoeff if conf.G1.A is set, bCurveCoeff),
+// the generators g1Gen, g1GenAff and g1Infinity, the cofactor cofactorG1 if G1 has cofactor
+// cleaning, and the GLV parameters thirdRootOneG1, lambdaGLV and glvBasis if G1 has GLV.
+func GenerateG1(conf config.Curve, baseDir string, opts ...func(*bavard.Bavard) error) error {
+	if conf.HasG2() {
+		return errors.New("GenerateG1 doesn't generate G2, see Generate")
+	}
+
+	funcs := msmFuncs(conf.Fr.NbBits)
+	funcs["asElement"] = conf.Fp.WriteElement
+	opts = append(opts, bavard.Package(conf.Package), bavard.Funcs(funcs))
+
+	generate := func(data interface{}, entries ...bavard.Entry) error {
+		for _, entry := range entries {
+			tmpl := make([]string, len(entry.Templates))
+			for i, name := range entry.Templates {
+				b, err := templates.ReadFile(path.Join("template", name))
+				if err != nil {
+					return err
+				}
+				tmpl[i] = string(b)
+			}
+			if err := bavard.GenerateFromString(entry.File, tmpl, data, opts...); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// G1
+	if err := generate(pconf{conf, conf.G1},
+		bavard.Entry{File: filepath.Join(baseDir, "g1.go"), Templates: []string{"point.go.tmpl"}},
+		bavard.Entry{File: filepath.Join(baseDir, "g1_test.go"), Templates: []string{"tests/point.go.tmpl"}},
+	); err != nil {
+		return err
+	}
+
+	// hash to G1
+	if conf.HashE1 != nil {
+		hashConf := conf.HashE1.GetInfo(conf.Fp, &conf.G1, conf.Name)
+		hashConf.PackagePath = conf.PackagePath()
+		if err := generate(hashConf,
+			bavard.Entry{File: filepath.Join(baseDir, "hash_to_g1.go"), Templates: []string{"hash_to_curve.go.tmpl", "sswu.go.tmpl", "svdw.go.tmpl"}},
+			bavard.Entry{File: filepath.Join(baseDir, "hash_to_g1_test.go"), Templates: []string{"tests/hash_to_curve.go.tmpl"}},
+		); err != nil {
+			return err
+		}
+	}
+
+	// MSM and marshal
+	conf.G1.CRange = extendCRange(conf.G1.CRange, conf.Fr.NbBits)
+	return generate(conf,
+		bavard.Entry{File: filepath.Join(baseDir, "multiexp.go"), Templates: []string{"multiexp.go.tmpl"}},
+		bavard.Entry{File: filepath.Join(baseDir, "multiexp_affine.go"), Templates: []string{"multiexp_affine.go.tmpl"}},
+		bavard.Entry{File: filepath.Join(baseDir, "multiexp_jacobian.go"), Templates: []string{"multiexp_jacobian.go.tmpl"}},
+		bavard.Entry{File: filepath.Join(baseDir, "multiexp_test.go"), Templates: []string{"tests/multiexp.go.tmpl"}},
+		bavard.Entry{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal_raw.go.tmpl"}},
+		bavard.Entry{File: filepath.Join(baseDir, "marshal_test.go"), Templates: []string{"tests/marshal_raw.go.tmpl"}},
+	)
+}
@@ -18,6 +18,22 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		{File: filepath.Join(baseDir, "ecdsa_test.go"), Templates: []string{"ecdsa.test.go.tmpl"}},
 		{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
 		{File: filepath.Join(baseDir, "marshal_test.go"), Templates: []string{"marshal.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "prepared.go"), Templates: []string{"prepared.go.tmpl"}},
+		{File: filepath.Join(baseDir, "der.go"), Templates: []string{"der.go.tmpl"}},
+		{File: filepath.Join(baseDir, "der_test.go"), Templates: []string{"der.test.go.tmpl"}},
+	}
+	if conf.Equal(config.SECP256K1) || conf.Equal(config.BN254) || conf.Equal(config.STARK_CURVE) {
+		// batch verification needs the recovery of R from (r, v), and a multi-exponentiation on G1.
+		// It is left out for the curves with a cofactor, where the recovered R would also have to
+		// be checked to be in the subgroup, at a cost that defeats the batching.
+		entries = append(entries, bavard.Entry{File: filepath.Join(baseDir, "batch.go"), Templates: []string{"batch.go.tmpl"}})
+	}
+	if conf.Equal(config.SECP256K1) {
+		// Ethereum recoverable signatures and addresses
+		entries = append(entries,
+			bavard.Entry{File: filepath.Join(baseDir, "ethereum.go"), Templates: []string{"ethereum.go.tmpl"}},
+			bavard.Entry{File: filepath.Join(baseDir, "ethereum_test.go"), Templates: []string{"ethereum.test.go.tmpl"}},
+		)
 	}
 	return bgen.Generate(conf, conf.Package, "./ecdsa/template", entries...)
 
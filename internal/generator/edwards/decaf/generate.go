@@ -0,0 +1,28 @@
+package decaf
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+// Generate generates the prime order group of a twisted Edwards companion curve with a = -1: the
+// curve is quotiented by its 4-torsion (Decaf) or 8-torsion (Ristretto), depending on its cofactor.
+// The encoding relies on a = -1, and Generate fails for other curves.
+func Generate(conf config.TwistedEdwardsCurve, baseDir string, bgen *bavard.BatchGenerator) error {
+	if conf.A != "-1" {
+		return fmt.Errorf("decaf: %s/%s has a = %s, a = -1 is required", conf.Name, conf.Package, conf.A)
+	}
+	conf.Package = "decaf"
+	baseDir = filepath.Join(baseDir, conf.Package)
+
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "decaf.go"), Templates: []string{"decaf.go.tmpl"}},
+		{File: filepath.Join(baseDir, "elligator.go"), Templates: []string{"elligator.go.tmpl"}},
+		{File: filepath.Join(baseDir, "decaf_test.go"), Templates: []string{"decaf.test.go.tmpl"}},
+	}
+	return bgen.Generate(conf, conf.Package, "./edwards/decaf/template", entries...)
+}
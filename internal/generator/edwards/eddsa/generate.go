@@ -17,7 +17,23 @@ func Generate(conf config.TwistedEdwardsCurve, baseDir string, bgen *bavard.Batc
 		{File: filepath.Join(baseDir, "eddsa.go"), Templates: []string{"eddsa.go.tmpl"}},
 		{File: filepath.Join(baseDir, "eddsa_test.go"), Templates: []string{"eddsa.test.go.tmpl"}},
 		{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
+		{File: filepath.Join(baseDir, "batch.go"), Templates: []string{"batch.go.tmpl"}},
 	}
 	return bgen.Generate(conf, conf.Package, "./edwards/eddsa/template", entries...)
 
 }
+
+// GenerateRFC8032 generates the signature schemes of RFC 8032 on a standalone curve
+func GenerateRFC8032(conf config.TwistedEdwardsCurve, baseDir string, bgen *bavard.BatchGenerator) error {
+	conf.Package = "eddsa"
+	baseDir = filepath.Join(baseDir, conf.Package)
+
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"rfc8032/doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "eddsa.go"), Templates: []string{"rfc8032/eddsa.go.tmpl"}},
+		{File: filepath.Join(baseDir, "eddsa_test.go"), Templates: []string{"rfc8032/eddsa.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"rfc8032/marshal.go.tmpl"}},
+		{File: filepath.Join(baseDir, "basemul.go"), Templates: []string{"rfc8032/basemul.go.tmpl"}},
+	}
+	return bgen.Generate(conf, conf.Package, "./edwards/eddsa/template", entries...)
+}
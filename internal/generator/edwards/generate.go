@@ -1,7 +1,9 @@
 package edwards
 
 import (
+	"math/big"
 	"path/filepath"
+	"strings"
 
 	"github.com/consensys/bavard"
 	"github.com/consensys/gnark-crypto/internal/generator/config"
@@ -13,7 +15,57 @@ func Generate(conf config.TwistedEdwardsCurve, baseDir string, bgen *bavard.Batc
 		{File: filepath.Join(baseDir, "point_test.go"), Templates: []string{"tests/point.go.tmpl"}},
 		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
 		{File: filepath.Join(baseDir, "curve.go"), Templates: []string{"curve.go.tmpl"}},
+		{File: filepath.Join(baseDir, "multiexp.go"), Templates: []string{"multiexp.go.tmpl"}},
+		{File: filepath.Join(baseDir, "multiexp_test.go"), Templates: []string{"tests/multiexp.go.tmpl"}},
 	}
+	// the hash-to-curve suites of the RFC 8032 curves are not the ones of the companion curves
+	if conf.Scheme == "" {
+		entries = append(entries,
+			bavard.Entry{File: filepath.Join(baseDir, "hash_to_curve.go"), Templates: []string{"hash_to_curve.go.tmpl"}},
+			bavard.Entry{File: filepath.Join(baseDir, "hash_to_curve_test.go"), Templates: []string{"tests/hash_to_curve.go.tmpl"}},
+		)
+	}
+
+	data := struct {
+		config.TwistedEdwardsCurve
+		// CurveID identifier of the curve in the hash-to-curve suite IDs
+		CurveID string
+		// BaseField package name of the base field: fr for the companion curves, whose base field
+		// is the scalar field of the pairing-friendly curve, and fp for the RFC 8032 curves
+		BaseField string
+		// BaseFieldImport, ScalarFieldImport import specs of the base field and of the scalar field
+		BaseFieldImport, ScalarFieldImport string
+		// SizePointCompressed size in bytes of a compressed point
+		SizePointCompressed string
+	}{TwistedEdwardsCurve: conf, CurveID: curveID(conf)}
+
+	const eccPath = "github.com/consensys/gnark-crypto/ecc/"
+	if conf.Scheme == "" {
+		data.BaseField = "fr"
+		data.BaseFieldImport = `"` + eccPath + conf.Name + `/fr"`
+		data.ScalarFieldImport = `"` + eccPath + conf.Name + "/" + conf.Package + `/fr"`
+	} else {
+		data.BaseField = "fp"
+		data.BaseFieldImport = `"` + eccPath + conf.Name + `/fp"`
+		data.ScalarFieldImport = `"` + eccPath + conf.Name + `/fr"`
+	}
+	data.SizePointCompressed = data.BaseField + ".Bytes"
+	if conf.Scheme != "" {
+		// RFC 8032 encodes y and the sign of x in b bits, with 2ᵇ⁻¹ > p
+		p, _ := new(big.Int).SetString(conf.FpModulus, 10)
+		if p.BitLen()%8 == 0 {
+			data.SizePointCompressed += " + 1"
+		}
+	}
+
+	return bgen.Generate(data, conf.Package, "./edwards/template", entries...)
+}
 
-	return bgen.Generate(conf, conf.Package, "./edwards/template", entries...)
+// curveID returns the identifier of the curve in the hash-to-curve suite IDs, e.g. BN254TE for
+// the companion curve of bn254
+func curveID(conf config.TwistedEdwardsCurve) string {
+	if conf.Package != "twistededwards" {
+		return strings.ToUpper(conf.Package)
+	}
+	return strings.ToUpper(strings.ReplaceAll(conf.Name, "-", "")) + "TE"
 }
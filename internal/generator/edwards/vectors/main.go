@@ -0,0 +1,235 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// vectors writes the hash_vectors_test.go files of the twisted Edwards companion curves.
+//
+// RFC 9380 doesn't define hash-to-curve suites for these curves, so there are no external test
+// vectors: the ones written here are computed with the generated packages themselves, in the format
+// of RFC 9380, appendix J (domain separation tag "QUUX-V01-CS02-with-" followed by the suite ID, and
+// the same messages). They catch regressions, not a wrong implementation of the suites; re-run
+// vectors only after a deliberate change of the hash-to-curve constructions.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	frbls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	tebls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377/twistededwards"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch"
+	frbls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	tebls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/twistededwards"
+	frbls24315 "github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	tebls24315 "github.com/consensys/gnark-crypto/ecc/bls24-315/twistededwards"
+	frbls24317 "github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+	tebls24317 "github.com/consensys/gnark-crypto/ecc/bls24-317/twistededwards"
+	frbn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	tebn254 "github.com/consensys/gnark-crypto/ecc/bn254/twistededwards"
+	frbw6633 "github.com/consensys/gnark-crypto/ecc/bw6-633/fr"
+	tebw6633 "github.com/consensys/gnark-crypto/ecc/bw6-633/twistededwards"
+	frbw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761/fr"
+	tebw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761/twistededwards"
+)
+
+// dstPrefix is the prefix of the domain separation tags of the test vectors of RFC 9380
+const dstPrefix = "QUUX-V01-CS02-with-"
+
+// messages of the test vectors of RFC 9380
+var messages = []string{
+	"",
+	"abc",
+	"abcdef0123456789",
+	"q128_" + strings.Repeat("q", 128),
+	"a512_" + strings.Repeat("a", 512),
+}
+
+type point struct {
+	X, Y string
+}
+
+// testCase a test vector: the final output P, the points Q before cofactor clearing and the
+// field elements u
+type testCase struct {
+	Msg string
+	P   point
+	Q   []point
+	U   []string
+}
+
+// suite hashes a message to the curve, with count = 1 (EncodeToCurve) or count = 2 (HashToCurve)
+type suite func(msg, dst []byte, count int) (testCase, error)
+
+type curve struct {
+	dir                        string // relative to the root of the repository
+	pkg                        string
+	encodeSuiteID, hashSuiteID string
+	suite                      suite
+}
+
+//go:generate go run main.go
+func main() {
+	curves := []curve{
+		{"ecc/bls12-377/twistededwards", "twistededwards", tebls12377.EncodeToCurveSuiteID, tebls12377.HashToCurveSuiteID,
+			newSuite(frbls12377.Hash, tebls12377.MapToCurve, tebls12377.EncodeToCurve, tebls12377.HashToCurve,
+				func(p *tebls12377.PointAffine) (frbls12377.Element, frbls12377.Element) { return p.X, p.Y })},
+		{"ecc/bls12-381/twistededwards", "twistededwards", tebls12381.EncodeToCurveSuiteID, tebls12381.HashToCurveSuiteID,
+			newSuite(frbls12381.Hash, tebls12381.MapToCurve, tebls12381.EncodeToCurve, tebls12381.HashToCurve,
+				func(p *tebls12381.PointAffine) (frbls12381.Element, frbls12381.Element) { return p.X, p.Y })},
+		{"ecc/bls12-381/bandersnatch", "bandersnatch", bandersnatch.EncodeToCurveSuiteID, bandersnatch.HashToCurveSuiteID,
+			newSuite(frbls12381.Hash, bandersnatch.MapToCurve, bandersnatch.EncodeToCurve, bandersnatch.HashToCurve,
+				func(p *bandersnatch.PointAffine) (frbls12381.Element, frbls12381.Element) { return p.X, p.Y })},
+		{"ecc/bls24-315/twistededwards", "twistededwards", tebls24315.EncodeToCurveSuiteID, tebls24315.HashToCurveSuiteID,
+			newSuite(frbls24315.Hash, tebls24315.MapToCurve, tebls24315.EncodeToCurve, tebls24315.HashToCurve,
+				func(p *tebls24315.PointAffine) (frbls24315.Element, frbls24315.Element) { return p.X, p.Y })},
+		{"ecc/bls24-317/twistededwards", "twistededwards", tebls24317.EncodeToCurveSuiteID, tebls24317.HashToCurveSuiteID,
+			newSuite(frbls24317.Hash, tebls24317.MapToCurve, tebls24317.EncodeToCurve, tebls24317.HashToCurve,
+				func(p *tebls24317.PointAffine) (frbls24317.Element, frbls24317.Element) { return p.X, p.Y })},
+		{"ecc/bn254/twistededwards", "twistededwards", tebn254.EncodeToCurveSuiteID, tebn254.HashToCurveSuiteID,
+			newSuite(frbn254.Hash, tebn254.MapToCurve, tebn254.EncodeToCurve, tebn254.HashToCurve,
+				func(p *tebn254.PointAffine) (frbn254.Element, frbn254.Element) { return p.X, p.Y })},
+		{"ecc/bw6-633/twistededwards", "twistededwards", tebw6633.EncodeToCurveSuiteID, tebw6633.HashToCurveSuiteID,
+			newSuite(frbw6633.Hash, tebw6633.MapToCurve, tebw6633.EncodeToCurve, tebw6633.HashToCurve,
+				func(p *tebw6633.PointAffine) (frbw6633.Element, frbw6633.Element) { return p.X, p.Y })},
+		{"ecc/bw6-761/twistededwards", "twistededwards", tebw6761.EncodeToCurveSuiteID, tebw6761.HashToCurveSuiteID,
+			newSuite(frbw6761.Hash, tebw6761.MapToCurve, tebw6761.EncodeToCurve, tebw6761.HashToCurve,
+				func(p *tebw6761.PointAffine) (frbw6761.Element, frbw6761.Element) { return p.X, p.Y })},
+	}
+
+	tmpl := template.Must(template.New("vectors").Parse(vectorsTemplate))
+	for _, c := range curves {
+		if err := generate(tmpl, c, filepath.Join("..", "..", "..", "..", c.dir, "hash_vectors_test.go")); err != nil {
+			panic(err)
+		}
+	}
+	fmt.Println("successfully generated the hash-to-curve test vectors")
+}
+
+func generate(tmpl *template.Template, c curve, path string) error {
+	data := struct {
+		Package            string
+		EncodeDST, HashDST string
+		Encode, Hash       []testCase
+	}{Package: c.pkg, EncodeDST: dstPrefix + c.encodeSuiteID, HashDST: dstPrefix + c.hashSuiteID}
+
+	for _, msg := range messages {
+		tc, err := c.suite([]byte(msg), []byte(data.EncodeDST), 1)
+		if err != nil {
+			return err
+		}
+		data.Encode = append(data.Encode, tc)
+
+		if tc, err = c.suite([]byte(msg), []byte(data.HashDST), 2); err != nil {
+			return err
+		}
+		data.Hash = append(data.Hash, tc)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, src, 0o600)
+}
+
+// newSuite returns the suite of a curve, from its hash to field, map to curve and hash to curve
+// functions
+func newSuite[E any, PE interface {
+	*E
+	Text(base int) string
+}, P any](
+	hashToField func(msg, dst []byte, count int) ([]E, error),
+	mapToCurve func(u *E) P,
+	encodeToCurve, hashToCurve func(msg, dst []byte) (P, error),
+	coordinates func(p *P) (E, E),
+) suite {
+	hex := func(p *P) point {
+		x, y := coordinates(p)
+		return point{"0x" + PE(&x).Text(16), "0x" + PE(&y).Text(16)}
+	}
+
+	return func(msg, dst []byte, count int) (testCase, error) {
+		u, err := hashToField(msg, dst, count)
+		if err != nil {
+			return testCase{}, err
+		}
+		tc := testCase{Msg: string(msg)}
+		for i := range u {
+			q := mapToCurve(&u[i])
+			tc.Q = append(tc.Q, hex(&q))
+			tc.U = append(tc.U, "0x"+PE(&u[i]).Text(16))
+		}
+
+		var p P
+		if count == 1 {
+			p, err = encodeToCurve(msg, dst)
+		} else {
+			p, err = hashToCurve(msg, dst)
+		}
+		if err != nil {
+			return testCase{}, err
+		}
+		tc.P = hex(&p)
+		return tc, nil
+	}
+}
+
+const vectorsTemplate = `// Code generated by internal/generator/edwards/vectors DO NOT EDIT
+
+package {{.Package}}
+
+// RFC 9380 doesn't define hash-to-curve suites for this curve: the test vectors below are not
+// external ones. They were computed with this package by internal/generator/edwards/vectors, in
+// the format of RFC 9380, appendix J, and only guard against regressions.
+
+func init() {
+	encodeToCurveVector = encodeTestVector{
+		dst: []byte("{{.EncodeDST}}"),
+		cases: []encodeTestCase{
+			{
+			{{- range $i, $c := .Encode}}
+			{{- if $i}}
+			}, {
+			{{- end}}
+				msg: "{{.Msg}}", P: point{"{{.P.X}}", "{{.P.Y}}"},
+				Q: point{"{{(index .Q 0).X}}", "{{(index .Q 0).Y}}"},
+				u: "{{index .U 0}}",
+			{{- end}}
+			},
+		}}
+	hashToCurveVector = hashTestVector{
+		dst: []byte("{{.HashDST}}"),
+		cases: []hashTestCase{
+			{
+			{{- range $i, $c := .Hash}}
+			{{- if $i}}
+			}, {
+			{{- end}}
+				msg: "{{.Msg}}", P: point{"{{.P.X}}", "{{.P.Y}}"},
+				Q0: point{"{{(index .Q 0).X}}", "{{(index .Q 0).Y}}"},
+				Q1: point{"{{(index .Q 1).X}}", "{{(index .Q 1).Y}}"},
+				u0: "{{index .U 0}}", u1: "{{index .U 1}}",
+			{{- end}}
+			},
+		}}
+}
+`
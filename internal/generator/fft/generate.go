@@ -1,6 +1,7 @@
 package fft
 
 import (
+	"math/big"
 	"math/bits"
 	"path/filepath"
 
@@ -21,6 +22,16 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		{File: filepath.Join(baseDir, "fft.go"), Templates: []string{"fft.go.tmpl", "imports.go.tmpl"}},
 		{File: filepath.Join(baseDir, "bitreverse.go"), Templates: []string{"bitreverse.go.tmpl", "imports.go.tmpl"}},
 		{File: filepath.Join(baseDir, "options.go"), Templates: []string{"options.go.tmpl", "imports.go.tmpl"}},
+		{File: filepath.Join(baseDir, "mixed_radix.go"), Templates: []string{"mixed_radix.go.tmpl", "imports.go.tmpl"}},
+		{File: filepath.Join(baseDir, "mixed_radix_test.go"), Templates: []string{"tests/mixed_radix.go.tmpl", "imports.go.tmpl"}},
+		{File: filepath.Join(baseDir, "fourstep.go"), Templates: []string{"fourstep.go.tmpl", "imports.go.tmpl"}},
+		{File: filepath.Join(baseDir, "fourstep_test.go"), Templates: []string{"tests/fourstep.go.tmpl", "imports.go.tmpl"}},
+		{File: filepath.Join(baseDir, "mmap.go"), Templates: []string{"mmap.go.tmpl", "imports.go.tmpl"}, BuildTag: "unix"},
+		{File: filepath.Join(baseDir, "mmap_other.go"), Templates: []string{"mmap_other.go.tmpl", "imports.go.tmpl"}, BuildTag: "!unix"},
+		{File: filepath.Join(baseDir, "fft_group.go"), Templates: []string{"fft_group.go.tmpl", "imports.go.tmpl"}},
+		{File: filepath.Join(baseDir, "fft_group_test.go"), Templates: []string{"tests/fft_group.go.tmpl", "imports.go.tmpl"}},
+		{File: filepath.Join(baseDir, "extensions.go"), Templates: []string{"extensions.go.tmpl", "imports.go.tmpl"}},
+		{File: filepath.Join(baseDir, "extensions_test.go"), Templates: []string{"tests/extensions.go.tmpl", "imports.go.tmpl"}},
 	}
 
 	funcs := make(map[string]interface{})
@@ -47,6 +58,12 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 	funcs["logicalOr"] = func(x, y any) uint64 {
 		return anyToUint64(x) | anyToUint64(y)
 	}
+	funcs["extensions"] = func() []extension {
+		return []extension{
+			{Name: "E2", Coordinates: []string{"A0", "A1"}},
+			{Name: "E4", Coordinates: []string{"B0.A0", "B0.A1", "B1.A0", "B1.A1"}},
+		}
+	}
 
 	bavardOpts := []func(*bavard.Bavard) error{bavard.Funcs(funcs)}
 
@@ -59,7 +76,43 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 	entries = []bavard.Entry{
 		{File: filepath.Join(frDir, "generator.go"), Templates: []string{"fr.generator.go.tmpl"}},
 	}
-	return bgen.GenerateWithOptions(conf, "fr", "./fft/template/", bavardOpts, entries...)
+	if err := bgen.GenerateWithOptions(conf, "fr", "./fft/template/", bavardOpts, entries...); err != nil {
+		return err
+	}
+
+	// extensions of fr, in fr/extensions
+	extDir := filepath.Join(frDir, "extensions")
+	entries = []bavard.Entry{
+		{File: filepath.Join(extDir, "doc.go"), Templates: []string{"extensions/doc.go.tmpl"}},
+		{File: filepath.Join(extDir, "e2.go"), Templates: []string{"extensions/e2.go.tmpl", "imports.go.tmpl"}},
+		{File: filepath.Join(extDir, "e4.go"), Templates: []string{"extensions/e4.go.tmpl", "imports.go.tmpl"}},
+		{File: filepath.Join(extDir, "polynomial.go"), Templates: []string{"extensions/polynomial.go.tmpl", "imports.go.tmpl"}},
+		{File: filepath.Join(extDir, "extensions_test.go"), Templates: []string{"extensions/extensions.test.go.tmpl", "imports.go.tmpl"}},
+	}
+	conf.Package = "extensions"
+	extConf := extensionsConfig{Curve: conf, NonResidue: smallestNonResidue(conf.FrInfo.Modulus())}
+	return bgen.GenerateWithOptions(extConf, "extensions", "./fft/template/", bavardOpts, entries...)
+}
+
+// extension describes a tower extension of fr for the templates; Coordinates are the paths
+// to the fr coordinates of an element
+type extension struct {
+	Name        string
+	Coordinates []string
+}
+
+type extensionsConfig struct {
+	config.Curve
+	NonResidue int
+}
+
+// smallestNonResidue returns the smallest positive integer that is not a square mod q
+func smallestNonResidue(q *big.Int) int {
+	for i := 2; ; i++ {
+		if big.Jacobi(big.NewInt(int64(i)), q) == -1 {
+			return i
+		}
+	}
 }
 
 func anyToUint64(x any) uint64 {
@@ -0,0 +1,59 @@
+package ipa
+
+import (
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+// ipaConfig describes the group the inner-product argument is instantiated on
+type ipaConfig struct {
+	Package string
+
+	// CurvePath import path (relative to gnark-crypto/ecc) of the package defining the points
+	CurvePath string
+	// ScalarFieldPath import path (relative to gnark-crypto/ecc) of the scalar field of the group
+	ScalarFieldPath string
+	// BaseFieldPath import path (relative to gnark-crypto/ecc) of the base field, only used on twisted Edwards curves
+	BaseFieldPath string
+
+	// Affine and Projective are the names of the point types used to store and accumulate points
+	Affine, Projective string
+
+	TwistedEdwards bool
+}
+
+// Generate generates the inner-product argument on the G1 group of a curve
+func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+	return generate(ipaConfig{
+		Package:         "ipa",
+		CurvePath:       conf.Name,
+		ScalarFieldPath: conf.Name + "/fr",
+		Affine:          "G1Affine",
+		Projective:      "G1Jac",
+	}, baseDir, bgen)
+}
+
+// GenerateTwistedEdwards generates the inner-product argument on a twisted Edwards companion curve
+func GenerateTwistedEdwards(conf config.TwistedEdwardsCurve, baseDir string, bgen *bavard.BatchGenerator) error {
+	return generate(ipaConfig{
+		Package:         "ipa",
+		CurvePath:       conf.Name + "/" + conf.Package,
+		ScalarFieldPath: conf.Name + "/" + conf.Package + "/fr",
+		BaseFieldPath:   conf.Name + "/fr",
+		Affine:          "PointAffine",
+		Projective:      "PointExtended",
+		TwistedEdwards:  true,
+	}, baseDir, bgen)
+}
+
+func generate(conf ipaConfig, baseDir string, bgen *bavard.BatchGenerator) error {
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "ipa.go"), Templates: []string{"ipa.go.tmpl"}},
+		{File: filepath.Join(baseDir, "group.go"), Templates: []string{"group.go.tmpl"}},
+		{File: filepath.Join(baseDir, "ipa_test.go"), Templates: []string{"ipa.test.go.tmpl"}},
+	}
+	return bgen.Generate(conf, conf.Package, "./ipa/template/", entries...)
+}
@@ -10,27 +10,32 @@ import (
 	"github.com/consensys/bavard"
 	"github.com/consensys/gnark-crypto/field/generator"
 	field "github.com/consensys/gnark-crypto/field/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/bulletproofs"
 	"github.com/consensys/gnark-crypto/internal/generator/config"
 	"github.com/consensys/gnark-crypto/internal/generator/crypto/hash/mimc"
 	"github.com/consensys/gnark-crypto/internal/generator/ecc"
 	"github.com/consensys/gnark-crypto/internal/generator/ecdsa"
 	"github.com/consensys/gnark-crypto/internal/generator/edwards"
+	"github.com/consensys/gnark-crypto/internal/generator/edwards/decaf"
 	"github.com/consensys/gnark-crypto/internal/generator/edwards/eddsa"
 	"github.com/consensys/gnark-crypto/internal/generator/fft"
 	fri "github.com/consensys/gnark-crypto/internal/generator/fri/template"
 	"github.com/consensys/gnark-crypto/internal/generator/gkr"
 	"github.com/consensys/gnark-crypto/internal/generator/hash_to_field"
 	"github.com/consensys/gnark-crypto/internal/generator/iop"
+	"github.com/consensys/gnark-crypto/internal/generator/ipa"
 	"github.com/consensys/gnark-crypto/internal/generator/kzg"
 	"github.com/consensys/gnark-crypto/internal/generator/pairing"
 	"github.com/consensys/gnark-crypto/internal/generator/pedersen"
 	"github.com/consensys/gnark-crypto/internal/generator/permutation"
 	"github.com/consensys/gnark-crypto/internal/generator/plookup"
 	"github.com/consensys/gnark-crypto/internal/generator/polynomial"
+	"github.com/consensys/gnark-crypto/internal/generator/pst"
 	"github.com/consensys/gnark-crypto/internal/generator/sis"
 	"github.com/consensys/gnark-crypto/internal/generator/sumcheck"
 	"github.com/consensys/gnark-crypto/internal/generator/test_vector_utils"
 	"github.com/consensys/gnark-crypto/internal/generator/tower"
+	"github.com/consensys/gnark-crypto/internal/generator/zeromorph"
 )
 
 const (
@@ -69,12 +74,20 @@ func main() {
 			// generate ecdsa
 			assertNoError(ecdsa.Generate(conf, curveDir, bgen))
 
+			// generate G1, G2, multiExp, ...
+			assertNoError(ecc.Generate(conf, curveDir, bgen))
+
 			if conf.Equal(config.STARK_CURVE) {
 				return // TODO @yelhousni
 			}
 
-			// generate G1, G2, multiExp, ...
-			assertNoError(ecc.Generate(conf, curveDir, bgen))
+			// generate ipa (inner-product argument) on G1
+			assertNoError(ipa.Generate(conf, filepath.Join(curveDir, "ipa"), bgen))
+
+			if conf.Equal(config.BN254) || conf.Equal(config.SECP256K1) {
+				// generate bulletproofs range proofs on G1
+				assertNoError(bulletproofs.Generate(conf, filepath.Join(curveDir, "bulletproofs"), bgen))
+			}
 
 			if conf.Equal(config.SECP256K1) {
 				return
@@ -99,6 +112,12 @@ func main() {
 			// generate kzg on fr
 			assertNoError(kzg.Generate(conf, filepath.Join(curveDir, "kzg"), bgen))
 
+			// generate pst (multilinear kzg) on fr
+			assertNoError(pst.Generate(conf, filepath.Join(curveDir, "pst"), bgen))
+
+			// generate zeromorph (multilinear commitments on top of kzg) on fr
+			assertNoError(zeromorph.Generate(conf, filepath.Join(curveDir, "zeromorph"), bgen))
+
 			// generate pedersen on fr
 			assertNoError(pedersen.Generate(conf, filepath.Join(curveDir, "fr", "pedersen"), bgen))
 
@@ -118,7 +137,7 @@ func main() {
 			}
 
 			// generate polynomial on fr
-			assertNoError(polynomial.Generate(frInfo, filepath.Join(curveDir, "fr", "polynomial"), true, bgen))
+			assertNoError(polynomial.Generate(frInfo, filepath.Join(curveDir, "fr", "polynomial"), true, true, bgen))
 
 			// generate eddsa on companion curves
 			assertNoError(fri.Generate(conf, filepath.Join(curveDir, "fr", "fri"), bgen))
@@ -168,10 +187,50 @@ func main() {
 
 			// generate eddsa on companion curves
 			assertNoError(eddsa.Generate(conf, curveDir, bgen))
+
+			// generate the scalar field of the companion curves
+			frConf, err := field.NewFieldConfig("fr", "Element", conf.Order, false)
+			assertNoError(err)
+			assertNoError(generator.GenerateFF(frConf, filepath.Join(curveDir, "fr")))
+
+			// generate ipa (inner-product argument) on companion curves
+			assertNoError(ipa.GenerateTwistedEdwards(conf, filepath.Join(curveDir, "ipa"), bgen))
+
+			// generate the prime order groups of the companion curves with a = -1
+			if conf.A == "-1" {
+				assertNoError(decaf.Generate(conf, curveDir, bgen))
+			}
 		}(conf)
 
 	}
 
+	for _, conf := range config.RFC8032Curves {
+		wg.Add(1)
+
+		go func(conf config.TwistedEdwardsCurve) {
+			defer wg.Done()
+
+			curveDir := filepath.Join(baseDir, "ecc", conf.Name)
+
+			// generate the base and scalar fields of the curve. The base fields don't meet the
+			// no-carry condition of the assembly, and the scalar fields are only used for a few
+			// operations per signature: both are pure go.
+			fpConf, err := field.NewFieldConfig("fp", "Element", conf.FpModulus, false)
+			assertNoError(err)
+			assertNoError(generator.GenerateFF(fpConf, filepath.Join(curveDir, "fp")))
+			frConf, err := field.NewFieldConfig("fr", "Element", conf.Order, false)
+			assertNoError(err)
+			frConf.ASM = false
+			assertNoError(generator.GenerateFF(frConf, filepath.Join(curveDir, "fr")))
+
+			// generate the curve, with the twisted edwards templates
+			assertNoError(edwards.Generate(conf, curveDir, bgen))
+
+			// generate the signature schemes of RFC 8032
+			assertNoError(eddsa.GenerateRFC8032(conf, curveDir, bgen))
+		}(conf)
+	}
+
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
@@ -7,20 +7,32 @@ import (
 	"github.com/consensys/gnark-crypto/internal/generator/config"
 )
 
-func Generate(conf config.FieldDependency, baseDir string, generateTests bool, bgen *bavard.BatchGenerator) error {
+// Generate generates the polynomial package for the field described by conf; when withFFT is set,
+// the field has an fft package and the FFT-based arithmetic (multiplication, division, interpolation...)
+// is generated as well.
+func Generate(conf config.FieldDependency, baseDir string, generateTests, withFFT bool, bgen *bavard.BatchGenerator) error {
 
 	entries := []bavard.Entry{
 		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
 		{File: filepath.Join(baseDir, "polynomial.go"), Templates: []string{"polynomial.go.tmpl"}},
 		{File: filepath.Join(baseDir, "multilin.go"), Templates: []string{"multilin.go.tmpl"}},
 		{File: filepath.Join(baseDir, "pool.go"), Templates: []string{"pool.go.tmpl"}},
+		{File: filepath.Join(baseDir, "multilin_ops.go"), Templates: []string{"multilin_ops.go.tmpl"}},
+	}
+
+	if withFFT {
+		entries = append(entries, bavard.Entry{File: filepath.Join(baseDir, "arithmetic.go"), Templates: []string{"arithmetic.go.tmpl"}})
 	}
 
 	if generateTests {
 		entries = append(entries,
 			bavard.Entry{File: filepath.Join(baseDir, "polynomial_test.go"), Templates: []string{"polynomial.test.go.tmpl"}},
 			bavard.Entry{File: filepath.Join(baseDir, "multilin_test.go"), Templates: []string{"multilin.test.go.tmpl"}},
+			bavard.Entry{File: filepath.Join(baseDir, "multilin_ops_test.go"), Templates: []string{"multilin_ops.test.go.tmpl"}},
 		)
+		if withFFT {
+			entries = append(entries, bavard.Entry{File: filepath.Join(baseDir, "arithmetic_test.go"), Templates: []string{"arithmetic.test.go.tmpl"}})
+		}
 	}
 
 	return bgen.Generate(conf, "polynomial", "./polynomial/template/", entries...)
@@ -0,0 +1,21 @@
+package pst
+
+import (
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+
+	// multilinear KZG commitment scheme
+	conf.Package = "pst"
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "pst.go"), Templates: []string{"pst.go.tmpl"}},
+		{File: filepath.Join(baseDir, "pst_test.go"), Templates: []string{"pst.test.go.tmpl"}},
+	}
+	return bgen.Generate(conf, conf.Package, "./pst/template/", entries...)
+
+}
@@ -257,7 +257,8 @@ func (c *eqTimesGateEvalSumcheckClaims) computeGJ() polynomial.Polynomial {
 	computeAll := func(start, end int) {
 		var step small_rational.SmallRational
 
-		res := make([]small_rational.SmallRational, degGJ)
+		// the sums of products are accumulated without reduction
+		res := make([]small_rational.Accumulator, degGJ)
 		operands := make([]small_rational.SmallRational, degGJ*nbInner)
 
 		for i := start; i < end; i++ {
@@ -276,14 +277,14 @@ func (c *eqTimesGateEvalSumcheckClaims) computeGJ() polynomial.Polynomial {
 			_e := nbInner
 			for d := 0; d < degGJ; d++ {
 				summand := c.wire.Gate.Evaluate(operands[_s+1 : _e]...)
-				summand.Mul(&summand, &operands[_s])
-				res[d].Add(&res[d], &summand)
+				res[d].MulAcc(&summand, &operands[_s])
 				_s, _e = _e, _e+nbInner
 			}
 		}
 		mu.Lock()
 		for i := 0; i < len(gJ); i++ {
-			gJ[i].Add(&gJ[i], &res[i])
+			r := res[i].Reduce()
+			gJ[i].Add(&gJ[i], &r)
 		}
 		mu.Unlock()
 	}
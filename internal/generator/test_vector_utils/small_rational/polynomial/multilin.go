@@ -117,17 +117,13 @@ func (m MultiLin) Clone() MultiLin {
 }
 
 // Add two bookKeepingTables
+//
+// left and right may have different numbers of variables: the one with k < n variables is seen
+// as a polynomial in X₁, ..., Xₖ, constant in the other variables. m is resized if needed.
 func (m *MultiLin) Add(left, right MultiLin) {
-	size := len(left)
-	// Check that left and right have the same size
-	if len(right) != size || len(*m) != size {
-		panic("left, right and destination must have the right size")
-	}
-
-	// Add elementwise
-	for i := 0; i < size; i++ {
-		(*m)[i].Add(&left[i], &right[i])
-	}
+	m.combine(left, right, func(z, x, y *small_rational.SmallRational) {
+		z.Add(x, y)
+	})
 }
 
 // EvalEq computes Eq(q₁, ... , qₙ, h₁, ... , hₙ) = Π₁ⁿ Eq(qᵢ, hᵢ)
@@ -31,14 +31,48 @@ func (p *Polynomial) Degree() uint64 {
 	return uint64(len(*p) - 1)
 }
 
+// evalBlockSize is the number of coefficients Eval sums with a single reduction
+const evalBlockSize = 32
+
 // Eval evaluates p at v
 // returns a small_rational.SmallRational
+//
+// Large polynomials are split in blocks of evalBlockSize coefficients; each block is an
+// inner product with (1, v, ..., v^(evalBlockSize-1)), computed with an Accumulator, and
+// the blocks are combined with Horner's rule in v^evalBlockSize.
 func (p *Polynomial) Eval(v *small_rational.SmallRational) small_rational.SmallRational {
 
-	res := (*p)[len(*p)-1]
-	for i := len(*p) - 2; i >= 0; i-- {
-		res.Mul(&res, v)
-		res.Add(&res, &(*p)[i])
+	if len(*p) < 2*evalBlockSize {
+		res := (*p)[len(*p)-1]
+		for i := len(*p) - 2; i >= 0; i-- {
+			res.Mul(&res, v)
+			res.Add(&res, &(*p)[i])
+		}
+		return res
+	}
+
+	var powers [evalBlockSize]small_rational.SmallRational
+	powers[0].SetOne()
+	for i := 1; i < evalBlockSize; i++ {
+		powers[i].Mul(&powers[i-1], v)
+	}
+	var vB small_rational.SmallRational
+	vB.Mul(&powers[evalBlockSize-1], v)
+
+	var res, block small_rational.SmallRational
+	var acc small_rational.Accumulator
+	for start := ((len(*p) - 1) / evalBlockSize) * evalBlockSize; start >= 0; start -= evalBlockSize {
+		end := start + evalBlockSize
+		if end > len(*p) {
+			end = len(*p)
+		}
+		acc.Reset()
+		for i := start; i < end; i++ {
+			acc.MulAcc(&(*p)[i], &powers[i-start])
+		}
+		block = acc.Reduce()
+		res.Mul(&res, &vB)
+		res.Add(&res, &block)
 	}
 
 	return res
@@ -220,6 +220,35 @@ func (z *SmallRational) Mul(x, y *SmallRational) *SmallRational {
 	return z
 }
 
+// Accumulator mirrors the lazy-reduction accumulator of the field packages;
+// rationals need no reduction, so it simply holds the running sum
+type Accumulator struct {
+	sum SmallRational
+}
+
+func (acc *Accumulator) MulAcc(x, y *SmallRational) *Accumulator {
+	var t SmallRational
+	t.Mul(x, y)
+	acc.sum.Add(&acc.sum, &t)
+	return acc
+}
+
+func (acc *Accumulator) Add(x, y *Accumulator) *Accumulator {
+	acc.sum.Add(&x.sum, &y.sum)
+	return acc
+}
+
+func (acc *Accumulator) Reset() *Accumulator {
+	acc.sum.SetZero()
+	return acc
+}
+
+func (acc *Accumulator) Reduce() SmallRational {
+	var res SmallRational
+	res.Set(&acc.sum)
+	return res
+}
+
 func (z *SmallRational) SetOne() *SmallRational {
 	return z.SetInt64(1)
 }
@@ -27,11 +27,14 @@ import (
 	eddsa_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/twistededwards/eddsa"
 	eddsa_bw6633 "github.com/consensys/gnark-crypto/ecc/bw6-633/twistededwards/eddsa"
 	eddsa_bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761/twistededwards/eddsa"
+	eddsa_ed25519 "github.com/consensys/gnark-crypto/ecc/edwards25519/eddsa"
+	eddsa_ed448 "github.com/consensys/gnark-crypto/ecc/edwards448/eddsa"
 	"github.com/consensys/gnark-crypto/ecc/twistededwards"
 	"github.com/consensys/gnark-crypto/signature"
 )
 
-// New takes a source of randomness and returns a new key pair
+// New takes a source of randomness and returns a new key pair. ED25519 and ED448 are the
+// standard Ed25519 and Ed448 of RFC 8032, the other curves are the "companion" twisted edwards curves.
 func New(ss twistededwards.ID, r io.Reader) (signature.Signer, error) {
 	switch ss {
 	case twistededwards.BN254:
@@ -50,6 +53,10 @@ func New(ss twistededwards.ID, r io.Reader) (signature.Signer, error) {
 		return eddsa_bls24317.GenerateKey(r)
 	case twistededwards.BW6_633:
 		return eddsa_bw6633.GenerateKey(r)
+	case twistededwards.ED25519:
+		return eddsa_ed25519.GenerateKey(r)
+	case twistededwards.ED448:
+		return eddsa_ed448.GenerateKey(r)
 	default:
 		panic("not implemented")
 	}